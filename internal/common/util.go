@@ -3,6 +3,7 @@ package common
 import (
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/labstack/echo/v4"
 )
@@ -51,3 +52,51 @@ func ParsePagination(c echo.Context, defaultPageSize int32, maxPageSize int32) (
 		PageSize: pageSize,
 	}, nil
 }
+
+// ParseQueryInt parses an optional non-negative integer query param, zero when absent.
+// The error is always instance of *echo.HttpError
+func ParseQueryInt(c echo.Context, name string, bitSize int) (int64, error) {
+	query := c.QueryParam(name)
+	if query == "" {
+		return 0, nil
+	}
+
+	v, err := strconv.ParseInt(query, 10, bitSize)
+	if err != nil || v < 0 {
+		return 0, echo.ErrBadRequest.WithInternal(fmt.Errorf("invalid %s: %s", name, query))
+	}
+
+	return v, nil
+}
+
+// ParseQueryDuration parses an optional non-negative duration query param (e.g. 24h), zero when absent.
+// The error is always instance of *echo.HttpError
+func ParseQueryDuration(c echo.Context, name string) (time.Duration, error) {
+	query := c.QueryParam(name)
+	if query == "" {
+		return 0, nil
+	}
+
+	v, err := time.ParseDuration(query)
+	if err != nil || v < 0 {
+		return 0, echo.ErrBadRequest.WithInternal(fmt.Errorf("invalid %s: %s", name, query))
+	}
+
+	return v, nil
+}
+
+// ParseQueryTime parses an optional RFC3339 query param, zero time when absent.
+// The error is always instance of *echo.HttpError
+func ParseQueryTime(c echo.Context, name string) (time.Time, error) {
+	query := c.QueryParam(name)
+	if query == "" {
+		return time.Time{}, nil
+	}
+
+	v, err := time.Parse(time.RFC3339, query)
+	if err != nil {
+		return time.Time{}, echo.ErrBadRequest.WithInternal(fmt.Errorf("invalid %s: %w", name, err))
+	}
+
+	return v, nil
+}
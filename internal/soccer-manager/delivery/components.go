@@ -6,7 +6,10 @@ import (
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/jwt"
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/jwt/access"
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/jwt/refresh"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/outbox"
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/service"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/stream"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/throttle"
 	"github.com/hexley21/soccer-manager/pkg/config"
 	"github.com/hexley21/soccer-manager/pkg/validator"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -16,21 +19,39 @@ import (
 type JWTManagers struct {
 	Access  jwt.ManagerWithTTL[access.Data]
 	Refresh jwt.ManagerWithTTL[refresh.Data]
+
+	// AccessKeys verify access tokens, their public parts are published as JWKS
+	AccessKeys *jwt.KeySet
 }
 
 type Services struct {
 	GlobeService service.GlobeService
 
-	AuthService service.AuthService
-	UserService service.UserService
+	AuthService         service.AuthService
+	SessionService      service.SessionService
+	UserService         service.UserService
+	ProvisioningService service.ProvisioningService
+
+	NotificationService service.NotificationService
+	OutboxService       service.OutboxService
 
 	TeamService service.TeamService
 
 	PlayerPosService service.PlayerPositionService
 	PlayerService    service.PlayerService
+	WatchlistService service.WatchlistService
 
 	TransferService service.TransferService
 	TransferRecordService service.TransferRecordService
+	BidService            service.BidService
+	AuctionService        service.AuctionService
+	OfferService          service.OfferService
+	TransferWindowService service.TransferWindowService
+	MarketStatsService    service.MarketStatsService
+
+	MatchService service.MatchService
+
+	LeagueService service.LeagueService
 }
 
 type Components struct {
@@ -39,6 +60,9 @@ type Components struct {
 	Validator     validator.Validator
 	SnowflakeNode *snowflake.Node
 	EventBus      evbus.Bus
+	Broker        *stream.Broker
+	Outbox        *outbox.Dispatcher
+	LoginGuard    *throttle.Guard
 
 	DbPool *pgxpool.Pool
 	// redisCluster *redis.ClusterClient
@@ -0,0 +1,37 @@
+package auction
+
+import (
+	"time"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
+)
+
+type auctionResultResponseDTO struct {
+	ID           int64                 `json:"id"`
+	TransferID   int64                 `json:"transfer_id"`
+	PlayerID     int64                 `json:"player_id"`
+	SellerTeamID int64                 `json:"seller_team_id"`
+	WinningBidID int64                 `json:"winning_bid_id,omitempty"`
+	BuyerTeamID  int64                 `json:"buyer_team_id,omitempty"`
+	SoldPrice    int64                 `json:"sold_price,omitempty"`
+	ReservePrice int64                 `json:"reserve_price"`
+	Outcome      domain.AuctionOutcome `json:"outcome"`
+	EndedAt      time.Time             `json:"ended_at"`
+	SettledAt    time.Time             `json:"settled_at"`
+} // @name AuctionResultResponse
+
+func auctionResultResponseAdapter(model domain.AuctionResult) auctionResultResponseDTO {
+	return auctionResultResponseDTO{
+		ID:           model.ID,
+		TransferID:   model.TransferID,
+		PlayerID:     model.PlayerID,
+		SellerTeamID: model.SellerTeamID,
+		WinningBidID: model.WinningBidID,
+		BuyerTeamID:  model.BuyerTeamID,
+		SoldPrice:    model.SoldPrice,
+		ReservePrice: model.ReservePrice,
+		Outcome:      model.Outcome,
+		EndedAt:      model.EndedAt,
+		SettledAt:    model.SettledAt,
+	}
+}
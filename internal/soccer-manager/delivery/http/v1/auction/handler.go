@@ -0,0 +1,86 @@
+package auction
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/hexley21/soccer-manager/internal/common"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/service"
+	"github.com/labstack/echo/v4"
+)
+
+type handler struct {
+	auctionService service.AuctionService
+	pageSize       int32
+	pageLimit      int32
+}
+
+func newHandler(auctionService service.AuctionService, pageSize int32, pageLimit int32) *handler {
+	return &handler{
+		auctionService: auctionService,
+		pageSize:       pageSize,
+		pageLimit:      pageLimit,
+	}
+}
+
+// @Summary List auction results
+// @Description Returns outcomes of settled auctions (paginated)
+// @Tags auctions
+// @Produce json
+// @Param cursor query int false "Pagination cursor"
+// @Param page_size query int false "Number of items per page"
+// @Success 200 {object} common.apiResponse{data=[]auctionResultResponseDTO} "OK"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/auction-results [get]
+func (h *handler) GetAuctionResults(c echo.Context) error {
+	pagination, err := common.ParsePagination(c, h.pageSize, h.pageLimit)
+	if err != nil {
+		return err
+	}
+
+	results, err := h.auctionService.ListAuctionResults(
+		c.Request().Context(),
+		pagination.Cursor,
+		pagination.PageSize,
+	)
+	if err != nil {
+		return err
+	}
+
+	res := make([]auctionResultResponseDTO, len(results))
+	for i, r := range results {
+		res[i] = auctionResultResponseAdapter(r)
+	}
+
+	return c.JSON(http.StatusOK, common.NewApiResponse(res))
+}
+
+// @Summary Get auction result by ID
+// @Description Returns the outcome of a settled auction
+// @Tags auctions
+// @Produce json
+// @Param result_id path int true "Auction result ID"
+// @Success 200 {object} common.apiResponse{data=auctionResultResponseDTO} "OK"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 404 {object} echo.HTTPError "Not Found"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/auction-results/{result_id} [get]
+func (h *handler) GetAuctionResultById(c echo.Context) error {
+	resultId, err := strconv.ParseInt(c.Param("result_id"), 10, 64)
+	if err != nil {
+		return echo.ErrBadRequest.WithInternal(err)
+	}
+
+	result, err := h.auctionService.GetAuctionResultByID(c.Request().Context(), resultId)
+	if err != nil {
+		if errors.Is(err, service.ErrAuctionResultNotFound) {
+			return echo.ErrNotFound.WithInternal(err)
+		}
+
+		return err
+	}
+
+	return c.JSON(http.StatusOK, common.NewApiResponse(auctionResultResponseAdapter(result)))
+}
@@ -0,0 +1,13 @@
+package auction
+
+import (
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/delivery"
+	"github.com/labstack/echo/v4"
+)
+
+func RegisterRoutes(g *echo.Group, c *delivery.Components) {
+	h := newHandler(c.Services.AuctionService, c.Cfg.Pagination.M, c.Cfg.Pagination.L)
+
+	g.GET("/auction-results", h.GetAuctionResults)
+	g.GET("/auction-results/:result_id", h.GetAuctionResultById)
+}
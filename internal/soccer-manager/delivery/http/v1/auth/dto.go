@@ -4,7 +4,6 @@ type (
 	registerRequestDTO struct {
 		Username string `json:"username" validate:"required,username"`
 		Password string `json:"password" validate:"required,password"`
-		Role     string `json:"role"     validate:"required,userrole"`
 	} // @name RegisterRequest
 
 	registerResponseDTO struct {
@@ -27,6 +26,7 @@ type (
 
 type (
 	refreshResponseDTO struct {
-		AccessToken string `json:"access_token"`
+		AccessToken  string `json:"access_token"`
+		RefreshToken string `json:"refresh_token"`
 	} // @name RefreshResponse
 )
@@ -2,15 +2,17 @@ package auth
 
 import (
 	"errors"
+	"math"
 	"net/http"
+	"strconv"
 	"time"
 
-	evbus "github.com/asaskevich/EventBus"
 	"github.com/hexley21/soccer-manager/internal/common"
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/jwt/access"
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/jwt/refresh"
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/service"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/throttle"
 	"github.com/labstack/echo/v4"
 )
 
@@ -18,27 +20,31 @@ const refreshCookie = "refresh_token"
 
 type handler struct {
 	authService       service.AuthService
+	sessionService    service.SessionService
 	accessJWTManager  access.Manager
 	refreshJWTManager refresh.Manager
-	eventEmitter      evbus.BusPublisher
+	loginGuard        *throttle.Guard
 }
 
 func newHandler(
 	authService service.AuthService,
+	sessionService service.SessionService,
 	accessJWTManager access.Manager,
 	refreshJWTManager refresh.Manager,
-	eventEmitter evbus.BusPublisher,
+	loginGuard *throttle.Guard,
 ) *handler {
 	return &handler{
 		authService:       authService,
+		sessionService:    sessionService,
 		accessJWTManager:  accessJWTManager,
 		refreshJWTManager: refreshJWTManager,
-		eventEmitter:      eventEmitter,
+		loginGuard:        loginGuard,
 	}
 }
 
 // @Summary Login user
-// @Description Authenticates user with username and password and returns JWT
+// @Description Authenticates user with username and password and returns JWT.
+// @Description Repeated failures of a username or an IP are throttled, the wait is sent in Retry-After
 // @Tags auth
 // @Accept json
 // @Produce json
@@ -46,6 +52,7 @@ func newHandler(
 // @Success 200 {object} common.apiResponse{data=loginResponseDTO} "OK"
 // @Failure 400 {object} echo.HTTPError "Bad Request"
 // @Failure 401 {object} echo.HTTPError "Unauthorized"
+// @Failure 429 {object} echo.HTTPError "Too Many Requests"
 // @Failure 500 {object} echo.HTTPError "Internal Server Error"
 // @Router /v1/auth/login [post]
 func (h *handler) Login(c echo.Context) error {
@@ -58,39 +65,54 @@ func (h *handler) Login(c echo.Context) error {
 		return echo.ErrBadRequest.WithInternal(err)
 	}
 
-	user, err := h.authService.Authenticate(c.Request().Context(), req.Username, req.Password)
+	ctx := c.Request().Context()
+	ip := c.RealIP()
+
+	// the login counts as failed until it's known, so parallel logins can't outrun the throttle.
+	// Throttled attempts aren't counted, waiting out the delay is enough to try again
+	wait, err := h.loginGuard.Attempt(ctx, req.Username, ip)
 	if err != nil {
-		if errors.Is(err, service.ErrUserNotFound) {
+		c.Logger().Errorf("failed to count login attempt: %v", err)
+		return err
+	}
+	if wait > 0 {
+		setRetryAfter(c, wait)
+		return echo.ErrTooManyRequests
+	}
+
+	user, err := h.authService.Authenticate(ctx, req.Username, req.Password)
+	if err != nil {
+		// unknown usernames count as well, so they can't be told apart from wrong passwords
+		if errors.Is(err, service.ErrUserNotFound) || errors.Is(err, service.ErrIncorrectPassword) {
 			c.Logger().Debug(err)
 			return echo.ErrUnauthorized.WithInternal(err)
 		}
-		if errors.Is(err, service.ErrIncorrectPassword) {
-			c.Logger().Debug(err)
-			return echo.ErrUnauthorized.WithInternal(err)
+
+		if err := h.loginGuard.Cancel(ctx, req.Username, ip); err != nil {
+			c.Logger().Errorf("failed to take back login attempt: %v", err)
 		}
 
 		c.Logger().Error("failed to login user: %v", err)
 		return err
 	}
 
-	accessToken, err := h.accessJWTManager.CreateTokenString(
-		access.NewData(user.ID, user.Role),
-	)
+	if err := h.loginGuard.Succeed(ctx, req.Username, ip); err != nil {
+		c.Logger().Errorf("failed to reset login failures: %v", err)
+	}
+
+	sessionID, refreshToken, err := h.startSession(c, user.ID)
 	if err != nil {
-		c.Logger().Errorf("failed to create access_token: %v", err)
 		return err
 	}
 
-	refreshToken, err := h.refreshJWTManager.CreateTokenString(
-		refresh.NewData(user.ID),
+	accessToken, err := h.accessJWTManager.CreateTokenString(
+		access.NewData(user.ID, sessionID, user.Role),
 	)
 	if err != nil {
-		c.Logger().Errorf("failed to create refresh_token: %v", err)
+		c.Logger().Errorf("failed to create access_token: %v", err)
 		return err
 	}
 
-	setCookieJWT(c, refreshCookie, refreshToken, h.refreshJWTManager.TTL())
-
 	return c.JSON(http.StatusOK, common.NewApiResponse(loginResponseDTO{
 		AccessToken:  accessToken,
 		RefreshToken: refreshToken, // the refresh token is left only for better apie usage
@@ -98,17 +120,34 @@ func (h *handler) Login(c echo.Context) error {
 }
 
 // @Summary Logout user
-// @Description Logs out user by invalidating refresh token cookie
+// @Description Logs out user by revoking the session of the refresh token cookie and erasing it
 // @Tags auth
 // @Success 204 "No Content"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
 // @Router /v1/auth/logout [post]
 func (h *handler) Logout(c echo.Context) error {
+	// logout always succeeds for the client, a broken or stale cookie has nothing left to revoke
+	if cookie, err := c.Cookie(refreshCookie); err == nil {
+		if refreshData, err := h.refreshJWTManager.ParseTokenString(cookie.Value); err == nil {
+			err := h.sessionService.RevokeSession(
+				c.Request().Context(),
+				refreshData.UserID,
+				refreshData.SessionID,
+				domain.SessionRevokedLogout,
+			)
+			if err != nil && !errors.Is(err, service.ErrSessionNotFound) {
+				c.Logger().Errorf("failed to revoke session: %v", err)
+				return err
+			}
+		}
+	}
+
 	eraseCookie(c, refreshCookie)
 	return c.NoContent(http.StatusNoContent)
 }
 
 // @Summary Register new user
-// @Description Creates a new user account and returns JWT tokens
+// @Description Creates a new user account and returns JWT tokens, new users are always USER
 // @Tags auth
 // @Accept json
 // @Produce json
@@ -132,7 +171,6 @@ func (h *handler) Register(c echo.Context) error {
 		c.Request().Context(),
 		req.Username,
 		req.Password,
-		req.Role,
 	)
 	if err != nil {
 		if errors.Is(err, service.ErrUsernameTaken) {
@@ -144,27 +182,19 @@ func (h *handler) Register(c echo.Context) error {
 		return err
 	}
 
-	accessToken, err := h.accessJWTManager.CreateTokenString(
-		access.NewData(user.ID, user.Role),
-	)
+	sessionID, refreshToken, err := h.startSession(c, user.ID)
 	if err != nil {
-		c.Logger().Errorf("failed to create access_token: %v", err)
 		return err
 	}
 
-	refreshToken, err := h.refreshJWTManager.CreateTokenString(
-		refresh.NewData(user.ID),
+	accessToken, err := h.accessJWTManager.CreateTokenString(
+		access.NewData(user.ID, sessionID, user.Role),
 	)
 	if err != nil {
-		c.Logger().Errorf("failed to create refresh_token: %v", err)
+		c.Logger().Errorf("failed to create access_token: %v", err)
 		return err
 	}
 
-	setCookieJWT(c, refreshCookie, refreshToken, h.refreshJWTManager.TTL())
-
-	// trigger a signup event
-	h.eventEmitter.Publish(domain.EventONSIGNUP, user.ID, req.Username)
-
 	return c.JSON(http.StatusCreated, common.NewApiResponse(registerResponseDTO{
 		AccessToken:  accessToken,
 		RefreshToken: refreshToken,
@@ -172,7 +202,8 @@ func (h *handler) Register(c echo.Context) error {
 }
 
 // @Summary Refresh access token
-// @Description Get a new access token using refresh token from cookie
+// @Description Get a new access token using refresh token from cookie, the refresh token is rotated.
+// @Description Reusing a rotated refresh token revokes its session
 // @Tags auth
 // @Produce json
 // @Success 200 {object} common.apiResponse{data=refreshResponseDTO} "OK"
@@ -192,7 +223,27 @@ func (h *handler) Refresh(c echo.Context) error {
 		return echo.ErrUnauthorized.WithInternal(err)
 	}
 
-	user, err := h.authService.GetUserById(c.Request().Context(), refreshData.UserID)
+	session, err := h.sessionService.RotateSession(
+		c.Request().Context(),
+		refreshData.SessionID,
+		refreshData.TokenID,
+		clientOf(c),
+	)
+	if err != nil {
+		if errors.Is(err, service.ErrRefreshTokenReused) {
+			eraseCookie(c, refreshCookie)
+			c.Logger().Warnf("refresh token reused, revoked session %d of user %d", refreshData.SessionID, refreshData.UserID)
+			return echo.ErrUnauthorized.WithInternal(err)
+		}
+		if errors.Is(err, service.ErrSessionNotFound) {
+			eraseCookie(c, refreshCookie)
+			return echo.ErrUnauthorized.WithInternal(err)
+		}
+
+		return err
+	}
+
+	user, err := h.authService.GetUserById(c.Request().Context(), session.UserID)
 	if err != nil {
 		if errors.Is(err, service.ErrUserNotFound) {
 			eraseCookie(c, refreshCookie)
@@ -204,19 +255,55 @@ func (h *handler) Refresh(c echo.Context) error {
 	}
 
 	accessToken, err := h.accessJWTManager.CreateTokenString(
-		access.NewData(refreshData.UserID, user.Role),
+		access.NewData(user.ID, session.ID, user.Role),
 	)
 	if err != nil {
 		c.Logger().Errorf("failed to sign access_token")
 		return err
 	}
 
+	refreshToken, err := h.refreshJWTManager.CreateTokenString(
+		refresh.NewData(user.ID, session.ID, session.TokenID),
+	)
+	if err != nil {
+		c.Logger().Errorf("failed to create refresh_token: %v", err)
+		return err
+	}
+
+	setCookieJWT(c, refreshCookie, refreshToken, h.refreshJWTManager.TTL())
+
 	return c.JSON(
 		http.StatusOK,
-		common.NewApiResponse(refreshResponseDTO{AccessToken: accessToken}),
+		common.NewApiResponse(refreshResponseDTO{AccessToken: accessToken, RefreshToken: refreshToken}),
 	)
 }
 
+// startSession opens a session for the user and sets its first refresh token as a cookie
+func (h *handler) startSession(c echo.Context, userID int64) (int64, string, error) {
+	session, err := h.sessionService.StartSession(c.Request().Context(), userID, clientOf(c))
+	if err != nil {
+		c.Logger().Errorf("failed to start session: %v", err)
+		return 0, "", err
+	}
+
+	refreshToken, err := h.refreshJWTManager.CreateTokenString(
+		refresh.NewData(userID, session.ID, session.TokenID),
+	)
+	if err != nil {
+		c.Logger().Errorf("failed to create refresh_token: %v", err)
+		return 0, "", err
+	}
+
+	setCookieJWT(c, refreshCookie, refreshToken, h.refreshJWTManager.TTL())
+
+	return session.ID, refreshToken, nil
+}
+
+// clientOf describes the device a session is used from
+func clientOf(c echo.Context) domain.SessionClient {
+	return domain.SessionClient{UserAgent: c.Request().UserAgent(), IP: c.RealIP()}
+}
+
 func setCookieJWT(c echo.Context, cookieName string, token string, ttl time.Duration) {
 	c.SetCookie(&http.Cookie{
 		Name:     cookieName,
@@ -238,3 +325,8 @@ func eraseCookie(c echo.Context, cookieName string) {
 		Expires:  time.Unix(0, 0),
 	})
 }
+
+// setRetryAfter sends the wait in whole seconds, rounded up so the client doesn't retry early
+func setRetryAfter(c echo.Context, wait time.Duration) {
+	c.Response().Header().Set(echo.HeaderRetryAfter, strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+}
@@ -8,9 +8,10 @@ import (
 func RegisterRoutes(g *echo.Group, c *delivery.Components) {
 	h := newHandler(
 		c.Services.AuthService,
+		c.Services.SessionService,
 		c.JWTManagers.Access,
 		c.JWTManagers.Refresh,
-		c.EventBus,
+		c.LoginGuard,
 	)
 
 	g.POST("/login", h.Login)
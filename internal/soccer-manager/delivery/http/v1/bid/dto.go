@@ -0,0 +1,44 @@
+package bid
+
+import (
+	"time"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
+	"github.com/shopspring/decimal"
+)
+
+type bidResponseDTO struct {
+	ID            int64            `json:"id"`
+	TransferID    int64            `json:"transfer_id,omitempty"`
+	PlayerID      int64            `json:"player_id"`
+	SellerTeamID  int64            `json:"seller_team_id"`
+	BidderTeamID  int64            `json:"bidder_team_id"`
+	Amount        int64            `json:"amount"`
+	CounterAmount int64            `json:"counter_amount,omitempty"`
+	Status        domain.BidStatus `json:"status"`
+	CreatedAt     time.Time        `json:"created_at"`
+	UpdatedAt     time.Time        `json:"updated_at"`
+} // @name BidResponse
+
+func bidResponseAdapter(model domain.Bid) bidResponseDTO {
+	return bidResponseDTO{
+		ID:            model.ID,
+		TransferID:    model.TransferID,
+		PlayerID:      model.PlayerID,
+		SellerTeamID:  model.SellerTeamID,
+		BidderTeamID:  model.BidderTeamID,
+		Amount:        model.Amount,
+		CounterAmount: model.CounterAmount,
+		Status:        model.Status,
+		CreatedAt:     model.CreatedAt,
+		UpdatedAt:     model.UpdatedAt,
+	}
+}
+
+type placeBidRequestDTO struct {
+	Amount decimal.Decimal `json:"amount" validate:"required,dgte=1"`
+} // @name PlaceBidRequest
+
+type counterBidRequestDTO struct {
+	Amount decimal.Decimal `json:"amount" validate:"required,dgte=1"`
+} // @name CounterBidRequest
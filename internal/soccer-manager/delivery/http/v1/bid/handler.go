@@ -0,0 +1,380 @@
+package bid
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/hexley21/soccer-manager/internal/common"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/jwt/access"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/service"
+	"github.com/labstack/echo/v4"
+)
+
+type handler struct {
+	bidService service.BidService
+	pageSize   int32
+	pageLimit  int32
+}
+
+func newHandler(bidService service.BidService, pageSize int32, pageLimit int32) *handler {
+	return &handler{
+		bidService: bidService,
+		pageSize:   pageSize,
+		pageLimit:  pageLimit,
+	}
+}
+
+// @Summary List bids on transfer
+// @Description Returns bids on the transfer, only the seller can see them (paginated)
+// @Tags bids
+// @Produce json
+// @Security AccessToken
+// @Param transfer_id path int true "Transfer ID"
+// @Param cursor query int false "Pagination cursor"
+// @Param page_size query int false "Number of items per page"
+// @Success 200 {object} common.apiResponse{data=[]bidResponseDTO} "OK"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 401 {object} echo.HTTPError "Unauthorized"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/transfers/{transfer_id}/bids [get]
+func (h *handler) GetBidsByTransferId(c echo.Context) error {
+	transferId, err := strconv.ParseInt(c.Param("transfer_id"), 10, 64)
+	if err != nil {
+		return echo.ErrBadRequest.WithInternal(err)
+	}
+
+	userData, ok := c.Get(access.CtxKey).(access.Data)
+	if !ok {
+		return echo.ErrUnauthorized.WithInternal(access.NewInvalidTokenError(userData))
+	}
+
+	pagination, err := common.ParsePagination(c, h.pageSize, h.pageLimit)
+	if err != nil {
+		return err
+	}
+
+	bids, err := h.bidService.ListBidsByTransferID(
+		c.Request().Context(),
+		transferId,
+		userData.UserID,
+		pagination.Cursor,
+		pagination.PageSize,
+	)
+	if err != nil {
+		return err
+	}
+
+	res := make([]bidResponseDTO, len(bids))
+	for i, b := range bids {
+		res[i] = bidResponseAdapter(b)
+	}
+
+	return c.JSON(http.StatusOK, common.NewApiResponse(res))
+}
+
+// @Summary Place a bid
+// @Description Submits a bid on the transfer, the amount is reserved from your budget until the bid is closed
+// @Tags bids
+// @Accept json
+// @Produce json
+// @Security AccessToken
+// @Param transfer_id path int true "Transfer ID"
+// @Param request body placeBidRequestDTO true "Bid amount"
+// @Success 201 {object} common.apiResponse{data=bidResponseDTO} "Created"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 401 {object} echo.HTTPError "Unauthorized"
+// @Failure 402 {object} echo.HTTPError "Payment Required"
+// @Failure 403 {object} echo.HTTPError "Forbidden"
+// @Failure 404 {object} echo.HTTPError "Not Found"
+// @Failure 409 {object} echo.HTTPError "Conflict"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/transfers/{transfer_id}/bids [post]
+func (h *handler) PlaceBid(c echo.Context) error {
+	transferId, err := strconv.ParseInt(c.Param("transfer_id"), 10, 64)
+	if err != nil {
+		return echo.ErrBadRequest.WithInternal(err)
+	}
+
+	userData, ok := c.Get(access.CtxKey).(access.Data)
+	if !ok {
+		return echo.ErrUnauthorized.WithInternal(access.NewInvalidTokenError(userData))
+	}
+
+	var req placeBidRequestDTO
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+	if err := c.Validate(&req); err != nil {
+		return echo.ErrBadRequest.WithInternal(err)
+	}
+
+	bid, err := h.bidService.PlaceBid(
+		c.Request().Context(),
+		transferId,
+		userData.UserID,
+		req.Amount.IntPart(),
+	)
+	if err != nil {
+		if errors.Is(err, service.ErrTransferNotFound) {
+			return echo.ErrNotFound.WithInternal(err)
+		}
+		if errors.Is(err, service.ErrCantBuyFromYourself) ||
+			errors.Is(err, service.ErrBidAlreadyPlaced) ||
+			errors.Is(err, service.ErrAuctionEnded) {
+			return echo.ErrConflict.WithInternal(err)
+		}
+		if errors.Is(err, service.ErrNotEnoughFunds) {
+			return echo.ErrPaymentRequired.WithInternal(err)
+		}
+		if errors.Is(err, service.ErrTransferWindowClosed) {
+			return echo.ErrForbidden.WithInternal(err)
+		}
+		if errors.Is(err, service.ErrInvalidArguments) ||
+			errors.Is(err, service.ErrBidTooLow) {
+			return echo.ErrBadRequest.WithInternal(err)
+		}
+
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, common.NewApiResponse(bidResponseAdapter(bid)))
+}
+
+// @Summary List my bids
+// @Description Returns bids placed by your team (paginated)
+// @Tags bids
+// @Produce json
+// @Security AccessToken
+// @Param cursor query int false "Pagination cursor"
+// @Param page_size query int false "Number of items per page"
+// @Success 200 {object} common.apiResponse{data=[]bidResponseDTO} "OK"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 401 {object} echo.HTTPError "Unauthorized"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/users/me/bids [get]
+func (h *handler) GetMyBids(c echo.Context) error {
+	userData, ok := c.Get(access.CtxKey).(access.Data)
+	if !ok {
+		return echo.ErrUnauthorized.WithInternal(access.NewInvalidTokenError(userData))
+	}
+
+	pagination, err := common.ParsePagination(c, h.pageSize, h.pageLimit)
+	if err != nil {
+		return err
+	}
+
+	bids, err := h.bidService.ListBidsByUserID(
+		c.Request().Context(),
+		userData.UserID,
+		pagination.Cursor,
+		pagination.PageSize,
+	)
+	if err != nil {
+		return err
+	}
+
+	res := make([]bidResponseDTO, len(bids))
+	for i, b := range bids {
+		res[i] = bidResponseAdapter(b)
+	}
+
+	return c.JSON(http.StatusOK, common.NewApiResponse(res))
+}
+
+// @Summary Get bid with id
+// @Description Returns a bid, visible to the seller and the bidder
+// @Tags bids
+// @Produce json
+// @Security AccessToken
+// @Param bid_id path int true "Bid ID"
+// @Success 200 {object} common.apiResponse{data=bidResponseDTO} "OK"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 401 {object} echo.HTTPError "Unauthorized"
+// @Failure 404 {object} echo.HTTPError "Not Found"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/bids/{bid_id} [get]
+func (h *handler) GetBidById(c echo.Context) error {
+	bidId, err := strconv.ParseInt(c.Param("bid_id"), 10, 64)
+	if err != nil {
+		return echo.ErrBadRequest.WithInternal(err)
+	}
+
+	userData, ok := c.Get(access.CtxKey).(access.Data)
+	if !ok {
+		return echo.ErrUnauthorized.WithInternal(access.NewInvalidTokenError(userData))
+	}
+
+	bid, err := h.bidService.GetBidByID(c.Request().Context(), bidId, userData.UserID)
+	if err != nil {
+		if errors.Is(err, service.ErrBidNotFound) {
+			return echo.ErrNotFound.WithInternal(err)
+		}
+
+		return err
+	}
+
+	return c.JSON(http.StatusOK, common.NewApiResponse(bidResponseAdapter(bid)))
+}
+
+// @Summary Accept a bid
+// @Description Seller accepts a pending bid, or bidder accepts seller's counter offer.
+// @Description The player is transferred the same way as on instant buy, other open bids are cancelled
+// @Tags bids
+// @Security AccessToken
+// @Param bid_id path int true "Bid ID"
+// @Success 200 "OK"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 401 {object} echo.HTTPError "Unauthorized"
+// @Failure 402 {object} echo.HTTPError "Payment Required"
+// @Failure 403 {object} echo.HTTPError "Forbidden"
+// @Failure 404 {object} echo.HTTPError "Not Found"
+// @Failure 409 {object} echo.HTTPError "Conflict"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/bids/{bid_id}/accept [post]
+func (h *handler) AcceptBid(c echo.Context) error {
+	bidId, err := strconv.ParseInt(c.Param("bid_id"), 10, 64)
+	if err != nil {
+		return echo.ErrBadRequest.WithInternal(err)
+	}
+
+	userData, ok := c.Get(access.CtxKey).(access.Data)
+	if !ok {
+		return echo.ErrUnauthorized.WithInternal(access.NewInvalidTokenError(userData))
+	}
+
+	if _, err := h.bidService.AcceptBid(c.Request().Context(), bidId, userData.UserID); err != nil {
+		if errors.Is(err, service.ErrNotEnoughFunds) {
+			return echo.ErrPaymentRequired.WithInternal(err)
+		}
+
+		return bidHTTPError(err)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+// @Summary Reject a bid
+// @Description Seller rejects an open bid, reserved funds go back to the bidder
+// @Tags bids
+// @Security AccessToken
+// @Param bid_id path int true "Bid ID"
+// @Success 200 "OK"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 401 {object} echo.HTTPError "Unauthorized"
+// @Failure 404 {object} echo.HTTPError "Not Found"
+// @Failure 409 {object} echo.HTTPError "Conflict"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/bids/{bid_id}/reject [post]
+func (h *handler) RejectBid(c echo.Context) error {
+	bidId, err := strconv.ParseInt(c.Param("bid_id"), 10, 64)
+	if err != nil {
+		return echo.ErrBadRequest.WithInternal(err)
+	}
+
+	userData, ok := c.Get(access.CtxKey).(access.Data)
+	if !ok {
+		return echo.ErrUnauthorized.WithInternal(access.NewInvalidTokenError(userData))
+	}
+
+	if err := h.bidService.RejectBid(c.Request().Context(), bidId, userData.UserID); err != nil {
+		return bidHTTPError(err)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+// @Summary Counter a bid
+// @Description Seller answers an open bid with a higher price, the bidder may accept it or withdraw
+// @Tags bids
+// @Accept json
+// @Security AccessToken
+// @Param bid_id path int true "Bid ID"
+// @Param request body counterBidRequestDTO true "Counter offer"
+// @Success 200 "OK"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 401 {object} echo.HTTPError "Unauthorized"
+// @Failure 404 {object} echo.HTTPError "Not Found"
+// @Failure 409 {object} echo.HTTPError "Conflict"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/bids/{bid_id}/counter [post]
+func (h *handler) CounterBid(c echo.Context) error {
+	bidId, err := strconv.ParseInt(c.Param("bid_id"), 10, 64)
+	if err != nil {
+		return echo.ErrBadRequest.WithInternal(err)
+	}
+
+	userData, ok := c.Get(access.CtxKey).(access.Data)
+	if !ok {
+		return echo.ErrUnauthorized.WithInternal(access.NewInvalidTokenError(userData))
+	}
+
+	var req counterBidRequestDTO
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+	if err := c.Validate(&req); err != nil {
+		return echo.ErrBadRequest.WithInternal(err)
+	}
+
+	if err := h.bidService.CounterBid(
+		c.Request().Context(),
+		bidId,
+		userData.UserID,
+		req.Amount.IntPart(),
+	); err != nil {
+		if errors.Is(err, service.ErrCounterTooLow) {
+			return echo.ErrBadRequest.WithInternal(err)
+		}
+
+		return bidHTTPError(err)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+// @Summary Withdraw a bid
+// @Description Bidder withdraws an open bid, reserved funds are given back. Auction bids can't be withdrawn once the auction has ended
+// @Tags bids
+// @Security AccessToken
+// @Param bid_id path int true "Bid ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 401 {object} echo.HTTPError "Unauthorized"
+// @Failure 404 {object} echo.HTTPError "Not Found"
+// @Failure 409 {object} echo.HTTPError "Conflict"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/bids/{bid_id} [delete]
+func (h *handler) WithdrawBid(c echo.Context) error {
+	bidId, err := strconv.ParseInt(c.Param("bid_id"), 10, 64)
+	if err != nil {
+		return echo.ErrBadRequest.WithInternal(err)
+	}
+
+	userData, ok := c.Get(access.CtxKey).(access.Data)
+	if !ok {
+		return echo.ErrUnauthorized.WithInternal(access.NewInvalidTokenError(userData))
+	}
+
+	if err := h.bidService.WithdrawBid(c.Request().Context(), bidId, userData.UserID); err != nil {
+		return bidHTTPError(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func bidHTTPError(err error) error {
+	if errors.Is(err, service.ErrTransferWindowClosed) {
+		return echo.ErrForbidden.WithInternal(err)
+	}
+	if errors.Is(err, service.ErrBidNotFound) {
+		return echo.ErrNotFound.WithInternal(err)
+	}
+	if errors.Is(err, service.ErrBidNotAwaiting) ||
+		errors.Is(err, service.ErrTransferIsAuction) ||
+		errors.Is(err, service.ErrAuctionEnded) {
+		return echo.ErrConflict.WithInternal(err)
+	}
+
+	return err
+}
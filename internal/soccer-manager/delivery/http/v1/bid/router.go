@@ -0,0 +1,21 @@
+package bid
+
+import (
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/delivery"
+	"github.com/labstack/echo/v4"
+)
+
+func RegisterRoutes(g *echo.Group, c *delivery.Components, m *delivery.Middlewares) {
+	h := newHandler(c.Services.BidService, c.Cfg.Pagination.M, c.Cfg.Pagination.L)
+
+	g.GET("/transfers/:transfer_id/bids", h.GetBidsByTransferId, m.JWTMiddleware)
+	g.POST("/transfers/:transfer_id/bids", h.PlaceBid, m.JWTMiddleware)
+
+	g.GET("/users/me/bids", h.GetMyBids, m.JWTMiddleware)
+
+	g.GET("/bids/:bid_id", h.GetBidById, m.JWTMiddleware)
+	g.DELETE("/bids/:bid_id", h.WithdrawBid, m.JWTMiddleware)
+	g.POST("/bids/:bid_id/accept", h.AcceptBid, m.JWTMiddleware)
+	g.POST("/bids/:bid_id/reject", h.RejectBid, m.JWTMiddleware)
+	g.POST("/bids/:bid_id/counter", h.CounterBid, m.JWTMiddleware)
+}
@@ -0,0 +1,108 @@
+package league
+
+import (
+	"time"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
+)
+
+type leagueResponseDTO struct {
+	ID        int64               `json:"id"`
+	Name      string              `json:"name"`
+	Status    domain.LeagueStatus `json:"status"`
+	CreatedAt time.Time           `json:"created_at"`
+} // @name LeagueResponse
+
+func leagueResponseAdapter(model domain.League) leagueResponseDTO {
+	return leagueResponseDTO{
+		ID:        model.ID,
+		Name:      model.Name,
+		Status:    model.Status,
+		CreatedAt: model.CreatedAt,
+	}
+}
+
+type leagueTeamResponseDTO struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	CountryCode string `json:"country_code"`
+} // @name LeagueTeamResponse
+
+func leagueTeamResponseAdapter(model domain.Team) leagueTeamResponseDTO {
+	return leagueTeamResponseDTO{
+		ID:          model.ID,
+		Name:        model.Name,
+		CountryCode: string(model.CountryCode),
+	}
+}
+
+type fixtureResponseDTO struct {
+	ID         int64      `json:"id"`
+	Round      int32      `json:"round"`
+	HomeTeamID int64      `json:"home_team_id"`
+	AwayTeamID int64      `json:"away_team_id"`
+	MatchID    int64      `json:"match_id,omitempty"`
+	HomeScore  *int32     `json:"home_score,omitempty"`
+	AwayScore  *int32     `json:"away_score,omitempty"`
+	PlayedAt   *time.Time `json:"played_at,omitempty"`
+} // @name FixtureResponse
+
+func fixtureResponseAdapter(model domain.Fixture) fixtureResponseDTO {
+	res := fixtureResponseDTO{
+		ID:         model.ID,
+		Round:      model.Round,
+		HomeTeamID: model.HomeTeamID,
+		AwayTeamID: model.AwayTeamID,
+	}
+
+	if model.Played() {
+		res.MatchID = model.MatchID
+		res.HomeScore = &model.HomeScore
+		res.AwayScore = &model.AwayScore
+		res.PlayedAt = &model.PlayedAt
+	}
+
+	return res
+}
+
+type standingResponseDTO struct {
+	Position       int                 `json:"position"`
+	TeamID         int64               `json:"team_id"`
+	Played         int32               `json:"played"`
+	Won            int32               `json:"won"`
+	Drawn          int32               `json:"drawn"`
+	Lost           int32               `json:"lost"`
+	GoalsFor       int32               `json:"goals_for"`
+	GoalsAgainst   int32               `json:"goals_against"`
+	GoalDifference int32               `json:"goal_difference"`
+	Points         int32               `json:"points"`
+	Form           []domain.FormResult `json:"form"`
+} // @name StandingResponse
+
+func standingResponseAdapter(position int, model domain.Standing) standingResponseDTO {
+	return standingResponseDTO{
+		Position:       position,
+		TeamID:         model.TeamID,
+		Played:         model.Played,
+		Won:            model.Won,
+		Drawn:          model.Drawn,
+		Lost:           model.Lost,
+		GoalsFor:       model.GoalsFor,
+		GoalsAgainst:   model.GoalsAgainst,
+		GoalDifference: model.GoalDifference,
+		Points:         model.Points,
+		Form:           model.Form,
+	}
+}
+
+type createLeagueRequestDTO struct {
+	Name string `json:"name" validate:"required,min=1,max=63"`
+} // @name CreateLeagueRequest
+
+type enrollTeamRequestDTO struct {
+	TeamID int64 `json:"team_id" validate:"required"`
+} // @name EnrollTeamRequest
+
+type playFixtureRequestDTO struct {
+	Seed *int64 `json:"seed"`
+} // @name PlayFixtureRequest
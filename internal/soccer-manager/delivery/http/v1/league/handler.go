@@ -0,0 +1,437 @@
+package league
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	evbus "github.com/asaskevich/EventBus"
+	"github.com/hexley21/soccer-manager/internal/common"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/service"
+	"github.com/labstack/echo/v4"
+)
+
+type handler struct {
+	leagueService service.LeagueService
+	eventEmitter  evbus.BusPublisher
+	pageSize      int32
+	pageLimit     int32
+}
+
+func newHandler(
+	leagueService service.LeagueService,
+	eventEmitter evbus.BusPublisher,
+	pageSize int32,
+	pageLimit int32,
+) *handler {
+	return &handler{
+		leagueService: leagueService,
+		eventEmitter:  eventEmitter,
+		pageSize:      pageSize,
+		pageLimit:     pageLimit,
+	}
+}
+
+// @Summary List all leagues
+// @Description Returns a list of leagues (paginated)
+// @Tags leagues
+// @Produce json
+// @Param cursor query int false "Pagination cursor"
+// @Param page_size query int false "Number of items per page"
+// @Success 200 {object} common.apiResponse{data=[]leagueResponseDTO} "OK"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/leagues [get]
+func (h *handler) GetLeagues(c echo.Context) error {
+	pagination, err := common.ParsePagination(c, h.pageSize, h.pageLimit)
+	if err != nil {
+		return err
+	}
+
+	leagues, err := h.leagueService.ListLeagues(
+		c.Request().Context(),
+		pagination.Cursor,
+		pagination.PageSize,
+	)
+	if err != nil {
+		return err
+	}
+
+	res := make([]leagueResponseDTO, len(leagues))
+	for i, l := range leagues {
+		res[i] = leagueResponseAdapter(l)
+	}
+
+	return c.JSON(http.StatusOK, common.NewApiResponse(res))
+}
+
+// @Summary Get league with id
+// @Description Returns a league by id
+// @Tags leagues
+// @Produce json
+// @Param league_id path int true "League ID"
+// @Success 200 {object} common.apiResponse{data=leagueResponseDTO} "OK"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 404 {object} echo.HTTPError "Not Found"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/leagues/{league_id} [get]
+func (h *handler) GetLeagueById(c echo.Context) error {
+	leagueId, err := strconv.ParseInt(c.Param("league_id"), 10, 64)
+	if err != nil {
+		return echo.ErrBadRequest.WithInternal(err)
+	}
+
+	league, err := h.leagueService.GetLeagueByID(c.Request().Context(), leagueId)
+	if err != nil {
+		if errors.Is(err, service.ErrLeagueNotFound) {
+			return echo.ErrNotFound.WithInternal(err)
+		}
+
+		return err
+	}
+
+	return c.JSON(http.StatusOK, common.NewApiResponse(leagueResponseAdapter(league)))
+}
+
+// @Summary Create league (ADMIN)
+// @Description Creates a new league open for team enrollment
+// @Tags leagues
+// @Accept json
+// @Produce json
+// @Security AccessToken
+// @Param request body createLeagueRequestDTO true "League details"
+// @Success 201 {object} common.apiResponse{data=leagueResponseDTO} "Created"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 403 {object} echo.HTTPError "Forbidden"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/leagues [post]
+func (h *handler) CreateLeague(c echo.Context) error {
+	var req createLeagueRequestDTO
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+	if err := c.Validate(&req); err != nil {
+		return echo.ErrBadRequest.WithInternal(err)
+	}
+
+	league, err := h.leagueService.CreateLeague(c.Request().Context(), req.Name)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, common.NewApiResponse(leagueResponseAdapter(league)))
+}
+
+// @Summary List league teams
+// @Description Returns teams enrolled in the league
+// @Tags leagues
+// @Produce json
+// @Param league_id path int true "League ID"
+// @Success 200 {object} common.apiResponse{data=[]leagueTeamResponseDTO} "OK"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 404 {object} echo.HTTPError "Not Found"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/leagues/{league_id}/teams [get]
+func (h *handler) GetLeagueTeams(c echo.Context) error {
+	leagueId, err := strconv.ParseInt(c.Param("league_id"), 10, 64)
+	if err != nil {
+		return echo.ErrBadRequest.WithInternal(err)
+	}
+
+	teams, err := h.leagueService.ListLeagueTeams(c.Request().Context(), leagueId)
+	if err != nil {
+		if errors.Is(err, service.ErrLeagueNotFound) {
+			return echo.ErrNotFound.WithInternal(err)
+		}
+
+		return err
+	}
+
+	res := make([]leagueTeamResponseDTO, len(teams))
+	for i, t := range teams {
+		res[i] = leagueTeamResponseAdapter(t)
+	}
+
+	return c.JSON(http.StatusOK, common.NewApiResponse(res))
+}
+
+// @Summary Enroll team (ADMIN)
+// @Description Enrolls a team in the league, only possible before fixtures are generated
+// @Tags leagues
+// @Accept json
+// @Security AccessToken
+// @Param league_id path int true "League ID"
+// @Param request body enrollTeamRequestDTO true "Team to enroll"
+// @Success 201 "Created"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 403 {object} echo.HTTPError "Forbidden"
+// @Failure 404 {object} echo.HTTPError "Not Found"
+// @Failure 409 {object} echo.HTTPError "Conflict"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/leagues/{league_id}/teams [post]
+func (h *handler) EnrollTeam(c echo.Context) error {
+	leagueId, err := strconv.ParseInt(c.Param("league_id"), 10, 64)
+	if err != nil {
+		return echo.ErrBadRequest.WithInternal(err)
+	}
+
+	var req enrollTeamRequestDTO
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+	if err := c.Validate(&req); err != nil {
+		return echo.ErrBadRequest.WithInternal(err)
+	}
+
+	err = h.leagueService.EnrollTeam(c.Request().Context(), leagueId, req.TeamID)
+	if err != nil {
+		if errors.Is(err, service.ErrLeagueNotFound) || errors.Is(err, service.ErrTeamNotFound) {
+			return echo.ErrNotFound.WithInternal(err)
+		}
+		if errors.Is(err, service.ErrLeagueStarted) ||
+			errors.Is(err, service.ErrTeamAlreadyEnrolled) {
+			return echo.ErrConflict.WithInternal(err)
+		}
+
+		return err
+	}
+
+	h.eventEmitter.Publish(domain.EventONTEAMNOTICE, domain.TeamNotice{
+		TeamID:  req.TeamID,
+		Kind:    domain.NotificationLeagueEnrolled,
+		Payload: map[string]int64{"league_id": leagueId},
+	})
+
+	return c.NoContent(http.StatusCreated)
+}
+
+// @Summary Unenroll team (ADMIN)
+// @Description Removes a team from the league, only possible before fixtures are generated
+// @Tags leagues
+// @Security AccessToken
+// @Param league_id path int true "League ID"
+// @Param team_id path int true "Team ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 403 {object} echo.HTTPError "Forbidden"
+// @Failure 404 {object} echo.HTTPError "Not Found"
+// @Failure 409 {object} echo.HTTPError "Conflict"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/leagues/{league_id}/teams/{team_id} [delete]
+func (h *handler) UnenrollTeam(c echo.Context) error {
+	leagueId, err := strconv.ParseInt(c.Param("league_id"), 10, 64)
+	if err != nil {
+		return echo.ErrBadRequest.WithInternal(err)
+	}
+	teamId, err := strconv.ParseInt(c.Param("team_id"), 10, 64)
+	if err != nil {
+		return echo.ErrBadRequest.WithInternal(err)
+	}
+
+	err = h.leagueService.UnenrollTeam(c.Request().Context(), leagueId, teamId)
+	if err != nil {
+		if errors.Is(err, service.ErrLeagueNotFound) || errors.Is(err, service.ErrTeamNotEnrolled) {
+			return echo.ErrNotFound.WithInternal(err)
+		}
+		if errors.Is(err, service.ErrLeagueStarted) {
+			return echo.ErrConflict.WithInternal(err)
+		}
+
+		return err
+	}
+
+	h.eventEmitter.Publish(domain.EventONTEAMNOTICE, domain.TeamNotice{
+		TeamID:  teamId,
+		Kind:    domain.NotificationLeagueLeft,
+		Payload: map[string]int64{"league_id": leagueId},
+	})
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// @Summary List league fixtures
+// @Description Returns all fixtures of the league ordered by round, scores are present once played
+// @Tags leagues
+// @Produce json
+// @Param league_id path int true "League ID"
+// @Success 200 {object} common.apiResponse{data=[]fixtureResponseDTO} "OK"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 404 {object} echo.HTTPError "Not Found"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/leagues/{league_id}/fixtures [get]
+func (h *handler) GetFixtures(c echo.Context) error {
+	leagueId, err := strconv.ParseInt(c.Param("league_id"), 10, 64)
+	if err != nil {
+		return echo.ErrBadRequest.WithInternal(err)
+	}
+
+	fixtures, err := h.leagueService.ListFixtures(c.Request().Context(), leagueId)
+	if err != nil {
+		if errors.Is(err, service.ErrLeagueNotFound) {
+			return echo.ErrNotFound.WithInternal(err)
+		}
+
+		return err
+	}
+
+	res := make([]fixtureResponseDTO, len(fixtures))
+	for i, f := range fixtures {
+		res[i] = fixtureResponseAdapter(f)
+	}
+
+	return c.JSON(http.StatusOK, common.NewApiResponse(res))
+}
+
+// @Summary Generate fixtures (ADMIN)
+// @Description Closes the enrollment and schedules a double round-robin,
+// @Description every team plays each other twice, once at home and once away
+// @Tags leagues
+// @Produce json
+// @Security AccessToken
+// @Param league_id path int true "League ID"
+// @Success 201 {object} common.apiResponse{data=[]fixtureResponseDTO} "Created"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 403 {object} echo.HTTPError "Forbidden"
+// @Failure 404 {object} echo.HTTPError "Not Found"
+// @Failure 409 {object} echo.HTTPError "Conflict"
+// @Failure 422 {object} echo.HTTPError "Unprocessable Entity"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/leagues/{league_id}/fixtures [post]
+func (h *handler) GenerateFixtures(c echo.Context) error {
+	leagueId, err := strconv.ParseInt(c.Param("league_id"), 10, 64)
+	if err != nil {
+		return echo.ErrBadRequest.WithInternal(err)
+	}
+
+	fixtures, err := h.leagueService.GenerateFixtures(c.Request().Context(), leagueId)
+	if err != nil {
+		if errors.Is(err, service.ErrLeagueNotFound) {
+			return echo.ErrNotFound.WithInternal(err)
+		}
+		if errors.Is(err, service.ErrLeagueStarted) {
+			return echo.ErrConflict.WithInternal(err)
+		}
+		if errors.Is(err, service.ErrNotEnoughTeams) {
+			return echo.ErrUnprocessableEntity.WithInternal(err)
+		}
+
+		return err
+	}
+
+	res := make([]fixtureResponseDTO, len(fixtures))
+	for i, f := range fixtures {
+		res[i] = fixtureResponseAdapter(f)
+	}
+
+	return c.JSON(http.StatusCreated, common.NewApiResponse(res))
+}
+
+// @Summary Play fixture (ADMIN)
+// @Description Simulates the fixture's match and stores the result,
+// @Description the same seed always produces the same match, random seed is used if omitted
+// @Tags leagues
+// @Accept json
+// @Produce json
+// @Security AccessToken
+// @Param league_id path int true "League ID"
+// @Param fixture_id path int true "Fixture ID"
+// @Param request body playFixtureRequestDTO false "Simulation seed"
+// @Success 201 {object} common.apiResponse{data=fixtureResponseDTO} "Created"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 403 {object} echo.HTTPError "Forbidden"
+// @Failure 404 {object} echo.HTTPError "Not Found"
+// @Failure 409 {object} echo.HTTPError "Conflict"
+// @Failure 422 {object} echo.HTTPError "Unprocessable Entity"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/leagues/{league_id}/fixtures/{fixture_id}/play [post]
+func (h *handler) PlayFixture(c echo.Context) error {
+	leagueId, err := strconv.ParseInt(c.Param("league_id"), 10, 64)
+	if err != nil {
+		return echo.ErrBadRequest.WithInternal(err)
+	}
+	fixtureId, err := strconv.ParseInt(c.Param("fixture_id"), 10, 64)
+	if err != nil {
+		return echo.ErrBadRequest.WithInternal(err)
+	}
+
+	var req playFixtureRequestDTO
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	seed := time.Now().UnixNano()
+	if req.Seed != nil {
+		seed = *req.Seed
+	}
+
+	fixture, err := h.leagueService.PlayFixture(c.Request().Context(), leagueId, fixtureId, seed)
+	if err != nil {
+		if errors.Is(err, service.ErrFixtureNotFound) {
+			return echo.ErrNotFound.WithInternal(err)
+		}
+		if errors.Is(err, service.ErrFixtureAlreadyPlayed) {
+			return echo.ErrConflict.WithInternal(err)
+		}
+		if errors.Is(err, service.ErrNotEnoughPlayers) {
+			return echo.ErrUnprocessableEntity.WithInternal(err)
+		}
+
+		return err
+	}
+
+	result := map[string]int64{
+		"league_id":    fixture.LeagueID,
+		"fixture_id":   fixture.ID,
+		"match_id":     fixture.MatchID,
+		"home_team_id": fixture.HomeTeamID,
+		"away_team_id": fixture.AwayTeamID,
+		"home_score":   int64(fixture.HomeScore),
+		"away_score":   int64(fixture.AwayScore),
+	}
+	for _, teamId := range []int64{fixture.HomeTeamID, fixture.AwayTeamID} {
+		h.eventEmitter.Publish(domain.EventONTEAMNOTICE, domain.TeamNotice{
+			TeamID:  teamId,
+			Kind:    domain.NotificationMatchPlayed,
+			Payload: result,
+		})
+	}
+	h.eventEmitter.Publish(domain.EventONMATCHPLAYED, fixture.MatchID)
+
+	return c.JSON(http.StatusCreated, common.NewApiResponse(fixtureResponseAdapter(fixture)))
+}
+
+// @Summary Get league standings
+// @Description Returns the league table sorted by points, goal difference and goals scored,
+// @Description form holds up to five latest results with the most recent one last
+// @Tags leagues
+// @Produce json
+// @Param league_id path int true "League ID"
+// @Success 200 {object} common.apiResponse{data=[]standingResponseDTO} "OK"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 404 {object} echo.HTTPError "Not Found"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/leagues/{league_id}/standings [get]
+func (h *handler) GetStandings(c echo.Context) error {
+	leagueId, err := strconv.ParseInt(c.Param("league_id"), 10, 64)
+	if err != nil {
+		return echo.ErrBadRequest.WithInternal(err)
+	}
+
+	standings, err := h.leagueService.GetStandings(c.Request().Context(), leagueId)
+	if err != nil {
+		if errors.Is(err, service.ErrLeagueNotFound) {
+			return echo.ErrNotFound.WithInternal(err)
+		}
+
+		return err
+	}
+
+	res := make([]standingResponseDTO, len(standings))
+	for i, s := range standings {
+		res[i] = standingResponseAdapter(i+1, s)
+	}
+
+	return c.JSON(http.StatusOK, common.NewApiResponse(res))
+}
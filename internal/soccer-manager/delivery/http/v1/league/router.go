@@ -0,0 +1,27 @@
+package league
+
+import (
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/delivery"
+	"github.com/labstack/echo/v4"
+)
+
+func RegisterRoutes(g *echo.Group, c *delivery.Components, m *delivery.Middlewares) {
+	h := newHandler(c.Services.LeagueService, c.EventBus, c.Cfg.Pagination.M, c.Cfg.Pagination.L)
+
+	g.GET("/leagues", h.GetLeagues)
+	g.GET("/leagues/:league_id", h.GetLeagueById)
+	g.GET("/leagues/:league_id/teams", h.GetLeagueTeams)
+	g.GET("/leagues/:league_id/fixtures", h.GetFixtures)
+	g.GET("/leagues/:league_id/standings", h.GetStandings)
+
+	g.POST("/leagues", h.CreateLeague, m.JWTMiddleware, m.IsAdmin)
+	g.POST("/leagues/:league_id/teams", h.EnrollTeam, m.JWTMiddleware, m.IsAdmin)
+	g.DELETE("/leagues/:league_id/teams/:team_id", h.UnenrollTeam, m.JWTMiddleware, m.IsAdmin)
+	g.POST("/leagues/:league_id/fixtures", h.GenerateFixtures, m.JWTMiddleware, m.IsAdmin)
+	g.POST(
+		"/leagues/:league_id/fixtures/:fixture_id/play",
+		h.PlayFixture,
+		m.JWTMiddleware,
+		m.IsAdmin,
+	)
+}
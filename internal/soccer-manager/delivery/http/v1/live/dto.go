@@ -0,0 +1,28 @@
+package live
+
+const (
+	actionSubscribe   = "subscribe"
+	actionUnsubscribe = "unsubscribe"
+
+	eventSubscribed   = "subscribed"
+	eventUnsubscribed = "unsubscribed"
+	eventError        = "error"
+)
+
+type socketRequestDTO struct {
+	Action string   `json:"action" example:"subscribe"`
+	Topics []string `json:"topics" example:"market,team:1,player:2"`
+} // @name SocketRequest
+
+type socketMessageDTO struct {
+	Event string `json:"event" example:"transfer.listed"`
+	Data  any    `json:"data"`
+} // @name SocketMessage
+
+type socketTopicsDTO struct {
+	Topics []string `json:"topics"`
+} // @name SocketTopics
+
+type socketErrorDTO struct {
+	Message string `json:"message"`
+} // @name SocketError
@@ -0,0 +1,353 @@
+package live
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/jwt/access"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/service"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/stream"
+	"github.com/hexley21/soccer-manager/pkg/config"
+	"github.com/labstack/echo/v4"
+)
+
+type handler struct {
+	teamService    service.TeamService
+	sessionService service.SessionService
+	accessManager  access.Manager
+	broker         *stream.Broker
+	upgrader       websocket.Upgrader
+	cfg            config.Stream
+}
+
+func newHandler(
+	teamService service.TeamService,
+	sessionService service.SessionService,
+	accessManager access.Manager,
+	broker *stream.Broker,
+	corsOrigins string,
+	cfg config.Stream,
+) *handler {
+	return &handler{
+		teamService:    teamService,
+		sessionService: sessionService,
+		accessManager:  accessManager,
+		broker:         broker,
+		upgrader: websocket.Upgrader{
+			CheckOrigin: func(r *http.Request) bool {
+				origin := r.Header.Get(echo.HeaderOrigin)
+				return origin == "" || corsOrigins == "*" || origin == corsOrigins
+			},
+		},
+		cfg: cfg,
+	}
+}
+
+// @Summary Live event stream
+// @Description Server-Sent Events of the market: transfer.listed, transfer.price_changed, transfer.expired, transfer.sold and auction.settled.
+// @Description With an access token the stream also carries private notification events and the events of the user's team, match.played and team.updated included.
+// @Description Every event's data is a JSON object, stream.lagged tells how many events a slow client missed
+// @Tags stream
+// @Produce text/event-stream
+// @Param Authorization header string false "Bearer access token"
+// @Param access_token query string false "Access token, for clients that can't set headers"
+// @Success 200 {object} stream.TransferPayload "transfer.* events"
+// @Success 200 {object} stream.SalePayload "transfer.sold event"
+// @Success 200 {object} stream.AuctionPayload "auction.settled event"
+// @Success 200 {object} stream.MatchPayload "match.played event"
+// @Success 200 {object} stream.TeamPayload "team.updated event"
+// @Success 200 {object} stream.NotificationPayload "notification event"
+// @Success 200 {object} stream.LaggedPayload "stream.lagged event"
+// @Failure 401 {object} echo.HTTPError "Unauthorized"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/stream [get]
+func (h *handler) Stream(c echo.Context) error {
+	topics, err := h.topics(c)
+	if err != nil {
+		return err
+	}
+
+	// the stream outlives the server write timeout
+	err = http.NewResponseController(c.Response()).SetWriteDeadline(time.Time{})
+	if err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+
+	sub := h.broker.Subscribe(topics...)
+	defer h.broker.Unsubscribe(sub)
+
+	res := c.Response()
+	res.Header().Set(echo.HeaderContentType, "text/event-stream")
+	res.Header().Set("Cache-Control", "no-cache")
+	res.Header().Set("Connection", "keep-alive")
+	res.Header().Set("X-Accel-Buffering", "no")
+	res.WriteHeader(http.StatusOK)
+	res.Flush()
+
+	ticker := time.NewTicker(h.cfg.Heartbeat)
+	defer ticker.Stop()
+
+	var reported uint64
+	for {
+		select {
+		case <-c.Request().Context().Done():
+			return nil
+		case <-ticker.C:
+			if _, err := fmt.Fprint(res, ": heartbeat\n\n"); err != nil {
+				return nil
+			}
+			res.Flush()
+		case msg, ok := <-sub.C():
+			if !ok {
+				return nil
+			}
+
+			// let the client know it missed events before sending the next one
+			if dropped := sub.Dropped(); dropped > reported {
+				if err := writeEvent(res, stream.Lagged(dropped-reported)); err != nil {
+					return nil
+				}
+				reported = dropped
+			}
+
+			if err := writeEvent(res, msg); err != nil {
+				return nil
+			}
+			res.Flush()
+		}
+	}
+}
+
+// topics resolves what the caller may follow, an invalid token is rejected rather than downgraded
+func (h *handler) topics(c echo.Context) ([]string, error) {
+	topics := []string{stream.TopicMarket}
+
+	user, ok, err := h.authenticate(c)
+	if err != nil || !ok {
+		return topics, err
+	}
+	topics = append(topics, stream.UserTopic(user.UserID))
+
+	team, err := h.teamService.GetTeamByUserId(c.Request().Context(), domain.LocaleCode(""), user.UserID)
+	if err != nil {
+		// the team is created asynchronously after signup
+		if errors.Is(err, service.ErrTeamNotFound) {
+			return topics, nil
+		}
+		return nil, err
+	}
+
+	return append(topics, stream.TeamTopic(team.ID)), nil
+}
+
+// authenticate reads the optional access token, ok is false for anonymous callers
+func (h *handler) authenticate(c echo.Context) (access.Data, bool, error) {
+	token := strings.TrimPrefix(c.Request().Header.Get(echo.HeaderAuthorization), "Bearer ")
+	if token == "" {
+		token = c.QueryParam("access_token")
+	}
+	if token == "" {
+		return access.Data{}, false, nil
+	}
+
+	user, err := h.accessManager.ParseTokenString(token)
+	if err != nil {
+		return access.Data{}, false, echo.ErrUnauthorized.WithInternal(err)
+	}
+
+	if err := h.sessionService.CheckSession(c.Request().Context(), user.UserID, user.SessionID); err != nil {
+		if errors.Is(err, service.ErrSessionNotFound) {
+			return access.Data{}, false, echo.ErrUnauthorized.WithInternal(err)
+		}
+		return access.Data{}, false, err
+	}
+
+	return user, true, nil
+}
+
+func writeEvent(res *echo.Response, msg stream.Message) error {
+	data, err := json.Marshal(msg.Data)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(res, "event: %s\ndata: %s\n\n", msg.Event, data)
+	return err
+}
+
+// socketReadLimit bounds a single client message, subscription requests are tiny
+const socketReadLimit = 4096
+
+// @Summary Live event socket
+// @Description WebSocket feed of the same events as /v1/stream, every message is {"event": ..., "data": ...}.
+// @Description Send {"action": "subscribe" | "unsubscribe", "topics": [...]} to follow market, team:<id> or player:<id>, each request is answered with subscribed, unsubscribed or error.
+// @Description With an access token private notification events arrive without subscribing.
+// @Description A client that can't keep up loses events and is told so by stream.lagged
+// @Tags stream
+// @Param Authorization header string false "Bearer access token"
+// @Param access_token query string false "Access token, for clients that can't set headers"
+// @Param request body socketRequestDTO false "Client message"
+// @Success 101 {object} socketMessageDTO "Switching Protocols"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 401 {object} echo.HTTPError "Unauthorized"
+// @Failure 403 {object} echo.HTTPError "Forbidden"
+// @Router /v1/ws [get]
+func (h *handler) Socket(c echo.Context) error {
+	user, ok, err := h.authenticate(c)
+	if err != nil {
+		return err
+	}
+
+	// the upgrader replies on failure by itself
+	conn, err := h.upgrader.Upgrade(c.Response(), c.Request(), nil)
+	if err != nil {
+		return nil
+	}
+	defer conn.Close()
+
+	sub := h.broker.Subscribe()
+	defer h.broker.Unsubscribe(sub)
+	if ok {
+		sub.Follow(stream.UserTopic(user.UserID))
+	}
+
+	replies := make(chan socketMessageDTO, 1)
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	defer close(stop)
+
+	go func() {
+		defer close(done)
+		h.readSocket(conn, sub, replies, stop)
+	}()
+
+	ticker := time.NewTicker(h.cfg.Heartbeat)
+	defer ticker.Stop()
+
+	var reported uint64
+	for {
+		select {
+		case <-done:
+			return nil
+		case <-ticker.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(h.cfg.WriteTimeout)); err != nil {
+				return nil
+			}
+		case reply := <-replies:
+			if err := h.writeSocket(conn, reply); err != nil {
+				return nil
+			}
+		case msg, open := <-sub.C():
+			if !open {
+				_ = conn.WriteControl(
+					websocket.CloseMessage,
+					websocket.FormatCloseMessage(websocket.CloseGoingAway, ""),
+					time.Now().Add(h.cfg.WriteTimeout),
+				)
+				return nil
+			}
+
+			// let the client know it missed events before sending the next one
+			if dropped := sub.Dropped(); dropped > reported {
+				lagged := stream.Lagged(dropped - reported)
+				if err := h.writeSocket(conn, socketMessageDTO{Event: lagged.Event, Data: lagged.Data}); err != nil {
+					return nil
+				}
+				reported = dropped
+			}
+
+			if err := h.writeSocket(conn, socketMessageDTO{Event: msg.Event, Data: msg.Data}); err != nil {
+				return nil
+			}
+		}
+	}
+}
+
+// readSocket applies subscription requests until the connection breaks or the writer stops
+func (h *handler) readSocket(
+	conn *websocket.Conn,
+	sub *stream.Subscription,
+	replies chan<- socketMessageDTO,
+	stop <-chan struct{},
+) {
+	pongWait := 2 * h.cfg.Heartbeat
+
+	conn.SetReadLimit(socketReadLimit)
+	_ = conn.SetReadDeadline(time.Now().Add(pongWait))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(pongWait))
+	})
+
+	for {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		_ = conn.SetReadDeadline(time.Now().Add(pongWait))
+
+		var reply socketMessageDTO
+		var req socketRequestDTO
+		if err := json.Unmarshal(data, &req); err != nil {
+			reply = socketError("malformed request")
+		} else {
+			reply = h.applySocketRequest(sub, req)
+		}
+
+		select {
+		case replies <- reply:
+		case <-stop:
+			return
+		}
+	}
+}
+
+func (h *handler) applySocketRequest(sub *stream.Subscription, req socketRequestDTO) socketMessageDTO {
+	topics := make([]string, len(req.Topics))
+	for i, t := range req.Topics {
+		topic, err := stream.ParseTopic(t)
+		if err != nil {
+			return socketError(fmt.Sprintf("%v: %q", err, t))
+		}
+		topics[i] = topic
+	}
+
+	switch req.Action {
+	case actionSubscribe:
+		following := make(map[string]struct{})
+		for _, t := range append(sub.Topics(), topics...) {
+			following[t] = struct{}{}
+		}
+		if len(following) > h.cfg.MaxTopics {
+			return socketError(fmt.Sprintf("at most %d topics can be followed", h.cfg.MaxTopics))
+		}
+
+		sub.Follow(topics...)
+		return socketMessageDTO{Event: eventSubscribed, Data: socketTopicsDTO{Topics: sub.Topics()}}
+
+	case actionUnsubscribe:
+		sub.Unfollow(topics...)
+		return socketMessageDTO{Event: eventUnsubscribed, Data: socketTopicsDTO{Topics: sub.Topics()}}
+
+	default:
+		return socketError(fmt.Sprintf("unknown action: %q", req.Action))
+	}
+}
+
+// writeSocket gives up on clients that don't read, so they can't hold the connection's writer forever
+func (h *handler) writeSocket(conn *websocket.Conn, msg socketMessageDTO) error {
+	if err := conn.SetWriteDeadline(time.Now().Add(h.cfg.WriteTimeout)); err != nil {
+		return err
+	}
+
+	return conn.WriteJSON(msg)
+}
+
+func socketError(message string) socketMessageDTO {
+	return socketMessageDTO{Event: eventError, Data: socketErrorDTO{Message: message}}
+}
@@ -0,0 +1,20 @@
+package live
+
+import (
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/delivery"
+	"github.com/labstack/echo/v4"
+)
+
+func RegisterRoutes(g *echo.Group, c *delivery.Components) {
+	h := newHandler(
+		c.Services.TeamService,
+		c.Services.SessionService,
+		c.JWTManagers.Access,
+		c.Broker,
+		c.Cfg.HTTP.CorsOrigins,
+		c.Cfg.Stream,
+	)
+
+	g.GET("/stream", h.Stream)
+	g.GET("/ws", h.Socket)
+}
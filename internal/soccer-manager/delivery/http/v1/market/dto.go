@@ -0,0 +1,103 @@
+package market
+
+import (
+	"time"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
+)
+
+type marketStatsResponseDTO struct {
+	Since                    time.Time                        `json:"since"`
+	GeneratedAt              time.Time                        `json:"generated_at"`
+	DailyVolume              []marketDayVolumeResponseDTO     `json:"daily_volume"`
+	AveragePriceByPosition   []marketPositionPriceResponseDTO `json:"average_price_by_position"`
+	TopTransfers             []marketTransferResponseDTO      `json:"top_transfers"`
+	TopSpenders              []marketTeamTotalResponseDTO     `json:"top_spenders"`
+	TopSellers               []marketTeamTotalResponseDTO     `json:"top_sellers"`
+	AverageTimeToSellSeconds int64                            `json:"average_time_to_sell_seconds"`
+} // @name MarketStatsResponse
+
+func marketStatsResponseAdapter(model domain.MarketStats) marketStatsResponseDTO {
+	res := marketStatsResponseDTO{
+		Since:                    model.Since,
+		GeneratedAt:              model.GeneratedAt,
+		DailyVolume:              make([]marketDayVolumeResponseDTO, len(model.DailyVolume)),
+		AveragePriceByPosition:   make([]marketPositionPriceResponseDTO, len(model.AveragePriceByPosition)),
+		TopTransfers:             make([]marketTransferResponseDTO, len(model.TopTransfers)),
+		TopSpenders:              make([]marketTeamTotalResponseDTO, len(model.TopSpenders)),
+		TopSellers:               make([]marketTeamTotalResponseDTO, len(model.TopSellers)),
+		AverageTimeToSellSeconds: int64(model.AverageTimeToSell.Seconds()),
+	}
+
+	for i, v := range model.DailyVolume {
+		res.DailyVolume[i] = marketDayVolumeResponseDTO{
+			Day:    v.Day,
+			Sales:  v.Sales,
+			Volume: v.Volume,
+		}
+	}
+	for i, p := range model.AveragePriceByPosition {
+		res.AveragePriceByPosition[i] = marketPositionPriceResponseDTO{
+			PositionCode: string(p.PositionCode),
+			Sales:        p.Sales,
+			AveragePrice: p.AveragePrice,
+		}
+	}
+	for i, r := range model.TopTransfers {
+		res.TopTransfers[i] = marketTransferResponseDTO{
+			ID:           r.ID,
+			PlayerID:     r.PlayerID,
+			SellerTeamID: r.SellerTeamID,
+			BuyerTeamID:  r.BuyerTeamID,
+			SoldPrice:    r.SoldPrice,
+			Tax:          r.Tax,
+			ListedAt:     r.ListedAt,
+			SoldAt:       r.SoldAt,
+		}
+	}
+	for i, t := range model.TopSpenders {
+		res.TopSpenders[i] = marketTeamTotalResponseAdapter(t)
+	}
+	for i, t := range model.TopSellers {
+		res.TopSellers[i] = marketTeamTotalResponseAdapter(t)
+	}
+
+	return res
+}
+
+type marketDayVolumeResponseDTO struct {
+	Day    time.Time `json:"day"`
+	Sales  int64     `json:"sales"`
+	Volume int64     `json:"volume"`
+} // @name MarketDayVolumeResponse
+
+type marketPositionPriceResponseDTO struct {
+	PositionCode string `json:"position_code"`
+	Sales        int64  `json:"sales"`
+	AveragePrice int64  `json:"average_price"`
+} // @name MarketPositionPriceResponse
+
+type marketTransferResponseDTO struct {
+	ID           int64     `json:"id"`
+	PlayerID     int64     `json:"player_id"`
+	SellerTeamID int64     `json:"seller_team_id"`
+	BuyerTeamID  int64     `json:"buyer_team_id"`
+	SoldPrice    int64     `json:"sold_price"`
+	Tax          int64     `json:"tax"`
+	ListedAt     time.Time `json:"listed_at"`
+	SoldAt       time.Time `json:"sold_at"`
+} // @name MarketTransferResponse
+
+type marketTeamTotalResponseDTO struct {
+	TeamID    int64 `json:"team_id"`
+	Transfers int64 `json:"transfers"`
+	Total     int64 `json:"total"`
+} // @name MarketTeamTotalResponse
+
+func marketTeamTotalResponseAdapter(model domain.MarketTeamTotal) marketTeamTotalResponseDTO {
+	return marketTeamTotalResponseDTO{
+		TeamID:    model.TeamID,
+		Transfers: model.Transfers,
+		Total:     model.Total,
+	}
+}
@@ -0,0 +1,56 @@
+package market
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hexley21/soccer-manager/internal/common"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/service"
+	"github.com/labstack/echo/v4"
+)
+
+const defaultStatsDays = 30
+
+type handler struct {
+	marketStatsService service.MarketStatsService
+	statsTTL           time.Duration
+}
+
+func newHandler(marketStatsService service.MarketStatsService, statsTTL time.Duration) *handler {
+	return &handler{
+		marketStatsService: marketStatsService,
+		statsTTL:           statsTTL,
+	}
+}
+
+// @Summary Market statistics
+// @Description Aggregates sales of the last days: daily volume, average price by position, top transfers, spenders, sellers and average time to sell. Results are cached
+// @Tags market
+// @Produce json
+// @Param days query int false "Number of days to aggregate, defaults to 30"
+// @Success 200 {object} common.apiResponse{data=marketStatsResponseDTO} "OK"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/market/stats [get]
+func (h *handler) GetMarketStats(c echo.Context) error {
+	days, err := common.ParseQueryInt(c, "days", 32)
+	if err != nil {
+		return err
+	}
+	if days == 0 {
+		days = defaultStatsDays
+	}
+
+	stats, err := h.marketStatsService.GetMarketStats(c.Request().Context(), int32(days))
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidArguments) {
+			return echo.ErrBadRequest.WithInternal(err)
+		}
+		return err
+	}
+
+	c.Response().Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int(h.statsTTL.Seconds())))
+	return c.JSON(http.StatusOK, common.NewApiResponse(marketStatsResponseAdapter(stats)))
+}
@@ -0,0 +1,12 @@
+package market
+
+import (
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/delivery"
+	"github.com/labstack/echo/v4"
+)
+
+func RegisterRoutes(g *echo.Group, c *delivery.Components) {
+	h := newHandler(c.Services.MarketStatsService, c.Cfg.Market.StatsTTL)
+
+	g.GET("/market/stats", h.GetMarketStats)
+}
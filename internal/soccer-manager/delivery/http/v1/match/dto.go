@@ -0,0 +1,59 @@
+package match
+
+import (
+	"time"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
+)
+
+type matchResponseDTO struct {
+	ID         int64                   `json:"id"`
+	HomeTeamID int64                   `json:"home_team_id"`
+	AwayTeamID int64                   `json:"away_team_id"`
+	Seed       int64                   `json:"seed"`
+	HomeScore  int32                   `json:"home_score"`
+	AwayScore  int32                   `json:"away_score"`
+	PlayedAt   time.Time               `json:"played_at"`
+	Events     []matchEventResponseDTO `json:"events,omitempty"`
+} // @name MatchResponse
+
+type matchEventResponseDTO struct {
+	Minute          int32                 `json:"minute"`
+	Type            domain.MatchEventType `json:"type"`
+	TeamID          int64                 `json:"team_id"`
+	PlayerID        int64                 `json:"player_id,omitempty"`
+	RelatedPlayerID int64                 `json:"related_player_id,omitempty"`
+} // @name MatchEventResponse
+
+func matchResponseAdapter(model domain.Match) matchResponseDTO {
+	res := matchResponseDTO{
+		ID:         model.ID,
+		HomeTeamID: model.HomeTeamID,
+		AwayTeamID: model.AwayTeamID,
+		Seed:       model.Seed,
+		HomeScore:  model.HomeScore,
+		AwayScore:  model.AwayScore,
+		PlayedAt:   model.PlayedAt,
+	}
+
+	if len(model.Events) != 0 {
+		res.Events = make([]matchEventResponseDTO, len(model.Events))
+		for i, e := range model.Events {
+			res.Events[i] = matchEventResponseDTO{
+				Minute:          e.Minute,
+				Type:            e.Type,
+				TeamID:          e.TeamID,
+				PlayerID:        e.PlayerID,
+				RelatedPlayerID: e.RelatedPlayerID,
+			}
+		}
+	}
+
+	return res
+}
+
+type createMatchRequestDTO struct {
+	HomeTeamID int64  `json:"home_team_id" validate:"required"`
+	AwayTeamID int64  `json:"away_team_id" validate:"required,nefield=HomeTeamID"`
+	Seed       *int64 `json:"seed"`
+} // @name CreateMatchRequest
@@ -0,0 +1,204 @@
+package match
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	evbus "github.com/asaskevich/EventBus"
+	"github.com/hexley21/soccer-manager/internal/common"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/service"
+	"github.com/labstack/echo/v4"
+)
+
+type handler struct {
+	matchService service.MatchService
+	eventEmitter evbus.BusPublisher
+	pageSize     int32
+	pageLimit    int32
+}
+
+func newHandler(
+	matchService service.MatchService,
+	eventEmitter evbus.BusPublisher,
+	pageSize int32,
+	pageLimit int32,
+) *handler {
+	return &handler{
+		matchService: matchService,
+		eventEmitter: eventEmitter,
+		pageSize:     pageSize,
+		pageLimit:    pageLimit,
+	}
+}
+
+// @Summary List all matches
+// @Description Returns a list of played matches without timelines (paginated)
+// @Tags matches
+// @Produce json
+// @Param cursor query int false "Pagination cursor"
+// @Param page_size query int false "Number of items per page"
+// @Success 200 {object} common.apiResponse{data=[]matchResponseDTO} "OK"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/matches [get]
+func (h *handler) GetMatches(c echo.Context) error {
+	pagination, err := common.ParsePagination(c, h.pageSize, h.pageLimit)
+	if err != nil {
+		return err
+	}
+
+	matches, err := h.matchService.ListMatches(
+		c.Request().Context(),
+		pagination.Cursor,
+		pagination.PageSize,
+	)
+	if err != nil {
+		return err
+	}
+
+	res := make([]matchResponseDTO, len(matches))
+	for i, m := range matches {
+		res[i] = matchResponseAdapter(m)
+	}
+
+	return c.JSON(http.StatusOK, common.NewApiResponse(res))
+}
+
+// @Summary Get match with id
+// @Description Returns a match by id together with its timeline
+// @Tags matches
+// @Produce json
+// @Param match_id path int true "Match ID"
+// @Success 200 {object} common.apiResponse{data=matchResponseDTO} "OK"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 404 {object} echo.HTTPError "Not Found"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/matches/{match_id} [get]
+func (h *handler) GetMatchById(c echo.Context) error {
+	matchId, err := strconv.ParseInt(c.Param("match_id"), 10, 64)
+	if err != nil {
+		return echo.ErrBadRequest.WithInternal(err)
+	}
+
+	match, err := h.matchService.GetMatchByID(c.Request().Context(), matchId)
+	if err != nil {
+		if errors.Is(err, service.ErrMatchNotFound) {
+			return echo.ErrNotFound.WithInternal(err)
+		}
+
+		return err
+	}
+
+	return c.JSON(http.StatusOK, common.NewApiResponse(matchResponseAdapter(match)))
+}
+
+// @Summary List matches by team ID
+// @Description Returns a list of home and away matches of the team (paginated)
+// @Tags matches
+// @Produce json
+// @Param team_id path int true "Team ID"
+// @Param cursor query int false "Pagination cursor"
+// @Param page_size query int false "Number of items per page"
+// @Success 200 {object} common.apiResponse{data=[]matchResponseDTO} "OK"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/teams/{team_id}/matches [get]
+func (h *handler) GetMatchesByTeamId(c echo.Context) error {
+	teamId, err := strconv.ParseInt(c.Param("team_id"), 10, 64)
+	if err != nil {
+		return echo.ErrBadRequest.WithInternal(err)
+	}
+
+	pagination, err := common.ParsePagination(c, h.pageSize, h.pageLimit)
+	if err != nil {
+		return err
+	}
+
+	matches, err := h.matchService.ListMatchesByTeamID(
+		c.Request().Context(),
+		teamId,
+		pagination.Cursor,
+		pagination.PageSize,
+	)
+	if err != nil {
+		return err
+	}
+
+	res := make([]matchResponseDTO, len(matches))
+	for i, m := range matches {
+		res[i] = matchResponseAdapter(m)
+	}
+
+	return c.JSON(http.StatusOK, common.NewApiResponse(res))
+}
+
+// @Summary Play a match (ADMIN)
+// @Description Simulates a match between two teams and stores the result,
+// @Description the same seed always produces the same match, random seed is used if omitted
+// @Tags matches
+// @Accept json
+// @Produce json
+// @Security AccessToken
+// @Param request body createMatchRequestDTO true "Match details"
+// @Success 201 {object} common.apiResponse{data=matchResponseDTO} "Created"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 403 {object} echo.HTTPError "Forbidden"
+// @Failure 404 {object} echo.HTTPError "Not Found"
+// @Failure 422 {object} echo.HTTPError "Unprocessable Entity"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/matches [post]
+func (h *handler) CreateMatch(c echo.Context) error {
+	var req createMatchRequestDTO
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+	if err := c.Validate(&req); err != nil {
+		return echo.ErrBadRequest.WithInternal(err)
+	}
+
+	seed := time.Now().UnixNano()
+	if req.Seed != nil {
+		seed = *req.Seed
+	}
+
+	match, err := h.matchService.PlayMatch(
+		c.Request().Context(),
+		req.HomeTeamID,
+		req.AwayTeamID,
+		seed,
+	)
+	if err != nil {
+		if errors.Is(err, service.ErrCantPlayYourself) {
+			return echo.ErrBadRequest.WithInternal(err)
+		}
+		if errors.Is(err, service.ErrTeamNotFound) {
+			return echo.ErrNotFound.WithInternal(err)
+		}
+		if errors.Is(err, service.ErrNotEnoughPlayers) {
+			return echo.ErrUnprocessableEntity.WithInternal(err)
+		}
+
+		return err
+	}
+
+	result := map[string]int64{
+		"match_id":     match.ID,
+		"home_team_id": match.HomeTeamID,
+		"away_team_id": match.AwayTeamID,
+		"home_score":   int64(match.HomeScore),
+		"away_score":   int64(match.AwayScore),
+	}
+	for _, teamId := range []int64{match.HomeTeamID, match.AwayTeamID} {
+		h.eventEmitter.Publish(domain.EventONTEAMNOTICE, domain.TeamNotice{
+			TeamID:  teamId,
+			Kind:    domain.NotificationMatchPlayed,
+			Payload: result,
+		})
+	}
+	h.eventEmitter.Publish(domain.EventONMATCHPLAYED, match.ID)
+
+	return c.JSON(http.StatusCreated, common.NewApiResponse(matchResponseAdapter(match)))
+}
@@ -0,0 +1,16 @@
+package match
+
+import (
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/delivery"
+	"github.com/labstack/echo/v4"
+)
+
+func RegisterRoutes(g *echo.Group, c *delivery.Components, m *delivery.Middlewares) {
+	h := newHandler(c.Services.MatchService, c.EventBus, c.Cfg.Pagination.M, c.Cfg.Pagination.L)
+
+	g.GET("/matches", h.GetMatches)
+	g.GET("/matches/:match_id", h.GetMatchById)
+	g.GET("/teams/:team_id/matches", h.GetMatchesByTeamId)
+
+	g.POST("/matches", h.CreateMatch, m.JWTMiddleware, m.IsAdmin)
+}
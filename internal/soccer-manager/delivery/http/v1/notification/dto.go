@@ -0,0 +1,54 @@
+package notification
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
+)
+
+type notificationResponseDTO struct {
+	ID        int64           `json:"id"`
+	Kind      string          `json:"kind"`
+	Payload   json.RawMessage `json:"payload"   swaggertype:"object"`
+	Read      bool            `json:"read"`
+	ReadAt    *time.Time      `json:"read_at,omitempty"`
+	CreatedAt time.Time       `json:"created_at"`
+} // @name NotificationResponse
+
+func notificationResponseAdapter(model domain.Notification) notificationResponseDTO {
+	res := notificationResponseDTO{
+		ID:        model.ID,
+		Kind:      string(model.Kind),
+		Payload:   model.Payload,
+		CreatedAt: model.CreatedAt,
+	}
+	if !model.ReadAt.IsZero() {
+		readAt := model.ReadAt
+		res.Read = true
+		res.ReadAt = &readAt
+	}
+
+	return res
+}
+
+type inboxResponseDTO struct {
+	Unread        int64                     `json:"unread"`
+	Notifications []notificationResponseDTO `json:"notifications"`
+} // @name NotificationInboxResponse
+
+func inboxResponseAdapter(model domain.NotificationInbox) inboxResponseDTO {
+	res := inboxResponseDTO{
+		Unread:        model.Unread,
+		Notifications: make([]notificationResponseDTO, len(model.Notifications)),
+	}
+	for i, n := range model.Notifications {
+		res.Notifications[i] = notificationResponseAdapter(n)
+	}
+
+	return res
+}
+
+type markAllReadResponseDTO struct {
+	Marked int64 `json:"marked"`
+} // @name MarkAllNotificationsReadResponse
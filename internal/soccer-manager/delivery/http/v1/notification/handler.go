@@ -0,0 +1,128 @@
+package notification
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/hexley21/soccer-manager/internal/common"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/jwt/access"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/service"
+	"github.com/labstack/echo/v4"
+)
+
+type handler struct {
+	notificationService service.NotificationService
+	pageSize            int32
+	pageLimit           int32
+}
+
+func newHandler(notificationService service.NotificationService, pageSize int32, pageLimit int32) *handler {
+	return &handler{
+		notificationService: notificationService,
+		pageSize:            pageSize,
+		pageLimit:           pageLimit,
+	}
+}
+
+// @Summary Get my notifications
+// @Description Returns notifications newest first (paginated) with the number of unread ones, the cursor is the last notification id of the previous page
+// @Tags notifications
+// @Security AccessToken
+// @Produce json
+// @Param cursor query int false "Pagination cursor"
+// @Param page_size query int false "Number of items per page"
+// @Param unread query bool false "Only unread notifications"
+// @Success 200 {object} common.apiResponse{data=inboxResponseDTO} "OK"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 401 {object} echo.HTTPError "Unauthorized"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/users/me/notifications [get]
+func (h *handler) GetNotifications(c echo.Context) error {
+	userData, ok := c.Get(access.CtxKey).(access.Data)
+	if !ok {
+		return echo.ErrUnauthorized.WithInternal(access.NewInvalidTokenError(userData))
+	}
+
+	pagination, err := common.ParsePagination(c, h.pageSize, h.pageLimit)
+	if err != nil {
+		return err
+	}
+
+	var unreadOnly bool
+	if q := c.QueryParam("unread"); q != "" {
+		unreadOnly, err = strconv.ParseBool(q)
+		if err != nil {
+			return echo.ErrBadRequest.WithInternal(fmt.Errorf("invalid unread: %w", err))
+		}
+	}
+
+	inbox, err := h.notificationService.GetInbox(
+		c.Request().Context(),
+		userData.UserID,
+		unreadOnly,
+		pagination.Cursor,
+		pagination.PageSize,
+	)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, common.NewApiResponse(inboxResponseAdapter(inbox)))
+}
+
+// @Summary Mark notification as read
+// @Description Marks one of my notifications as read
+// @Tags notifications
+// @Security AccessToken
+// @Param notification_id path int true "Notification ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 401 {object} echo.HTTPError "Unauthorized"
+// @Failure 404 {object} echo.HTTPError "Not Found"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/users/me/notifications/{notification_id}/read [post]
+func (h *handler) MarkRead(c echo.Context) error {
+	notificationId, err := strconv.ParseInt(c.Param("notification_id"), 10, 64)
+	if err != nil {
+		return echo.ErrBadRequest.WithInternal(err)
+	}
+	userData, ok := c.Get(access.CtxKey).(access.Data)
+	if !ok {
+		return echo.ErrUnauthorized.WithInternal(access.NewInvalidTokenError(userData))
+	}
+
+	if err := h.notificationService.MarkRead(c.Request().Context(), userData.UserID, notificationId); err != nil {
+		if errors.Is(err, service.ErrNotificationNotFound) {
+			return echo.ErrNotFound.WithInternal(err)
+		}
+
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// @Summary Mark all notifications as read
+// @Description Marks all of my unread notifications as read
+// @Tags notifications
+// @Security AccessToken
+// @Produce json
+// @Success 200 {object} common.apiResponse{data=markAllReadResponseDTO} "OK"
+// @Failure 401 {object} echo.HTTPError "Unauthorized"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/users/me/notifications/read [post]
+func (h *handler) MarkAllRead(c echo.Context) error {
+	userData, ok := c.Get(access.CtxKey).(access.Data)
+	if !ok {
+		return echo.ErrUnauthorized.WithInternal(access.NewInvalidTokenError(userData))
+	}
+
+	marked, err := h.notificationService.MarkAllRead(c.Request().Context(), userData.UserID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, common.NewApiResponse(markAllReadResponseDTO{Marked: marked}))
+}
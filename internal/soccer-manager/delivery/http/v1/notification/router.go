@@ -0,0 +1,14 @@
+package notification
+
+import (
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/delivery"
+	"github.com/labstack/echo/v4"
+)
+
+func RegisterRoutes(g *echo.Group, c *delivery.Components, m *delivery.Middlewares) {
+	h := newHandler(c.Services.NotificationService, c.Cfg.Pagination.M, c.Cfg.Pagination.L)
+
+	g.GET("/users/me/notifications", h.GetNotifications, m.JWTMiddleware)
+	g.POST("/users/me/notifications/read", h.MarkAllRead, m.JWTMiddleware)
+	g.POST("/users/me/notifications/:notification_id/read", h.MarkRead, m.JWTMiddleware)
+}
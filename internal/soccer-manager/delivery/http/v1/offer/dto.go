@@ -0,0 +1,36 @@
+package offer
+
+import (
+	"time"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
+	"github.com/shopspring/decimal"
+)
+
+type offerResponseDTO struct {
+	ID           int64              `json:"id"`
+	PlayerID     int64              `json:"player_id"`
+	SellerTeamID int64              `json:"seller_team_id"`
+	BuyerTeamID  int64              `json:"buyer_team_id"`
+	Amount       int64              `json:"amount"`
+	Status       domain.OfferStatus `json:"status"`
+	CreatedAt    time.Time          `json:"created_at"`
+	UpdatedAt    time.Time          `json:"updated_at"`
+} // @name OfferResponse
+
+func offerResponseAdapter(model domain.Offer) offerResponseDTO {
+	return offerResponseDTO{
+		ID:           model.ID,
+		PlayerID:     model.PlayerID,
+		SellerTeamID: model.SellerTeamID,
+		BuyerTeamID:  model.BuyerTeamID,
+		Amount:       model.Amount,
+		Status:       model.Status,
+		CreatedAt:    model.CreatedAt,
+		UpdatedAt:    model.UpdatedAt,
+	}
+}
+
+type placeOfferRequestDTO struct {
+	Amount decimal.Decimal `json:"amount" validate:"required,dgte=1"`
+} // @name PlaceOfferRequest
@@ -0,0 +1,297 @@
+package offer
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/hexley21/soccer-manager/internal/common"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/jwt/access"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/service"
+	"github.com/labstack/echo/v4"
+)
+
+type handler struct {
+	offerService service.OfferService
+	pageSize     int32
+	pageLimit    int32
+}
+
+func newHandler(offerService service.OfferService, pageSize int32, pageLimit int32) *handler {
+	return &handler{
+		offerService: offerService,
+		pageSize:     pageSize,
+		pageLimit:    pageLimit,
+	}
+}
+
+// @Summary List offers for player
+// @Description Returns direct offers for the player, only the owner can see them (paginated)
+// @Tags offers
+// @Produce json
+// @Security AccessToken
+// @Param player_id path int true "Player ID"
+// @Param cursor query int false "Pagination cursor"
+// @Param page_size query int false "Number of items per page"
+// @Success 200 {object} common.apiResponse{data=[]offerResponseDTO} "OK"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 401 {object} echo.HTTPError "Unauthorized"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/players/{player_id}/offers [get]
+func (h *handler) GetOffersByPlayerId(c echo.Context) error {
+	playerId, err := strconv.ParseInt(c.Param("player_id"), 10, 64)
+	if err != nil {
+		return echo.ErrBadRequest.WithInternal(err)
+	}
+
+	return h.listOffers(c, func(ctx context.Context, userID, cursor int64, limit int32) ([]domain.Offer, error) {
+		return h.offerService.ListOffersByPlayerID(ctx, playerId, userID, cursor, limit)
+	})
+}
+
+// @Summary Make an offer
+// @Description Makes a direct offer for a player that is not listed, the amount is reserved from your budget
+// @Tags offers
+// @Accept json
+// @Produce json
+// @Security AccessToken
+// @Param player_id path int true "Player ID"
+// @Param request body placeOfferRequestDTO true "Offer amount"
+// @Success 201 {object} common.apiResponse{data=offerResponseDTO} "Created"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 401 {object} echo.HTTPError "Unauthorized"
+// @Failure 402 {object} echo.HTTPError "Payment Required"
+// @Failure 403 {object} echo.HTTPError "Forbidden"
+// @Failure 404 {object} echo.HTTPError "Not Found"
+// @Failure 409 {object} echo.HTTPError "Conflict"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/players/{player_id}/offers [post]
+func (h *handler) PlaceOffer(c echo.Context) error {
+	playerId, err := strconv.ParseInt(c.Param("player_id"), 10, 64)
+	if err != nil {
+		return echo.ErrBadRequest.WithInternal(err)
+	}
+
+	userData, ok := c.Get(access.CtxKey).(access.Data)
+	if !ok {
+		return echo.ErrUnauthorized.WithInternal(access.NewInvalidTokenError(userData))
+	}
+
+	var req placeOfferRequestDTO
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+	if err := c.Validate(&req); err != nil {
+		return echo.ErrBadRequest.WithInternal(err)
+	}
+
+	offer, err := h.offerService.PlaceOffer(
+		c.Request().Context(),
+		playerId,
+		userData.UserID,
+		req.Amount.IntPart(),
+	)
+	if err != nil {
+		if errors.Is(err, service.ErrPlayerNotFound) {
+			return echo.ErrNotFound.WithInternal(err)
+		}
+		if errors.Is(err, service.ErrCantBuyFromYourself) ||
+			errors.Is(err, service.ErrPlayerIsListed) ||
+			errors.Is(err, service.ErrOfferAlreadyPlaced) {
+			return echo.ErrConflict.WithInternal(err)
+		}
+		if errors.Is(err, service.ErrNotEnoughFunds) {
+			return echo.ErrPaymentRequired.WithInternal(err)
+		}
+		if errors.Is(err, service.ErrTransferWindowClosed) {
+			return echo.ErrForbidden.WithInternal(err)
+		}
+		if errors.Is(err, service.ErrInvalidArguments) {
+			return echo.ErrBadRequest.WithInternal(err)
+		}
+
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, common.NewApiResponse(offerResponseAdapter(offer)))
+}
+
+// @Summary List my sent offers
+// @Description Returns offers made by your team (paginated)
+// @Tags offers
+// @Produce json
+// @Security AccessToken
+// @Param cursor query int false "Pagination cursor"
+// @Param page_size query int false "Number of items per page"
+// @Success 200 {object} common.apiResponse{data=[]offerResponseDTO} "OK"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 401 {object} echo.HTTPError "Unauthorized"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/users/me/offers/sent [get]
+func (h *handler) GetMySentOffers(c echo.Context) error {
+	return h.listOffers(c, h.offerService.ListSentOffers)
+}
+
+// @Summary List my received offers
+// @Description Returns offers made for players of your team (paginated)
+// @Tags offers
+// @Produce json
+// @Security AccessToken
+// @Param cursor query int false "Pagination cursor"
+// @Param page_size query int false "Number of items per page"
+// @Success 200 {object} common.apiResponse{data=[]offerResponseDTO} "OK"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 401 {object} echo.HTTPError "Unauthorized"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/users/me/offers/received [get]
+func (h *handler) GetMyReceivedOffers(c echo.Context) error {
+	return h.listOffers(c, h.offerService.ListReceivedOffers)
+}
+
+// @Summary Get offer by ID
+// @Description Returns the offer if your team is the seller or the buyer
+// @Tags offers
+// @Produce json
+// @Security AccessToken
+// @Param offer_id path int true "Offer ID"
+// @Success 200 {object} common.apiResponse{data=offerResponseDTO} "OK"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 401 {object} echo.HTTPError "Unauthorized"
+// @Failure 404 {object} echo.HTTPError "Not Found"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/offers/{offer_id} [get]
+func (h *handler) GetOfferById(c echo.Context) error {
+	offerId, err := strconv.ParseInt(c.Param("offer_id"), 10, 64)
+	if err != nil {
+		return echo.ErrBadRequest.WithInternal(err)
+	}
+
+	userData, ok := c.Get(access.CtxKey).(access.Data)
+	if !ok {
+		return echo.ErrUnauthorized.WithInternal(access.NewInvalidTokenError(userData))
+	}
+
+	offer, err := h.offerService.GetOfferByID(c.Request().Context(), offerId, userData.UserID)
+	if err != nil {
+		return offerHTTPError(err)
+	}
+
+	return c.JSON(http.StatusOK, common.NewApiResponse(offerResponseAdapter(offer)))
+}
+
+// @Summary Accept an offer
+// @Description Owner accepts a pending offer, the player is sold to the buyer at the offered amount
+// @Tags offers
+// @Security AccessToken
+// @Param offer_id path int true "Offer ID"
+// @Success 200 "OK"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 401 {object} echo.HTTPError "Unauthorized"
+// @Failure 403 {object} echo.HTTPError "Forbidden"
+// @Failure 404 {object} echo.HTTPError "Not Found"
+// @Failure 409 {object} echo.HTTPError "Conflict"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/offers/{offer_id}/accept [post]
+func (h *handler) AcceptOffer(c echo.Context) error {
+	return h.decideOffer(c, func(ctx context.Context, id int64, userID int64) error {
+		_, err := h.offerService.AcceptOffer(ctx, id, userID)
+		return err
+	}, http.StatusOK)
+}
+
+// @Summary Decline an offer
+// @Description Owner declines a pending offer, reserved funds go back to the buyer
+// @Tags offers
+// @Security AccessToken
+// @Param offer_id path int true "Offer ID"
+// @Success 200 "OK"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 401 {object} echo.HTTPError "Unauthorized"
+// @Failure 404 {object} echo.HTTPError "Not Found"
+// @Failure 409 {object} echo.HTTPError "Conflict"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/offers/{offer_id}/decline [post]
+func (h *handler) DeclineOffer(c echo.Context) error {
+	return h.decideOffer(c, h.offerService.DeclineOffer, http.StatusOK)
+}
+
+// @Summary Withdraw an offer
+// @Description Buyer withdraws a pending offer, reserved funds are given back
+// @Tags offers
+// @Security AccessToken
+// @Param offer_id path int true "Offer ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 401 {object} echo.HTTPError "Unauthorized"
+// @Failure 404 {object} echo.HTTPError "Not Found"
+// @Failure 409 {object} echo.HTTPError "Conflict"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/offers/{offer_id} [delete]
+func (h *handler) WithdrawOffer(c echo.Context) error {
+	return h.decideOffer(c, h.offerService.WithdrawOffer, http.StatusNoContent)
+}
+
+func (h *handler) listOffers(
+	c echo.Context,
+	list func(ctx context.Context, userID, cursor int64, limit int32) ([]domain.Offer, error),
+) error {
+	userData, ok := c.Get(access.CtxKey).(access.Data)
+	if !ok {
+		return echo.ErrUnauthorized.WithInternal(access.NewInvalidTokenError(userData))
+	}
+
+	pagination, err := common.ParsePagination(c, h.pageSize, h.pageLimit)
+	if err != nil {
+		return err
+	}
+
+	offers, err := list(c.Request().Context(), userData.UserID, pagination.Cursor, pagination.PageSize)
+	if err != nil {
+		return err
+	}
+
+	res := make([]offerResponseDTO, len(offers))
+	for i, o := range offers {
+		res[i] = offerResponseAdapter(o)
+	}
+
+	return c.JSON(http.StatusOK, common.NewApiResponse(res))
+}
+
+func (h *handler) decideOffer(
+	c echo.Context,
+	decide func(ctx context.Context, id int64, userID int64) error,
+	status int,
+) error {
+	offerId, err := strconv.ParseInt(c.Param("offer_id"), 10, 64)
+	if err != nil {
+		return echo.ErrBadRequest.WithInternal(err)
+	}
+
+	userData, ok := c.Get(access.CtxKey).(access.Data)
+	if !ok {
+		return echo.ErrUnauthorized.WithInternal(access.NewInvalidTokenError(userData))
+	}
+
+	if err := decide(c.Request().Context(), offerId, userData.UserID); err != nil {
+		return offerHTTPError(err)
+	}
+
+	return c.NoContent(status)
+}
+
+func offerHTTPError(err error) error {
+	if errors.Is(err, service.ErrTransferWindowClosed) {
+		return echo.ErrForbidden.WithInternal(err)
+	}
+	if errors.Is(err, service.ErrOfferNotFound) {
+		return echo.ErrNotFound.WithInternal(err)
+	}
+	if errors.Is(err, service.ErrOfferNotAwaiting) {
+		return echo.ErrConflict.WithInternal(err)
+	}
+
+	return err
+}
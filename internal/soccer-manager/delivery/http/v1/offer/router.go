@@ -0,0 +1,21 @@
+package offer
+
+import (
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/delivery"
+	"github.com/labstack/echo/v4"
+)
+
+func RegisterRoutes(g *echo.Group, c *delivery.Components, m *delivery.Middlewares) {
+	h := newHandler(c.Services.OfferService, c.Cfg.Pagination.M, c.Cfg.Pagination.L)
+
+	g.GET("/players/:player_id/offers", h.GetOffersByPlayerId, m.JWTMiddleware)
+	g.POST("/players/:player_id/offers", h.PlaceOffer, m.JWTMiddleware)
+
+	g.GET("/users/me/offers/sent", h.GetMySentOffers, m.JWTMiddleware)
+	g.GET("/users/me/offers/received", h.GetMyReceivedOffers, m.JWTMiddleware)
+
+	g.GET("/offers/:offer_id", h.GetOfferById, m.JWTMiddleware)
+	g.DELETE("/offers/:offer_id", h.WithdrawOffer, m.JWTMiddleware)
+	g.POST("/offers/:offer_id/accept", h.AcceptOffer, m.JWTMiddleware)
+	g.POST("/offers/:offer_id/decline", h.DeclineOffer, m.JWTMiddleware)
+}
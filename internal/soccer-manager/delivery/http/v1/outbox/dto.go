@@ -0,0 +1,37 @@
+package outbox
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
+)
+
+type outboxEventResponseDTO struct {
+	ID          int64           `json:"id"`
+	Topic       string          `json:"topic"        example:"transfer.Sold"`
+	Payload     json.RawMessage `json:"payload"      swaggertype:"object"`
+	Attempts    int32           `json:"attempts"`
+	DeliveredTo []string        `json:"delivered_to" example:"stream"`
+	LastError   string          `json:"last_error"`
+	DeadAt      time.Time       `json:"dead_at"`
+	CreatedAt   time.Time       `json:"created_at"`
+} // @name OutboxEventResponse
+
+func outboxEventResponseAdapter(model domain.OutboxEvent) outboxEventResponseDTO {
+	deliveredTo := model.DeliveredTo
+	if deliveredTo == nil {
+		deliveredTo = []string{}
+	}
+
+	return outboxEventResponseDTO{
+		ID:          model.ID,
+		Topic:       model.Topic,
+		Payload:     model.Payload,
+		Attempts:    model.Attempts,
+		DeliveredTo: deliveredTo,
+		LastError:   model.LastError,
+		DeadAt:      model.DeadAt,
+		CreatedAt:   model.CreatedAt,
+	}
+}
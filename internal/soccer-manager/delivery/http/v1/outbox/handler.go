@@ -0,0 +1,86 @@
+package outbox
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/hexley21/soccer-manager/internal/common"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/service"
+	"github.com/labstack/echo/v4"
+)
+
+type handler struct {
+	outboxService service.OutboxService
+	pageSize      int32
+	pageLimit     int32
+}
+
+func newHandler(outboxService service.OutboxService, pageSize int32, pageLimit int32) *handler {
+	return &handler{
+		outboxService: outboxService,
+		pageSize:      pageSize,
+		pageLimit:     pageLimit,
+	}
+}
+
+// @Summary List dead outbox events
+// @Description Returns events that ran out of delivery attempts (paginated), the cursor is the last event id of the previous page
+// @Tags outbox
+// @Security AccessToken
+// @Produce json
+// @Param cursor query int false "Pagination cursor"
+// @Param page_size query int false "Number of items per page"
+// @Success 200 {object} common.apiResponse{data=[]outboxEventResponseDTO} "OK"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 401 {object} echo.HTTPError "Unauthorized"
+// @Failure 403 {object} echo.HTTPError "Forbidden"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/outbox/dead [get]
+func (h *handler) GetDeadEvents(c echo.Context) error {
+	pagination, err := common.ParsePagination(c, h.pageSize, h.pageLimit)
+	if err != nil {
+		return err
+	}
+
+	events, err := h.outboxService.ListDeadEvents(c.Request().Context(), pagination.Cursor, pagination.PageSize)
+	if err != nil {
+		return err
+	}
+
+	res := make([]outboxEventResponseDTO, len(events))
+	for i, e := range events {
+		res[i] = outboxEventResponseAdapter(e)
+	}
+
+	return c.JSON(http.StatusOK, common.NewApiResponse(res))
+}
+
+// @Summary Requeue dead outbox event
+// @Description Gives a dead event a fresh set of delivery attempts, handlers that already got it are skipped
+// @Tags outbox
+// @Security AccessToken
+// @Param event_id path int true "Outbox event ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 401 {object} echo.HTTPError "Unauthorized"
+// @Failure 403 {object} echo.HTTPError "Forbidden"
+// @Failure 404 {object} echo.HTTPError "Not Found"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/outbox/{event_id}/requeue [post]
+func (h *handler) RequeueEvent(c echo.Context) error {
+	eventId, err := strconv.ParseInt(c.Param("event_id"), 10, 64)
+	if err != nil {
+		return echo.ErrBadRequest.WithInternal(err)
+	}
+
+	if err := h.outboxService.RequeueEvent(c.Request().Context(), eventId); err != nil {
+		if errors.Is(err, service.ErrOutboxEventNotFound) {
+			return echo.ErrNotFound.WithInternal(err)
+		}
+
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
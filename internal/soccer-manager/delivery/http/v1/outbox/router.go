@@ -0,0 +1,13 @@
+package outbox
+
+import (
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/delivery"
+	"github.com/labstack/echo/v4"
+)
+
+func RegisterRoutes(g *echo.Group, c *delivery.Components, m *delivery.Middlewares) {
+	h := newHandler(c.Services.OutboxService, c.Cfg.Pagination.M, c.Cfg.Pagination.L)
+
+	g.GET("/outbox/dead", h.GetDeadEvents, m.JWTMiddleware, m.IsAdmin)
+	g.POST("/outbox/:event_id/requeue", h.RequeueEvent, m.JWTMiddleware, m.IsAdmin)
+}
@@ -1,6 +1,8 @@
 package player
 
 import (
+	"time"
+
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
 )
 
@@ -13,8 +15,19 @@ type playerResponseDTO struct {
 	Age          int32                     `json:"age"`
 	PositionCode domain.PlayerPositionCode `json:"position_code"`
 	Price        int64                     `json:"price"`
+	Attributes   playerAttributesDTO       `json:"attributes"`
+	Overall      int32                     `json:"overall"`
 } // @name PlayerResponse
 
+type playerAttributesDTO struct {
+	Pace        int32 `json:"pace"`
+	Shooting    int32 `json:"shooting"`
+	Passing     int32 `json:"passing"`
+	Defending   int32 `json:"defending"`
+	Goalkeeping int32 `json:"goalkeeping"`
+	Stamina     int32 `json:"stamina"`
+} // @name PlayerAttributes
+
 func playerResponseAdapter(model domain.Player) playerResponseDTO {
 	return playerResponseDTO{
 		ID:           model.ID,
@@ -25,6 +38,15 @@ func playerResponseAdapter(model domain.Player) playerResponseDTO {
 		Age:          model.Age,
 		PositionCode: model.PositionCode,
 		Price:        model.Price,
+		Attributes: playerAttributesDTO{
+			Pace:        model.Attributes.Pace,
+			Shooting:    model.Attributes.Shooting,
+			Passing:     model.Attributes.Passing,
+			Defending:   model.Attributes.Defending,
+			Goalkeeping: model.Attributes.Goalkeeping,
+			Stamina:     model.Attributes.Stamina,
+		},
+		Overall: model.Rating(),
 	}
 }
 
@@ -33,3 +55,19 @@ type updatePlayerDataRequestDTO struct {
 	FirstName   string             `json:"first_name"   validate:"required,alphaunicode"`
 	LastName    string             `json:"last_name"    validate:"required,alphaunicode"`
 } // @name UpdatePlayerDataRequest
+
+type pricePointResponseDTO struct {
+	At        time.Time `json:"at"`
+	Price     int64     `json:"price"`
+	LowPrice  int64     `json:"low_price"`
+	HighPrice int64     `json:"high_price"`
+} // @name PricePointResponse
+
+func pricePointResponseAdapter(model domain.PlayerPricePoint) pricePointResponseDTO {
+	return pricePointResponseDTO{
+		At:        model.At,
+		Price:     model.Price,
+		LowPrice:  model.LowPrice,
+		HighPrice: model.HighPrice,
+	}
+}
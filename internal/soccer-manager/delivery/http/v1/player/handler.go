@@ -6,6 +6,7 @@ import (
 	"strconv"
 
 	"github.com/hexley21/soccer-manager/internal/common"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/jwt/access"
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/service"
 	"github.com/labstack/echo/v4"
@@ -89,6 +90,61 @@ func (h *handler) GetPlayerById(c echo.Context) error {
 	return c.JSON(http.StatusOK, common.NewApiResponse(playerResponseAdapter(player)))
 }
 
+// @Summary Get player price history
+// @Description Returns player's price chart in chronological order, optionally bucketed by day or week.
+// @Description Bucketed points start at their bucket, price is the last one within it
+// @Tags players
+// @Produce json
+// @Param player_id path int true "Player ID"
+// @Param from query string false "Range start (RFC3339)"
+// @Param to query string false "Range end, exclusive (RFC3339)"
+// @Param bucket query string false "Bucket size" Enums(day, week)
+// @Success 200 {object} common.apiResponse{data=[]pricePointResponseDTO} "OK"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 404 {object} echo.HTTPError "Not Found"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/players/{player_id}/price-history [get]
+func (h *handler) GetPlayerPriceHistory(c echo.Context) error {
+	playerId, err := strconv.ParseInt(c.Param("player_id"), 10, 64)
+	if err != nil {
+		return echo.ErrBadRequest.WithInternal(err)
+	}
+
+	from, err := common.ParseQueryTime(c, "from")
+	if err != nil {
+		return err
+	}
+	to, err := common.ParseQueryTime(c, "to")
+	if err != nil {
+		return err
+	}
+
+	points, err := h.playerService.GetPlayerPriceHistory(
+		c.Request().Context(),
+		playerId,
+		from,
+		to,
+		domain.PriceHistoryBucket(c.QueryParam("bucket")),
+	)
+	if err != nil {
+		if errors.Is(err, service.ErrPlayerNotFound) {
+			return echo.ErrNotFound.WithInternal(err)
+		}
+		if errors.Is(err, service.ErrInvalidArguments) {
+			return echo.ErrBadRequest.WithInternal(err)
+		}
+
+		return err
+	}
+
+	res := make([]pricePointResponseDTO, len(points))
+	for i, p := range points {
+		res[i] = pricePointResponseAdapter(p)
+	}
+
+	return c.JSON(http.StatusOK, common.NewApiResponse(res))
+}
+
 // @Summary Update player data
 // @Description Updates a player's data by ID
 // @Tags players
@@ -14,6 +14,7 @@ func RegisterRoutes(g *echo.Group, c *delivery.Components, m *delivery.Middlewar
 
 	g.GET("/players", h.GetAllPlayers)
 	g.GET("/players/:player_id", h.GetPlayerById)
+	g.GET("/players/:player_id/price-history", h.GetPlayerPriceHistory)
 	g.PUT("/players/:player_id", h.UpdatePlayerData, m.JWTMiddleware)
 	g.GET("/teams/:team_id/players", h.GetPlayersByTeamId)
 	g.GET("/users/:user_id/players", h.GetPlayersByUserId)
@@ -0,0 +1,34 @@
+package provisioning
+
+import (
+	"time"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
+)
+
+type provisioningResponseDTO struct {
+	UserID    int64                     `json:"user_id"`
+	Status    domain.ProvisioningStatus `json:"status"               example:"pending"`
+	TeamID    *int64                    `json:"team_id,omitempty"`
+	Attempts  int32                     `json:"attempts"`
+	LastError string                    `json:"last_error,omitempty"`
+	CreatedAt time.Time                 `json:"created_at"`
+	UpdatedAt time.Time                 `json:"updated_at"`
+} // @name ProvisioningResponse
+
+func provisioningResponseAdapter(model domain.Provisioning) provisioningResponseDTO {
+	res := provisioningResponseDTO{
+		UserID:    model.UserID,
+		Status:    model.Status,
+		Attempts:  model.Attempts,
+		LastError: model.LastError,
+		CreatedAt: model.CreatedAt,
+		UpdatedAt: model.UpdatedAt,
+	}
+	if model.TeamID != 0 {
+		teamID := model.TeamID
+		res.TeamID = &teamID
+	}
+
+	return res
+}
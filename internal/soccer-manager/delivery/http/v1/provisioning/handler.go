@@ -0,0 +1,124 @@
+package provisioning
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/hexley21/soccer-manager/internal/common"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/jwt/access"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/service"
+	"github.com/labstack/echo/v4"
+)
+
+type handler struct {
+	provisioningService service.ProvisioningService
+	pageSize            int32
+	pageLimit           int32
+}
+
+func newHandler(provisioningService service.ProvisioningService, pageSize int32, pageLimit int32) *handler {
+	return &handler{
+		provisioningService: provisioningService,
+		pageSize:            pageSize,
+		pageLimit:           pageLimit,
+	}
+}
+
+// @Summary Get my provisioning
+// @Description Tells whether my team and players are set up yet, failed provisioning waits for an admin
+// @Tags provisioning
+// @Security AccessToken
+// @Produce json
+// @Success 200 {object} common.apiResponse{data=provisioningResponseDTO} "OK"
+// @Failure 401 {object} echo.HTTPError "Unauthorized"
+// @Failure 404 {object} echo.HTTPError "Not Found"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/users/me/provisioning [get]
+func (h *handler) GetProvisioningMe(c echo.Context) error {
+	userData, ok := c.Get(access.CtxKey).(access.Data)
+	if !ok {
+		return echo.ErrUnauthorized.WithInternal(access.NewInvalidTokenError(userData))
+	}
+
+	provisioning, err := h.provisioningService.GetProvisioning(c.Request().Context(), userData.UserID)
+	if err != nil {
+		if errors.Is(err, service.ErrProvisioningNotFound) {
+			return echo.ErrNotFound.WithInternal(err)
+		}
+
+		return err
+	}
+
+	return c.JSON(http.StatusOK, common.NewApiResponse(provisioningResponseAdapter(provisioning)))
+}
+
+// @Summary List stuck provisioning
+// @Description Returns failed provisioning and pending one that hasn't moved for a while (paginated), the cursor is the last user id of the previous page
+// @Tags provisioning
+// @Security AccessToken
+// @Produce json
+// @Param cursor query int false "Pagination cursor"
+// @Param page_size query int false "Number of items per page"
+// @Success 200 {object} common.apiResponse{data=[]provisioningResponseDTO} "OK"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 401 {object} echo.HTTPError "Unauthorized"
+// @Failure 403 {object} echo.HTTPError "Forbidden"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/users/provisioning/stuck [get]
+func (h *handler) GetStuckProvisioning(c echo.Context) error {
+	pagination, err := common.ParsePagination(c, h.pageSize, h.pageLimit)
+	if err != nil {
+		return err
+	}
+
+	stuck, err := h.provisioningService.ListStuckProvisioning(
+		c.Request().Context(),
+		pagination.Cursor,
+		pagination.PageSize,
+	)
+	if err != nil {
+		return err
+	}
+
+	res := make([]provisioningResponseDTO, len(stuck))
+	for i, p := range stuck {
+		res[i] = provisioningResponseAdapter(p)
+	}
+
+	return c.JSON(http.StatusOK, common.NewApiResponse(res))
+}
+
+// @Summary Retry provisioning
+// @Description Runs a failed or stuck provisioning of the user again with a fresh set of attempts, parts that already exist are kept
+// @Tags provisioning
+// @Security AccessToken
+// @Param user_id path int true "User ID"
+// @Success 202 "Accepted"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 401 {object} echo.HTTPError "Unauthorized"
+// @Failure 403 {object} echo.HTTPError "Forbidden"
+// @Failure 404 {object} echo.HTTPError "Not Found"
+// @Failure 409 {object} echo.HTTPError "Conflict"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/users/{user_id}/provisioning/retry [post]
+func (h *handler) RetryProvisioning(c echo.Context) error {
+	userId, err := strconv.ParseInt(c.Param("user_id"), 10, 64)
+	if err != nil {
+		return echo.ErrBadRequest.WithInternal(err)
+	}
+
+	if err := h.provisioningService.RequeueProvisioning(c.Request().Context(), userId); err != nil {
+		if errors.Is(err, service.ErrUserNotFound) {
+			return echo.ErrNotFound.WithInternal(err)
+		}
+		if errors.Is(err, service.ErrProvisioningCompleted) ||
+			errors.Is(err, service.ErrProvisioningInProgress) {
+			return echo.ErrConflict.WithInternal(err)
+		}
+
+		return err
+	}
+
+	return c.NoContent(http.StatusAccepted)
+}
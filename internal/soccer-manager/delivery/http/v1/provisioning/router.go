@@ -0,0 +1,15 @@
+package provisioning
+
+import (
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/delivery"
+	"github.com/labstack/echo/v4"
+)
+
+func RegisterRoutes(g *echo.Group, c *delivery.Components, m *delivery.Middlewares) {
+	h := newHandler(c.Services.ProvisioningService, c.Cfg.Pagination.M, c.Cfg.Pagination.L)
+
+	g.GET("/users/me/provisioning", h.GetProvisioningMe, m.JWTMiddleware)
+
+	g.GET("/users/provisioning/stuck", h.GetStuckProvisioning, m.JWTMiddleware, m.IsAdmin)
+	g.POST("/users/:user_id/provisioning/retry", h.RetryProvisioning, m.JWTMiddleware, m.IsAdmin)
+}
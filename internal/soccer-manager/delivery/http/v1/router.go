@@ -2,14 +2,27 @@ package v1
 
 import (
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/delivery"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/delivery/http/v1/auction"
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/delivery/http/v1/auth"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/delivery/http/v1/bid"
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/delivery/http/v1/globe"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/delivery/http/v1/league"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/delivery/http/v1/live"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/delivery/http/v1/market"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/delivery/http/v1/match"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/delivery/http/v1/notification"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/delivery/http/v1/offer"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/delivery/http/v1/outbox"
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/delivery/http/v1/player"
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/delivery/http/v1/player_position"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/delivery/http/v1/provisioning"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/delivery/http/v1/session"
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/delivery/http/v1/team"
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/delivery/http/v1/transfer"
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/delivery/http/v1/transfer_record"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/delivery/http/v1/transfer_window"
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/delivery/http/v1/user"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/delivery/http/v1/watchlist"
 	"github.com/labstack/echo/v4"
 )
 
@@ -18,6 +31,11 @@ func RegisterRoutes(g *echo.Group, c *delivery.Components, m *delivery.Middlewar
 
 	auth.RegisterRoutes(g.Group("/auth"), c)
 	user.RegisterRoutes(g.Group("/users"), c, m)
+	provisioning.RegisterRoutes(g, c, m)
+	session.RegisterRoutes(g, c, m)
+	watchlist.RegisterRoutes(g, c, m)
+	notification.RegisterRoutes(g, c, m)
+	outbox.RegisterRoutes(g, c, m)
 
 	team.RegisterRoutes(g, c, m)
 
@@ -26,4 +44,13 @@ func RegisterRoutes(g *echo.Group, c *delivery.Components, m *delivery.Middlewar
 
 	transfer.RegisterRoutes(g, c, m)
 	transfer_record.RegisterRoutes(g, c)
+	transfer_window.RegisterRoutes(g, c, m)
+	bid.RegisterRoutes(g, c, m)
+	auction.RegisterRoutes(g, c)
+	offer.RegisterRoutes(g, c, m)
+	market.RegisterRoutes(g, c)
+	live.RegisterRoutes(g, c)
+
+	match.RegisterRoutes(g, c, m)
+	league.RegisterRoutes(g, c, m)
 }
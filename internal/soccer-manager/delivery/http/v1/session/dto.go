@@ -0,0 +1,33 @@
+package session
+
+import (
+	"time"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
+)
+
+type sessionResponseDTO struct {
+	ID         int64     `json:"id"`
+	UserAgent  string    `json:"user_agent"   example:"Mozilla/5.0 (X11; Linux x86_64)"`
+	IP         string    `json:"ip"           example:"203.0.113.7"`
+	Current    bool      `json:"current"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastUsedAt time.Time `json:"last_used_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+} // @name SessionResponse
+
+func sessionResponseAdapter(model domain.Session, currentSessionID int64) sessionResponseDTO {
+	return sessionResponseDTO{
+		ID:         model.ID,
+		UserAgent:  model.Client.UserAgent,
+		IP:         model.Client.IP,
+		Current:    model.ID == currentSessionID,
+		CreatedAt:  model.CreatedAt,
+		LastUsedAt: model.LastUsedAt,
+		ExpiresAt:  model.ExpiresAt,
+	}
+}
+
+type revokeSessionsResponseDTO struct {
+	Revoked int64 `json:"revoked" example:"3"`
+} // @name RevokeSessionsResponse
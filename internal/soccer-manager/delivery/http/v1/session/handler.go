@@ -0,0 +1,164 @@
+package session
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/hexley21/soccer-manager/internal/common"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/jwt/access"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/service"
+	"github.com/labstack/echo/v4"
+)
+
+type handler struct {
+	sessionService service.SessionService
+}
+
+func newHandler(sessionService service.SessionService) *handler {
+	return &handler{sessionService: sessionService}
+}
+
+// @Summary List my sessions
+// @Description Returns the devices I'm logged in from, most recently used first. The session of the access token is marked as current
+// @Tags sessions
+// @Security AccessToken
+// @Produce json
+// @Success 200 {object} common.apiResponse{data=[]sessionResponseDTO} "OK"
+// @Failure 401 {object} echo.HTTPError "Unauthorized"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/users/me/sessions [get]
+func (h *handler) ListSessionsMe(c echo.Context) error {
+	userData, ok := c.Get(access.CtxKey).(access.Data)
+	if !ok {
+		return echo.ErrUnauthorized.WithInternal(access.NewInvalidTokenError(userData))
+	}
+
+	return h.list(c, userData.UserID, userData.SessionID)
+}
+
+// @Summary Revoke my session
+// @Description Logs out one of my devices, its refresh and access tokens stop working right away
+// @Tags sessions
+// @Security AccessToken
+// @Param session_id path int true "Session ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 401 {object} echo.HTTPError "Unauthorized"
+// @Failure 404 {object} echo.HTTPError "Not Found"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/users/me/sessions/{session_id} [delete]
+func (h *handler) RevokeSessionMe(c echo.Context) error {
+	sessionID, err := strconv.ParseInt(c.Param("session_id"), 10, 64)
+	if err != nil {
+		return echo.ErrBadRequest.WithInternal(err)
+	}
+
+	userData, ok := c.Get(access.CtxKey).(access.Data)
+	if !ok {
+		return echo.ErrUnauthorized.WithInternal(access.NewInvalidTokenError(userData))
+	}
+
+	err = h.sessionService.RevokeSession(
+		c.Request().Context(),
+		userData.UserID,
+		sessionID,
+		domain.SessionRevokedByUser,
+	)
+	if err != nil {
+		if errors.Is(err, service.ErrSessionNotFound) {
+			return echo.ErrNotFound.WithInternal(err)
+		}
+
+		c.Logger().Errorf("failed to revoke session %d: %v", sessionID, err)
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// @Summary Log out everywhere
+// @Description Revokes every session of mine including the current one
+// @Tags sessions
+// @Security AccessToken
+// @Produce json
+// @Success 200 {object} common.apiResponse{data=revokeSessionsResponseDTO} "OK"
+// @Failure 401 {object} echo.HTTPError "Unauthorized"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/users/me/sessions [delete]
+func (h *handler) RevokeSessionsMe(c echo.Context) error {
+	userData, ok := c.Get(access.CtxKey).(access.Data)
+	if !ok {
+		return echo.ErrUnauthorized.WithInternal(access.NewInvalidTokenError(userData))
+	}
+
+	return h.revokeAll(c, userData.UserID, domain.SessionRevokedLogoutAll)
+}
+
+// @Summary List sessions of a user (ADMIN)
+// @Description Returns the active sessions of the user, most recently used first
+// @Tags sessions
+// @Security AccessToken
+// @Produce json
+// @Param user_id path int true "User ID"
+// @Success 200 {object} common.apiResponse{data=[]sessionResponseDTO} "OK"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 401 {object} echo.HTTPError "Unauthorized"
+// @Failure 403 {object} echo.HTTPError "Forbidden"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/users/{user_id}/sessions [get]
+func (h *handler) ListSessions(c echo.Context) error {
+	userID, err := strconv.ParseInt(c.Param("user_id"), 10, 64)
+	if err != nil {
+		return echo.ErrBadRequest.WithInternal(err)
+	}
+
+	return h.list(c, userID, 0)
+}
+
+// @Summary Revoke sessions of a user (ADMIN)
+// @Description Logs the user out of every device, for accounts reported as compromised
+// @Tags sessions
+// @Security AccessToken
+// @Produce json
+// @Param user_id path int true "User ID"
+// @Success 200 {object} common.apiResponse{data=revokeSessionsResponseDTO} "OK"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 401 {object} echo.HTTPError "Unauthorized"
+// @Failure 403 {object} echo.HTTPError "Forbidden"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/users/{user_id}/sessions [delete]
+func (h *handler) RevokeSessions(c echo.Context) error {
+	userID, err := strconv.ParseInt(c.Param("user_id"), 10, 64)
+	if err != nil {
+		return echo.ErrBadRequest.WithInternal(err)
+	}
+
+	return h.revokeAll(c, userID, domain.SessionRevokedByAdmin)
+}
+
+func (h *handler) list(c echo.Context, userID int64, currentSessionID int64) error {
+	sessions, err := h.sessionService.ListSessions(c.Request().Context(), userID)
+	if err != nil {
+		c.Logger().Errorf("failed to list sessions of user %d: %v", userID, err)
+		return err
+	}
+
+	res := make([]sessionResponseDTO, len(sessions))
+	for i, s := range sessions {
+		res[i] = sessionResponseAdapter(s, currentSessionID)
+	}
+
+	return c.JSON(http.StatusOK, common.NewApiResponse(res))
+}
+
+func (h *handler) revokeAll(c echo.Context, userID int64, reason domain.SessionRevokeReason) error {
+	revoked, err := h.sessionService.RevokeSessions(c.Request().Context(), userID, 0, reason)
+	if err != nil {
+		c.Logger().Errorf("failed to revoke sessions of user %d: %v", userID, err)
+		return err
+	}
+
+	return c.JSON(http.StatusOK, common.NewApiResponse(revokeSessionsResponseDTO{Revoked: revoked}))
+}
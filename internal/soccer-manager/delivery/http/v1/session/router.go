@@ -0,0 +1,17 @@
+package session
+
+import (
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/delivery"
+	"github.com/labstack/echo/v4"
+)
+
+func RegisterRoutes(g *echo.Group, c *delivery.Components, m *delivery.Middlewares) {
+	h := newHandler(c.Services.SessionService)
+
+	g.GET("/users/me/sessions", h.ListSessionsMe, m.JWTMiddleware)
+	g.DELETE("/users/me/sessions", h.RevokeSessionsMe, m.JWTMiddleware)
+	g.DELETE("/users/me/sessions/:session_id", h.RevokeSessionMe, m.JWTMiddleware)
+
+	g.GET("/users/:user_id/sessions", h.ListSessions, m.JWTMiddleware, m.IsAdmin)
+	g.DELETE("/users/:user_id/sessions", h.RevokeSessions, m.JWTMiddleware, m.IsAdmin)
+}
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"strconv"
 
+	evbus "github.com/asaskevich/EventBus"
 	"github.com/hexley21/soccer-manager/internal/common"
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/jwt/access"
@@ -13,16 +14,23 @@ import (
 )
 
 type handler struct {
-	teamService service.TeamService
-	pageSize    int32
-	pageLimit   int32
+	teamService  service.TeamService
+	eventEmitter evbus.BusPublisher
+	pageSize     int32
+	pageLimit    int32
 }
 
-func newHandler(teamService service.TeamService, pageSize int32, pageLimit int32) *handler {
+func newHandler(
+	teamService service.TeamService,
+	eventEmitter evbus.BusPublisher,
+	pageSize int32,
+	pageLimit int32,
+) *handler {
 	return &handler{
-		teamService: teamService,
-		pageSize:    pageSize,
-		pageLimit:   pageLimit,
+		teamService:  teamService,
+		eventEmitter: eventEmitter,
+		pageSize:     pageSize,
+		pageLimit:    pageLimit,
 	}
 }
 
@@ -192,6 +200,8 @@ func (h *handler) UpdateTeamCountry(c echo.Context) error {
 		return err
 	}
 
+	h.eventEmitter.Publish(domain.EventONTEAMUPDATED, userData.UserID)
+
 	return c.NoContent(http.StatusOK)
 }
 
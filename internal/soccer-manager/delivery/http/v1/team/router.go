@@ -6,7 +6,7 @@ import (
 )
 
 func RegisterRoutes(g *echo.Group, c *delivery.Components, m *delivery.Middlewares) {
-	h := newHandler(c.Services.TeamService, c.Cfg.Pagination.S, c.Cfg.Pagination.M)
+	h := newHandler(c.Services.TeamService, c.EventBus, c.Cfg.Pagination.S, c.Cfg.Pagination.M)
 
 	g.GET("/teams", h.GetTeams, m.AcceptLanguage)
 	g.GET("/teams/:team_id", h.GetTeamById, m.AcceptLanguage)
@@ -8,28 +8,55 @@ import (
 )
 
 type transferResponseDTO struct {
-	ID           int64     `json:"id"`
-	PlayerID     int64     `json:"player_id"`
-	SellerTeamID int64     `json:"seller_team_id"`
-	Price        int64     `json:"price"`
-	ListedAt     time.Time `json:"listed_at"`
+	ID            int64      `json:"id"`
+	PlayerID      int64      `json:"player_id"`
+	SellerTeamID  int64      `json:"seller_team_id"`
+	Price         int64      `json:"price"`
+	ListedAt      time.Time  `json:"listed_at"`
+	AuctionEndsAt *time.Time `json:"auction_ends_at,omitempty"`
+	ReservePrice  int64      `json:"reserve_price,omitempty"`
+	ExpiresAt     *time.Time `json:"expires_at,omitempty"`
 } // @name TransferResponse
 
 func transferResponseAdapter(model domain.Transfer) transferResponseDTO {
-	return transferResponseDTO{
+	res := transferResponseDTO{
 		ID:           model.ID,
 		PlayerID:     model.PlayerID,
 		SellerTeamID: model.SellerTeamID,
 		Price:        model.Price,
 		ListedAt:     model.ListedAt,
 	}
+	if model.IsAuction() {
+		res.AuctionEndsAt = &model.AuctionEndsAt
+		res.ReservePrice = model.ReservePrice
+	}
+	if !model.ExpiresAt.IsZero() {
+		res.ExpiresAt = &model.ExpiresAt
+	}
+
+	return res
 }
 
+// createTransferRequestDTO lists a player for a fixed price,
+// with auction_ends_at set it's an auction and price is the opening price.
+// Fixed price listings without expires_at lapse after the configured listing ttl
 type createTransferRequestDTO struct {
-	PlayerID int64           `json:"player_id" validate:"required"`
-	Price    decimal.Decimal `json:"price"     validate:"required,dgte=1"`
+	PlayerID      int64           `json:"player_id"       validate:"required"`
+	Price         decimal.Decimal `json:"price"           validate:"required,dgte=1"`
+	AuctionEndsAt *time.Time      `json:"auction_ends_at"`
+	ReservePrice  decimal.Decimal `json:"reserve_price"   validate:"dgte=0"`
+	ExpiresAt     *time.Time      `json:"expires_at"`
 } // @name CreateTransferRequest
 
 type updateTransferRequestDTO struct {
 	Price decimal.Decimal `json:"price" validate:"required,dgte=1"`
 } // @name UpdateTransferRequest
+
+// renewTransferRequestDTO without expires_at renews by the configured listing ttl
+type renewTransferRequestDTO struct {
+	ExpiresAt *time.Time `json:"expires_at"`
+} // @name RenewTransferRequest
+
+type renewTransferResponseDTO struct {
+	ExpiresAt time.Time `json:"expires_at"`
+} // @name RenewTransferResponse
@@ -2,10 +2,13 @@ package transfer
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/hexley21/soccer-manager/internal/common"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/jwt/access"
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/service"
 	"github.com/labstack/echo/v4"
@@ -26,11 +29,24 @@ func newHandler(transferService service.TransferService, pageSize int32, pageLim
 }
 
 // @Summary List all transfers
-// @Description Returns a list of all transfers (paginated)
+// @Description Returns a filtered list of transfers (paginated), the cursor is the last transfer id of the previous page.
+// @Description Sorted by price the cursor_price is the price of that transfer. Sorting by listed_at follows the transfer ids
 // @Tags transfers
 // @Produce json
 // @Param cursor query int false "Pagination cursor"
+// @Param cursor_price query int false "Price of the cursor transfer, required with the cursor when sorted by price"
 // @Param page_size query int false "Number of items per page"
+// @Param position query string false "Player position code"
+// @Param min_price query int false "Minimum price"
+// @Param max_price query int false "Maximum price"
+// @Param min_age query int false "Minimum player age"
+// @Param max_age query int false "Maximum player age"
+// @Param country query string false "Player country code"
+// @Param seller_team_id query int false "Seller team ID"
+// @Param min_listing_age query string false "Minimum listing age as a duration (e.g. 24h)"
+// @Param max_listing_age query string false "Maximum listing age as a duration (e.g. 24h)"
+// @Param sort query string false "Sort key" Enums(price, listed_at)
+// @Param order query string false "Sort order" Enums(asc, desc)
 // @Success 200 {object} common.apiResponse{data=[]transferResponseDTO} "OK"
 // @Failure 400 {object} echo.HTTPError "Bad Request"
 // @Failure 500 {object} echo.HTTPError "Internal Server Error"
@@ -41,12 +57,27 @@ func (h *handler) GetTransfers(c echo.Context) error {
 		return err
 	}
 
+	filter, err := parseTransferFilter(c)
+	if err != nil {
+		return err
+	}
+
+	cursorPrice, err := common.ParseQueryInt(c, "cursor_price", 64)
+	if err != nil {
+		return err
+	}
+
 	transfers, err := h.transferService.ListTransfers(
 		c.Request().Context(),
-		pagination.Cursor,
+		filter,
+		domain.TransferCursor{ID: pagination.Cursor, Price: cursorPrice},
 		pagination.PageSize,
 	)
 	if err != nil {
+		if errors.Is(err, service.ErrInvalidCursor) || errors.Is(err, service.ErrInvalidArguments) {
+			return echo.ErrBadRequest.WithInternal(err)
+		}
+
 		return err
 	}
 
@@ -153,7 +184,8 @@ func (h *handler) GetTransferByPlayer(c echo.Context) error {
 }
 
 // @Summary Create transfer
-// @Description Creates a new transfer listing
+// @Description Creates a new transfer listing, an auction when auction_ends_at is set.
+// @Description Fixed price listings lapse at expires_at or after the configured listing ttl
 // @Tags transfers
 // @Accept json
 // @Produce json
@@ -161,6 +193,7 @@ func (h *handler) GetTransferByPlayer(c echo.Context) error {
 // @Param request body createTransferRequestDTO true "Transfer details"
 // @Success 201 {object} common.apiResponse{data=int} "Created"
 // @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 403 {object} echo.HTTPError "Forbidden"
 // @Failure 404 {object} echo.HTTPError "Not Found"
 // @Failure 409 {object} echo.HTTPError "Conflict"
 // @Failure 500 {object} echo.HTTPError "Internal Server Error"
@@ -179,11 +212,22 @@ func (h *handler) CreateTransfer(c echo.Context) error {
 		return echo.ErrBadRequest.WithInternal(err)
 	}
 
+	var auctionEndsAt, expiresAt time.Time
+	if req.AuctionEndsAt != nil {
+		auctionEndsAt = *req.AuctionEndsAt
+	}
+	if req.ExpiresAt != nil {
+		expiresAt = *req.ExpiresAt
+	}
+
 	transferId, err := h.transferService.CreateTransfer(
 		c.Request().Context(),
 		userData.UserID,
 		req.PlayerID,
 		req.Price.IntPart(),
+		auctionEndsAt,
+		req.ReservePrice.IntPart(),
+		expiresAt,
 	)
 	if err != nil {
 		if errors.Is(err, service.ErrNonexistentCode) {
@@ -195,6 +239,9 @@ func (h *handler) CreateTransfer(c echo.Context) error {
 		if errors.Is(err, service.ErrInvalidArguments) {
 			return echo.ErrBadRequest.WithInternal(err)
 		}
+		if errors.Is(err, service.ErrTransferWindowClosed) {
+			return echo.ErrForbidden.WithInternal(err)
+		}
 
 		return err
 	}
@@ -214,6 +261,7 @@ func (h *handler) CreateTransfer(c echo.Context) error {
 // @Success 204 "No Content"
 // @Failure 400 {object} echo.HTTPError "Bad Request"
 // @Failure 404 {object} echo.HTTPError "Not Found"
+// @Failure 409 {object} echo.HTTPError "Conflict"
 // @Failure 500 {object} echo.HTTPError "Internal Server Error"
 // @Router /v1/transfers/{transfer_id} [delete]
 func (h *handler) DeleteTransfer(c echo.Context) error {
@@ -230,6 +278,10 @@ func (h *handler) DeleteTransfer(c echo.Context) error {
 		if errors.Is(err, service.ErrTransferNotFound) {
 			return echo.ErrNotFound.WithInternal(err)
 		}
+		if errors.Is(err, service.ErrTransferIsAuction) ||
+			errors.Is(err, service.ErrAuctionEnded) {
+			return echo.ErrConflict.WithInternal(err)
+		}
 
 		return err
 	}
@@ -238,7 +290,7 @@ func (h *handler) DeleteTransfer(c echo.Context) error {
 }
 
 // @Summary Update transfer
-// @Description Updates an existing transfer by ID
+// @Description Updates the price of an existing fixed price transfer by ID
 // @Tags transfers
 // @Accept json
 // @Produce json
@@ -247,6 +299,7 @@ func (h *handler) DeleteTransfer(c echo.Context) error {
 // @Param request body updateTransferRequestDTO true "Updated transfer info"
 // @Success 200 "OK"
 // @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 403 {object} echo.HTTPError "Forbidden"
 // @Failure 404 {object} echo.HTTPError "Not Found"
 // @Failure 500 {object} echo.HTTPError "Internal Server Error"
 // @Router /v1/transfers/{transfer_id} [put]
@@ -273,6 +326,9 @@ func (h *handler) UpdateTransfer(c echo.Context) error {
 		if errors.Is(err, service.ErrTransferNotFound) {
 			return echo.ErrNotFound.WithInternal(err)
 		}
+		if errors.Is(err, service.ErrTransferWindowClosed) {
+			return echo.ErrForbidden.WithInternal(err)
+		}
 
 		return err
 	}
@@ -280,8 +336,56 @@ func (h *handler) UpdateTransfer(c echo.Context) error {
 	return c.NoContent(http.StatusOK)
 }
 
+// @Summary Renew transfer
+// @Description Moves the expiry of a fixed price listing before it lapses, without expires_at renews by the configured listing ttl
+// @Tags transfers
+// @Accept json
+// @Produce json
+// @Security AccessToken
+// @Param transfer_id path int true "Transfer ID"
+// @Param request body renewTransferRequestDTO false "New expiry"
+// @Success 200 {object} common.apiResponse{data=renewTransferResponseDTO} "OK"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 404 {object} echo.HTTPError "Not Found"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/transfers/{transfer_id}/renew [post]
+func (h *handler) RenewTransfer(c echo.Context) error {
+	transferId, err := strconv.ParseInt(c.Param("transfer_id"), 10, 64)
+	if err != nil {
+		return echo.ErrBadRequest.WithInternal(err)
+	}
+	userData, ok := c.Get(access.CtxKey).(access.Data)
+	if !ok {
+		return echo.ErrUnauthorized.WithInternal(access.NewInvalidTokenError(userData))
+	}
+
+	var req renewTransferRequestDTO
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	var expiresAt time.Time
+	if req.ExpiresAt != nil {
+		expiresAt = *req.ExpiresAt
+	}
+
+	expiresAt, err = h.transferService.RenewTransfer(c.Request().Context(), transferId, userData.UserID, expiresAt)
+	if err != nil {
+		if errors.Is(err, service.ErrTransferNotFound) {
+			return echo.ErrNotFound.WithInternal(err)
+		}
+		if errors.Is(err, service.ErrInvalidArguments) {
+			return echo.ErrBadRequest.WithInternal(err)
+		}
+
+		return err
+	}
+
+	return c.JSON(http.StatusOK, common.NewApiResponse(renewTransferResponseDTO{ExpiresAt: expiresAt}))
+}
+
 // @Summary Buy a player
-// @Description Purchases a player in a specific transfer listing
+// @Description Purchases a player in a specific fixed price transfer listing
 // @Tags transfers
 // @Produce json
 // @Security AccessToken
@@ -289,6 +393,7 @@ func (h *handler) UpdateTransfer(c echo.Context) error {
 // @Success 201 "Created"
 // @Failure 400 {object} echo.HTTPError "Bad Request"
 // @Failure 401 {object} echo.HTTPError "Unauthorized"
+// @Failure 403 {object} echo.HTTPError "Forbidden"
 // @Failure 404 {object} echo.HTTPError "Not Found"
 // @Failure 409 {object} echo.HTTPError "Conflict"
 // @Failure 500 {object} echo.HTTPError "Internal Server Error"
@@ -304,19 +409,85 @@ func (h *handler) BuyPlayer(c echo.Context) error {
 		return echo.ErrUnauthorized.WithInternal(access.NewInvalidTokenError(userData))
 	}
 
-	if err := h.transferService.BuyPlayer(c.Request().Context(), transferId, userData.UserID); err != nil {
+	if _, err := h.transferService.BuyPlayer(c.Request().Context(), transferId, userData.UserID); err != nil {
 		if errors.Is(err, service.ErrTransferNotFound) {
 			return echo.ErrNotFound.WithInternal(err)
 		}
-		if errors.Is(err, service.ErrCantBuyFromYourself) {
+		if errors.Is(err, service.ErrCantBuyFromYourself) ||
+			errors.Is(err, service.ErrTransferIsAuction) {
 			return echo.ErrConflict.WithInternal(err)
 		}
 		if errors.Is(err, service.ErrNotEnoughFunds) {
 			return echo.ErrPaymentRequired.WithInternal(err)
 		}
+		if errors.Is(err, service.ErrTransferWindowClosed) {
+			return echo.ErrForbidden.WithInternal(err)
+		}
 
 		return err
 	}
 
 	return c.NoContent(http.StatusOK)
 }
+
+// parseTransferFilter parses market filters from the query, the error is always instance of *echo.HttpError
+func parseTransferFilter(c echo.Context) (domain.TransferFilter, error) {
+	var filter domain.TransferFilter
+	var err error
+
+	if position := c.QueryParam("position"); position != "" {
+		if !domain.PlayerPositionCode(position).Valid() {
+			return domain.TransferFilter{}, echo.ErrBadRequest.WithInternal(fmt.Errorf("invalid position: %s", position))
+		}
+		filter.PositionCode = position
+	}
+
+	if country := c.QueryParam("country"); country != "" {
+		if !domain.CountryCode(country).Valid() {
+			return domain.TransferFilter{}, echo.ErrBadRequest.WithInternal(fmt.Errorf("invalid country: %s", country))
+		}
+		filter.CountryCode = country
+	}
+
+	if filter.MinPrice, err = common.ParseQueryInt(c, "min_price", 64); err != nil {
+		return domain.TransferFilter{}, err
+	}
+	if filter.MaxPrice, err = common.ParseQueryInt(c, "max_price", 64); err != nil {
+		return domain.TransferFilter{}, err
+	}
+	if filter.SellerTeamID, err = common.ParseQueryInt(c, "seller_team_id", 64); err != nil {
+		return domain.TransferFilter{}, err
+	}
+
+	minAge, err := common.ParseQueryInt(c, "min_age", 32)
+	if err != nil {
+		return domain.TransferFilter{}, err
+	}
+	maxAge, err := common.ParseQueryInt(c, "max_age", 32)
+	if err != nil {
+		return domain.TransferFilter{}, err
+	}
+	filter.MinAge, filter.MaxAge = int32(minAge), int32(maxAge)
+
+	if filter.MinListingAge, err = common.ParseQueryDuration(c, "min_listing_age"); err != nil {
+		return domain.TransferFilter{}, err
+	}
+	if filter.MaxListingAge, err = common.ParseQueryDuration(c, "max_listing_age"); err != nil {
+		return domain.TransferFilter{}, err
+	}
+
+	filter.Sort = domain.TransferSort(c.QueryParam("sort"))
+	if !filter.Sort.Valid() {
+		return domain.TransferFilter{}, echo.ErrBadRequest.WithInternal(fmt.Errorf("invalid sort: %s", filter.Sort))
+	}
+
+	switch order := c.QueryParam("order"); order {
+	case "", "asc":
+	case "desc":
+		filter.Descending = true
+	default:
+		return domain.TransferFilter{}, echo.ErrBadRequest.WithInternal(fmt.Errorf("invalid order: %s", order))
+	}
+
+	return filter, nil
+}
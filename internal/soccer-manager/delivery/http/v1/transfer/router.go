@@ -16,6 +16,7 @@ func RegisterRoutes(g *echo.Group, c *delivery.Components, m *delivery.Middlewar
 	g.POST("/transfers", h.CreateTransfer, m.JWTMiddleware)
 	g.DELETE("/transfers/:transfer_id", h.DeleteTransfer, m.JWTMiddleware)
 	g.PUT("/transfers/:transfer_id", h.UpdateTransfer, m.JWTMiddleware)
+	g.POST("/transfers/:transfer_id/renew", h.RenewTransfer, m.JWTMiddleware)
 
 	g.POST("/transfers/:transfer_id/buy", h.BuyPlayer, m.JWTMiddleware)
 }
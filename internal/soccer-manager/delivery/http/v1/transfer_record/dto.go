@@ -12,6 +12,7 @@ type transferRecordResponseDTO struct {
 	SellerTeamID int64     `json:"seller_team_id"`
 	BuyerTeamID  int64     `json:"buyer_team_id"`
 	SoldPrice    int64     `json:"sold_price"`
+	Tax          int64     `json:"tax"`
 	ListedAt     time.Time `json:"listed_at"`
 	SoldAt       time.Time `json:"sold_at"`
 } // @name TransferRecordResponse
@@ -23,6 +24,7 @@ func transferRecordResponseAdapter(model domain.TransferRecord) transferRecordRe
 		SellerTeamID: model.SellerTeamID,
 		BuyerTeamID:  model.BuyerTeamID,
 		SoldPrice:    model.SoldPrice,
+		Tax:          model.Tax,
 		ListedAt:     model.ListedAt,
 		SoldAt:       model.SoldAt,
 	}
@@ -6,6 +6,7 @@ import (
 	"strconv"
 
 	"github.com/hexley21/soccer-manager/internal/common"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/service"
 	"github.com/labstack/echo/v4"
 )
@@ -34,11 +35,86 @@ func newHandler(
 // @Produce json
 // @Param cursor query int false "Pagination cursor"
 // @Param page_size query int false "Number of items per page"
+// @Param player_id query int false "Player ID"
+// @Param seller_team_id query int false "Seller team ID"
+// @Param buyer_team_id query int false "Buyer team ID"
+// @Param sold_from query string false "Sold at or after (RFC3339)"
+// @Param sold_to query string false "Sold before (RFC3339)"
 // @Success 200 {object} common.apiResponse{data=[]transferRecordResponseDTO} "OK"
 // @Failure 400 {object} echo.HTTPError "Bad Request"
 // @Failure 500 {object} echo.HTTPError "Internal Server Error"
 // @Router /v1/transfer-records [get]
 func (h *handler) GetTransferRecords(c echo.Context) error {
+	filter, err := parseTransferRecordFilter(c)
+	if err != nil {
+		return err
+	}
+
+	return h.listTransferRecords(c, filter)
+}
+
+// @Summary Get team's transfer records
+// @Description Returns transfer records where the team sold or bought a player (paginated)
+// @Tags transfer-records
+// @Produce json
+// @Param team_id path int true "Team ID"
+// @Param cursor query int false "Pagination cursor"
+// @Param page_size query int false "Number of items per page"
+// @Param player_id query int false "Player ID"
+// @Param seller_team_id query int false "Seller team ID"
+// @Param buyer_team_id query int false "Buyer team ID"
+// @Param sold_from query string false "Sold at or after (RFC3339)"
+// @Param sold_to query string false "Sold before (RFC3339)"
+// @Success 200 {object} common.apiResponse{data=[]transferRecordResponseDTO} "OK"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/teams/{team_id}/transfer-records [get]
+func (h *handler) GetTransferRecordsByTeamId(c echo.Context) error {
+	teamId, err := strconv.ParseInt(c.Param("team_id"), 10, 64)
+	if err != nil {
+		return echo.ErrBadRequest.WithInternal(err)
+	}
+
+	filter, err := parseTransferRecordFilter(c)
+	if err != nil {
+		return err
+	}
+	filter.TeamID = teamId
+
+	return h.listTransferRecords(c, filter)
+}
+
+// @Summary Get player's transfer records
+// @Description Returns the transfer history of a player (paginated)
+// @Tags transfer-records
+// @Produce json
+// @Param player_id path int true "Player ID"
+// @Param cursor query int false "Pagination cursor"
+// @Param page_size query int false "Number of items per page"
+// @Param seller_team_id query int false "Seller team ID"
+// @Param buyer_team_id query int false "Buyer team ID"
+// @Param sold_from query string false "Sold at or after (RFC3339)"
+// @Param sold_to query string false "Sold before (RFC3339)"
+// @Success 200 {object} common.apiResponse{data=[]transferRecordResponseDTO} "OK"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/players/{player_id}/transfer-records [get]
+func (h *handler) GetTransferRecordsByPlayerId(c echo.Context) error {
+	playerId, err := strconv.ParseInt(c.Param("player_id"), 10, 64)
+	if err != nil {
+		return echo.ErrBadRequest.WithInternal(err)
+	}
+
+	filter, err := parseTransferRecordFilter(c)
+	if err != nil {
+		return err
+	}
+	filter.PlayerID = playerId
+
+	return h.listTransferRecords(c, filter)
+}
+
+func (h *handler) listTransferRecords(c echo.Context, filter domain.TransferRecordFilter) error {
 	pagination, err := common.ParsePagination(c, h.pageSize, h.pageLimit)
 	if err != nil {
 		return err
@@ -46,10 +122,15 @@ func (h *handler) GetTransferRecords(c echo.Context) error {
 
 	records, err := h.transferRecordService.ListTransferRecords(
 		c.Request().Context(),
+		filter,
 		pagination.Cursor,
-		h.pageLimit,
+		pagination.PageSize,
 	)
 	if err != nil {
+		if errors.Is(err, service.ErrInvalidArguments) {
+			return echo.ErrBadRequest.WithInternal(err)
+		}
+
 		return err
 	}
 
@@ -90,3 +171,27 @@ func (h *handler) GetTransferRecordById(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, common.NewApiResponse(transferRecordResponseAdapter(record)))
 }
+
+// parseTransferRecordFilter parses record filters from the query, the error is always instance of *echo.HttpError
+func parseTransferRecordFilter(c echo.Context) (domain.TransferRecordFilter, error) {
+	var filter domain.TransferRecordFilter
+	var err error
+
+	if filter.PlayerID, err = common.ParseQueryInt(c, "player_id", 64); err != nil {
+		return domain.TransferRecordFilter{}, err
+	}
+	if filter.SellerTeamID, err = common.ParseQueryInt(c, "seller_team_id", 64); err != nil {
+		return domain.TransferRecordFilter{}, err
+	}
+	if filter.BuyerTeamID, err = common.ParseQueryInt(c, "buyer_team_id", 64); err != nil {
+		return domain.TransferRecordFilter{}, err
+	}
+	if filter.SoldFrom, err = common.ParseQueryTime(c, "sold_from"); err != nil {
+		return domain.TransferRecordFilter{}, err
+	}
+	if filter.SoldTo, err = common.ParseQueryTime(c, "sold_to"); err != nil {
+		return domain.TransferRecordFilter{}, err
+	}
+
+	return filter, nil
+}
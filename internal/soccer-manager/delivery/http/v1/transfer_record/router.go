@@ -10,4 +10,6 @@ func RegisterRoutes(g *echo.Group, c *delivery.Components) {
 
 	g.GET("/transfer-records", h.GetTransferRecords)
 	g.GET("/transfer-records/:record_id", h.GetTransferRecordById)
+	g.GET("/teams/:team_id/transfer-records", h.GetTransferRecordsByTeamId)
+	g.GET("/players/:player_id/transfer-records", h.GetTransferRecordsByPlayerId)
 }
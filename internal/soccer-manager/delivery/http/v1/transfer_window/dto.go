@@ -0,0 +1,51 @@
+package transfer_window
+
+import (
+	"time"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
+)
+
+type transferWindowResponseDTO struct {
+	ID        int64     `json:"id"`
+	Name      string    `json:"name"`
+	OpensAt   time.Time `json:"opens_at"`
+	ClosesAt  time.Time `json:"closes_at"`
+	CreatedAt time.Time `json:"created_at"`
+} // @name TransferWindowResponse
+
+func transferWindowResponseAdapter(model domain.TransferWindow) transferWindowResponseDTO {
+	return transferWindowResponseDTO{
+		ID:        model.ID,
+		Name:      model.Name,
+		OpensAt:   model.OpensAt,
+		ClosesAt:  model.ClosesAt,
+		CreatedAt: model.CreatedAt,
+	}
+}
+
+type transferWindowStatusResponseDTO struct {
+	IsOpen  bool                       `json:"is_open"`
+	Current *transferWindowResponseDTO `json:"current,omitempty"`
+	Next    *transferWindowResponseDTO `json:"next,omitempty"`
+} // @name TransferWindowStatusResponse
+
+func transferWindowStatusResponseAdapter(model domain.TransferWindowStatus) transferWindowStatusResponseDTO {
+	res := transferWindowStatusResponseDTO{IsOpen: model.IsOpen}
+	if model.Current != nil {
+		current := transferWindowResponseAdapter(*model.Current)
+		res.Current = &current
+	}
+	if model.Next != nil {
+		next := transferWindowResponseAdapter(*model.Next)
+		res.Next = &next
+	}
+
+	return res
+}
+
+type transferWindowRequestDTO struct {
+	Name     string    `json:"name"      validate:"required,max=64"`
+	OpensAt  time.Time `json:"opens_at"  validate:"required"`
+	ClosesAt time.Time `json:"closes_at" validate:"required,gtfield=OpensAt"`
+} // @name TransferWindowRequest
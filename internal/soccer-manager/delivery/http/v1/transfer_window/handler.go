@@ -0,0 +1,221 @@
+package transfer_window
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/hexley21/soccer-manager/internal/common"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/service"
+	"github.com/labstack/echo/v4"
+)
+
+type handler struct {
+	transferWindowService service.TransferWindowService
+	pageSize              int32
+	pageLimit             int32
+}
+
+func newHandler(
+	transferWindowService service.TransferWindowService,
+	pageSize int32,
+	pageLimit int32,
+) *handler {
+	return &handler{
+		transferWindowService: transferWindowService,
+		pageSize:              pageSize,
+		pageLimit:             pageLimit,
+	}
+}
+
+// @Summary List transfer windows
+// @Description Returns scheduled transfer windows (paginated)
+// @Tags transfer-windows
+// @Produce json
+// @Param cursor query int false "Pagination cursor"
+// @Param page_size query int false "Number of items per page"
+// @Success 200 {object} common.apiResponse{data=[]transferWindowResponseDTO} "OK"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/transfer-windows [get]
+func (h *handler) GetTransferWindows(c echo.Context) error {
+	pagination, err := common.ParsePagination(c, h.pageSize, h.pageLimit)
+	if err != nil {
+		return err
+	}
+
+	windows, err := h.transferWindowService.ListTransferWindows(
+		c.Request().Context(),
+		pagination.Cursor,
+		pagination.PageSize,
+	)
+	if err != nil {
+		return err
+	}
+
+	res := make([]transferWindowResponseDTO, len(windows))
+	for i, w := range windows {
+		res[i] = transferWindowResponseAdapter(w)
+	}
+
+	return c.JSON(http.StatusOK, common.NewApiResponse(res))
+}
+
+// @Summary Current transfer window
+// @Description Tells whether the market is open, the current window and when the next one opens
+// @Tags transfer-windows
+// @Produce json
+// @Success 200 {object} common.apiResponse{data=transferWindowStatusResponseDTO} "OK"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/transfer-windows/current [get]
+func (h *handler) GetCurrentTransferWindow(c echo.Context) error {
+	status, err := h.transferWindowService.GetTransferWindowStatus(c.Request().Context())
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, common.NewApiResponse(transferWindowStatusResponseAdapter(status)))
+}
+
+// @Summary Get transfer window by ID
+// @Description Returns a transfer window by ID
+// @Tags transfer-windows
+// @Produce json
+// @Param window_id path int true "Transfer window ID"
+// @Success 200 {object} common.apiResponse{data=transferWindowResponseDTO} "OK"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 404 {object} echo.HTTPError "Not Found"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/transfer-windows/{window_id} [get]
+func (h *handler) GetTransferWindowById(c echo.Context) error {
+	windowId, err := strconv.ParseInt(c.Param("window_id"), 10, 64)
+	if err != nil {
+		return echo.ErrBadRequest.WithInternal(err)
+	}
+
+	window, err := h.transferWindowService.GetTransferWindowByID(c.Request().Context(), windowId)
+	if err != nil {
+		return transferWindowHTTPError(err)
+	}
+
+	return c.JSON(http.StatusOK, common.NewApiResponse(transferWindowResponseAdapter(window)))
+}
+
+// @Summary Create transfer window
+// @Description Schedules a period when the market is open, windows can't overlap
+// @Tags transfer-windows
+// @Accept json
+// @Produce json
+// @Security AccessToken
+// @Param request body transferWindowRequestDTO true "Transfer window"
+// @Success 201 {object} common.apiResponse{data=transferWindowResponseDTO} "Created"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 401 {object} echo.HTTPError "Unauthorized"
+// @Failure 403 {object} echo.HTTPError "Forbidden"
+// @Failure 409 {object} echo.HTTPError "Conflict"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/transfer-windows [post]
+func (h *handler) CreateTransferWindow(c echo.Context) error {
+	var req transferWindowRequestDTO
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+	if err := c.Validate(&req); err != nil {
+		return echo.ErrBadRequest.WithInternal(err)
+	}
+
+	window, err := h.transferWindowService.CreateTransferWindow(
+		c.Request().Context(),
+		req.Name,
+		req.OpensAt,
+		req.ClosesAt,
+	)
+	if err != nil {
+		return transferWindowHTTPError(err)
+	}
+
+	return c.JSON(http.StatusCreated, common.NewApiResponse(transferWindowResponseAdapter(window)))
+}
+
+// @Summary Update transfer window
+// @Description Renames or reschedules a transfer window
+// @Tags transfer-windows
+// @Accept json
+// @Produce json
+// @Security AccessToken
+// @Param window_id path int true "Transfer window ID"
+// @Param request body transferWindowRequestDTO true "Transfer window"
+// @Success 200 {object} common.apiResponse{data=transferWindowResponseDTO} "OK"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 401 {object} echo.HTTPError "Unauthorized"
+// @Failure 403 {object} echo.HTTPError "Forbidden"
+// @Failure 404 {object} echo.HTTPError "Not Found"
+// @Failure 409 {object} echo.HTTPError "Conflict"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/transfer-windows/{window_id} [put]
+func (h *handler) UpdateTransferWindow(c echo.Context) error {
+	windowId, err := strconv.ParseInt(c.Param("window_id"), 10, 64)
+	if err != nil {
+		return echo.ErrBadRequest.WithInternal(err)
+	}
+
+	var req transferWindowRequestDTO
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+	if err := c.Validate(&req); err != nil {
+		return echo.ErrBadRequest.WithInternal(err)
+	}
+
+	window, err := h.transferWindowService.UpdateTransferWindow(
+		c.Request().Context(),
+		windowId,
+		req.Name,
+		req.OpensAt,
+		req.ClosesAt,
+	)
+	if err != nil {
+		return transferWindowHTTPError(err)
+	}
+
+	return c.JSON(http.StatusOK, common.NewApiResponse(transferWindowResponseAdapter(window)))
+}
+
+// @Summary Delete transfer window
+// @Description Deletes a transfer window by ID
+// @Tags transfer-windows
+// @Security AccessToken
+// @Param window_id path int true "Transfer window ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 401 {object} echo.HTTPError "Unauthorized"
+// @Failure 403 {object} echo.HTTPError "Forbidden"
+// @Failure 404 {object} echo.HTTPError "Not Found"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/transfer-windows/{window_id} [delete]
+func (h *handler) DeleteTransferWindow(c echo.Context) error {
+	windowId, err := strconv.ParseInt(c.Param("window_id"), 10, 64)
+	if err != nil {
+		return echo.ErrBadRequest.WithInternal(err)
+	}
+
+	if err := h.transferWindowService.DeleteTransferWindow(c.Request().Context(), windowId); err != nil {
+		return transferWindowHTTPError(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func transferWindowHTTPError(err error) error {
+	if errors.Is(err, service.ErrTransferWindowNotFound) {
+		return echo.ErrNotFound.WithInternal(err)
+	}
+	if errors.Is(err, service.ErrTransferWindowOverlaps) {
+		return echo.ErrConflict.WithInternal(err)
+	}
+	if errors.Is(err, service.ErrInvalidArguments) {
+		return echo.ErrBadRequest.WithInternal(err)
+	}
+
+	return err
+}
@@ -0,0 +1,18 @@
+package transfer_window
+
+import (
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/delivery"
+	"github.com/labstack/echo/v4"
+)
+
+func RegisterRoutes(g *echo.Group, c *delivery.Components, m *delivery.Middlewares) {
+	h := newHandler(c.Services.TransferWindowService, c.Cfg.Pagination.M, c.Cfg.Pagination.L)
+
+	g.GET("/transfer-windows", h.GetTransferWindows)
+	g.GET("/transfer-windows/current", h.GetCurrentTransferWindow)
+	g.GET("/transfer-windows/:window_id", h.GetTransferWindowById)
+
+	g.POST("/transfer-windows", h.CreateTransferWindow, m.JWTMiddleware, m.IsAdmin)
+	g.PUT("/transfer-windows/:window_id", h.UpdateTransferWindow, m.JWTMiddleware, m.IsAdmin)
+	g.DELETE("/transfer-windows/:window_id", h.DeleteTransferWindow, m.JWTMiddleware, m.IsAdmin)
+}
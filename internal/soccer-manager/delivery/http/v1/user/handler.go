@@ -3,8 +3,10 @@ package user
 import (
 	"errors"
 	"net/http"
+	"strconv"
 
 	"github.com/hexley21/soccer-manager/internal/common"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/jwt/access"
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/service"
 	"github.com/labstack/echo/v4"
@@ -114,7 +116,7 @@ func (h *handler) DeleteMe(c echo.Context) error {
 }
 
 // @Summary Change current user's password
-// @Description Change the authenticated user's password
+// @Description Change the authenticated user's password, every other session of the user is logged out
 // @Tags users
 // @Accept json
 // @Security AccessToken
@@ -144,7 +146,13 @@ func (h *handler) ChangePasswordMe(c echo.Context) error {
 		return echo.ErrUnauthorized.WithInternal(err)
 	}
 
-	if err := h.userService.UpdatePassword(c.Request().Context(), userData.UserID, req.OldPassword, req.NewPassword); err != nil {
+	if err := h.userService.UpdatePassword(
+		c.Request().Context(),
+		userData.UserID,
+		userData.SessionID,
+		req.OldPassword,
+		req.NewPassword,
+	); err != nil {
 		if errors.Is(err, service.ErrUserNotFound) {
 			c.Logger().Error("failed to find user on password-change: %v", err)
 			return echo.ErrNotFound.WithInternal(err)
@@ -158,3 +166,63 @@ func (h *handler) ChangePasswordMe(c echo.Context) error {
 
 	return c.NoContent(http.StatusOK)
 }
+
+// @Summary Promote user to admin (ADMIN)
+// @Description Grants the user ADMIN, the user is logged out everywhere and gets the role on the next login
+// @Tags users
+// @Security AccessToken
+// @Produce json
+// @Param user_id path int true "User ID"
+// @Success 200 {object} common.apiResponse{data=userResponseDTO} "OK"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 401 {object} echo.HTTPError "Unauthorized"
+// @Failure 403 {object} echo.HTTPError "Forbidden"
+// @Failure 404 {object} echo.HTTPError "Not Found"
+// @Failure 409 {object} echo.HTTPError "Conflict"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/users/{user_id}/promote [post]
+func (h *handler) Promote(c echo.Context) error {
+	return h.changeRole(c, domain.UserRoleADMIN)
+}
+
+// @Summary Demote admin to user (ADMIN)
+// @Description Takes ADMIN away from the user, the user is logged out everywhere. The last admin can't be demoted
+// @Tags users
+// @Security AccessToken
+// @Produce json
+// @Param user_id path int true "User ID"
+// @Success 200 {object} common.apiResponse{data=userResponseDTO} "OK"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 401 {object} echo.HTTPError "Unauthorized"
+// @Failure 403 {object} echo.HTTPError "Forbidden"
+// @Failure 404 {object} echo.HTTPError "Not Found"
+// @Failure 409 {object} echo.HTTPError "Conflict"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/users/{user_id}/demote [post]
+func (h *handler) Demote(c echo.Context) error {
+	return h.changeRole(c, domain.UserRoleUSER)
+}
+
+func (h *handler) changeRole(c echo.Context, role domain.UserRole) error {
+	userId, err := strconv.ParseInt(c.Param("user_id"), 10, 64)
+	if err != nil {
+		return echo.ErrBadRequest.WithInternal(err)
+	}
+
+	user, err := h.userService.ChangeRole(c.Request().Context(), userId, role)
+	if err != nil {
+		if errors.Is(err, service.ErrUserNotFound) {
+			return echo.ErrNotFound.WithInternal(err)
+		}
+		if errors.Is(err, service.ErrRoleUnchanged) || errors.Is(err, service.ErrLastAdmin) {
+			return echo.ErrConflict.WithInternal(err)
+		}
+
+		c.Logger().Errorf("failed to change role of user %d: %v", userId, err)
+		return err
+	}
+
+	return c.JSON(http.StatusOK, common.NewApiResponse(
+		NewUserResponseDTO(user.ID, user.Username, string(user.Role)),
+	))
+}
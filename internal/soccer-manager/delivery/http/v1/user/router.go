@@ -13,6 +13,8 @@ func RegisterRoutes(g *echo.Group, c *delivery.Components, m *delivery.Middlewar
 	)
 
 	g.GET("", h.List, m.JWTMiddleware, m.IsAdmin)
+	g.POST("/:user_id/promote", h.Promote, m.JWTMiddleware, m.IsAdmin)
+	g.POST("/:user_id/demote", h.Demote, m.JWTMiddleware, m.IsAdmin)
 
 	meGroup := g.Group("/me", m.JWTMiddleware)
 
@@ -0,0 +1,39 @@
+package watchlist
+
+import (
+	"time"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
+)
+
+type watchlistPlayerResponseDTO struct {
+	PlayerID      int64     `json:"player_id"`
+	TeamID        int64     `json:"team_id,omitempty"`
+	FirstName     string    `json:"first_name"`
+	LastName      string    `json:"last_name"`
+	Age           int32     `json:"age"`
+	PositionCode  string    `json:"position_code"`
+	Price         int64     `json:"price"`
+	TransferID    int64     `json:"transfer_id,omitempty"`
+	TransferPrice int64     `json:"transfer_price,omitempty"`
+	WatchedAt     time.Time `json:"watched_at"`
+} // @name WatchlistPlayerResponse
+
+func watchlistPlayerResponseAdapter(model domain.WatchlistPlayer) watchlistPlayerResponseDTO {
+	return watchlistPlayerResponseDTO{
+		PlayerID:      model.PlayerID,
+		TeamID:        model.TeamID,
+		FirstName:     model.FirstName,
+		LastName:      model.LastName,
+		Age:           model.Age,
+		PositionCode:  string(model.PositionCode),
+		Price:         model.Price,
+		TransferID:    model.TransferID,
+		TransferPrice: model.TransferPrice,
+		WatchedAt:     model.WatchedAt,
+	}
+}
+
+type watchPlayerRequestDTO struct {
+	PlayerID int64 `json:"player_id" validate:"required"`
+} // @name WatchPlayerRequest
@@ -0,0 +1,140 @@
+package watchlist
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/hexley21/soccer-manager/internal/common"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/jwt/access"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/service"
+	"github.com/labstack/echo/v4"
+)
+
+type handler struct {
+	watchlistService service.WatchlistService
+	pageSize         int32
+	pageLimit        int32
+}
+
+func newHandler(watchlistService service.WatchlistService, pageSize int32, pageLimit int32) *handler {
+	return &handler{
+		watchlistService: watchlistService,
+		pageSize:         pageSize,
+		pageLimit:        pageLimit,
+	}
+}
+
+// @Summary Get my watchlist
+// @Description Returns watched players with their live listings (paginated), the cursor is the last player id of the previous page
+// @Tags watchlist
+// @Security AccessToken
+// @Produce json
+// @Param cursor query int false "Pagination cursor"
+// @Param page_size query int false "Number of items per page"
+// @Success 200 {object} common.apiResponse{data=[]watchlistPlayerResponseDTO} "OK"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 401 {object} echo.HTTPError "Unauthorized"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/users/me/watchlist [get]
+func (h *handler) GetWatchlist(c echo.Context) error {
+	userData, ok := c.Get(access.CtxKey).(access.Data)
+	if !ok {
+		return echo.ErrUnauthorized.WithInternal(access.NewInvalidTokenError(userData))
+	}
+
+	pagination, err := common.ParsePagination(c, h.pageSize, h.pageLimit)
+	if err != nil {
+		return err
+	}
+
+	players, err := h.watchlistService.ListWatchlist(
+		c.Request().Context(),
+		userData.UserID,
+		pagination.Cursor,
+		pagination.PageSize,
+	)
+	if err != nil {
+		return err
+	}
+
+	res := make([]watchlistPlayerResponseDTO, len(players))
+	for i, p := range players {
+		res[i] = watchlistPlayerResponseAdapter(p)
+	}
+
+	return c.JSON(http.StatusOK, common.NewApiResponse(res))
+}
+
+// @Summary Watch player
+// @Description Adds a player to the watchlist, watchers are notified when it's listed or repriced
+// @Tags watchlist
+// @Security AccessToken
+// @Accept json
+// @Param request body watchPlayerRequestDTO true "Player to watch"
+// @Success 201 "Created"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 401 {object} echo.HTTPError "Unauthorized"
+// @Failure 404 {object} echo.HTTPError "Not Found"
+// @Failure 409 {object} echo.HTTPError "Conflict"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/users/me/watchlist [post]
+func (h *handler) WatchPlayer(c echo.Context) error {
+	userData, ok := c.Get(access.CtxKey).(access.Data)
+	if !ok {
+		return echo.ErrUnauthorized.WithInternal(access.NewInvalidTokenError(userData))
+	}
+
+	var req watchPlayerRequestDTO
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+	if err := c.Validate(&req); err != nil {
+		return echo.ErrBadRequest.WithInternal(err)
+	}
+
+	if err := h.watchlistService.WatchPlayer(c.Request().Context(), userData.UserID, req.PlayerID); err != nil {
+		if errors.Is(err, service.ErrPlayerNotFound) {
+			return echo.ErrNotFound.WithInternal(err)
+		}
+		if errors.Is(err, service.ErrPlayerAlreadyWatched) {
+			return echo.ErrConflict.WithInternal(err)
+		}
+
+		return err
+	}
+
+	return c.NoContent(http.StatusCreated)
+}
+
+// @Summary Unwatch player
+// @Description Removes a player from the watchlist
+// @Tags watchlist
+// @Security AccessToken
+// @Param player_id path int true "Player ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} echo.HTTPError "Bad Request"
+// @Failure 401 {object} echo.HTTPError "Unauthorized"
+// @Failure 404 {object} echo.HTTPError "Not Found"
+// @Failure 500 {object} echo.HTTPError "Internal Server Error"
+// @Router /v1/users/me/watchlist/{player_id} [delete]
+func (h *handler) UnwatchPlayer(c echo.Context) error {
+	playerId, err := strconv.ParseInt(c.Param("player_id"), 10, 64)
+	if err != nil {
+		return echo.ErrBadRequest.WithInternal(err)
+	}
+	userData, ok := c.Get(access.CtxKey).(access.Data)
+	if !ok {
+		return echo.ErrUnauthorized.WithInternal(access.NewInvalidTokenError(userData))
+	}
+
+	if err := h.watchlistService.UnwatchPlayer(c.Request().Context(), userData.UserID, playerId); err != nil {
+		if errors.Is(err, service.ErrPlayerNotWatched) {
+			return echo.ErrNotFound.WithInternal(err)
+		}
+
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
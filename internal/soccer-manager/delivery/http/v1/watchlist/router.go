@@ -0,0 +1,14 @@
+package watchlist
+
+import (
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/delivery"
+	"github.com/labstack/echo/v4"
+)
+
+func RegisterRoutes(g *echo.Group, c *delivery.Components, m *delivery.Middlewares) {
+	h := newHandler(c.Services.WatchlistService, c.Cfg.Pagination.M, c.Cfg.Pagination.L)
+
+	g.GET("/users/me/watchlist", h.GetWatchlist, m.JWTMiddleware)
+	g.POST("/users/me/watchlist", h.WatchPlayer, m.JWTMiddleware)
+	g.DELETE("/users/me/watchlist/:player_id", h.UnwatchPlayer, m.JWTMiddleware)
+}
@@ -0,0 +1,26 @@
+package well_known
+
+import (
+	"net/http"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/jwt"
+	"github.com/labstack/echo/v4"
+)
+
+// jwksMaxAge lets verifiers cache the keys, a new key has to be published at least this long before signing with it
+const jwksMaxAge = "max-age=300"
+
+type handler struct {
+	accessKeys *jwt.KeySet
+}
+
+func newHandler(accessKeys *jwt.KeySet) *handler {
+	return &handler{accessKeys: accessKeys}
+}
+
+// JWKS serves the public keys of access tokens (RFC 7517), it lives outside of /api
+// so verifiers find it at the conventional path. Empty while tokens are signed with HS256
+func (h *handler) JWKS(c echo.Context) error {
+	c.Response().Header().Set(echo.HeaderCacheControl, jwksMaxAge)
+	return c.JSON(http.StatusOK, h.accessKeys.JWKS())
+}
@@ -0,0 +1,12 @@
+package well_known
+
+import (
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/delivery"
+	"github.com/labstack/echo/v4"
+)
+
+func RegisterRoutes(g *echo.Group, c *delivery.Components) {
+	h := newHandler(c.JWTManagers.AccessKeys)
+
+	g.GET("/jwks.json", h.JWKS)
+}
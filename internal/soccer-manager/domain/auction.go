@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/repository"
+)
+
+type AuctionOutcome string // @name AuctionOutcome
+
+const (
+	AuctionOutcomeSOLD   AuctionOutcome = "SOLD"
+	AuctionOutcomeUNSOLD AuctionOutcome = "UNSOLD"
+)
+
+func (e AuctionOutcome) Valid() bool {
+	switch e {
+	case AuctionOutcomeSOLD,
+		AuctionOutcomeUNSOLD:
+		return true
+	}
+	return false
+}
+
+// AuctionResult is the settled outcome of an auction,
+// winner fields are zero when the player went unsold
+type AuctionResult struct {
+	ID           int64
+	TransferID   int64
+	PlayerID     int64
+	SellerTeamID int64
+	WinningBidID int64
+	BuyerTeamID  int64
+	SoldPrice    int64
+	ReservePrice int64
+	Outcome      AuctionOutcome
+	EndedAt      time.Time
+	SettledAt    time.Time
+}
+
+func AuctionResultAdapter(model repository.AuctionResult) AuctionResult {
+	return AuctionResult{
+		ID:           model.ID,
+		TransferID:   model.TransferID,
+		PlayerID:     model.PlayerID,
+		SellerTeamID: model.SellerTeamID,
+		WinningBidID: model.WinningBidID.Int64,
+		BuyerTeamID:  model.BuyerTeamID.Int64,
+		SoldPrice:    model.SoldPrice.Int64,
+		ReservePrice: model.ReservePrice,
+		Outcome:      AuctionOutcome(model.Outcome),
+		EndedAt:      model.EndedAt.Time,
+		SettledAt:    model.SettledAt.Time,
+	}
+}
@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/repository"
+)
+
+type BidStatus string // @name BidStatus
+
+const (
+	BidStatusPENDING   BidStatus = "PENDING"
+	BidStatusCOUNTERED BidStatus = "COUNTERED"
+	BidStatusACCEPTED  BidStatus = "ACCEPTED"
+	BidStatusREJECTED  BidStatus = "REJECTED"
+	BidStatusWITHDRAWN BidStatus = "WITHDRAWN"
+	BidStatusCANCELLED BidStatus = "CANCELLED"
+)
+
+func (e BidStatus) Valid() bool {
+	switch e {
+	case BidStatusPENDING,
+		BidStatusCOUNTERED,
+		BidStatusACCEPTED,
+		BidStatusREJECTED,
+		BidStatusWITHDRAWN,
+		BidStatusCANCELLED:
+		return true
+	}
+	return false
+}
+
+// Bid is an offer on a listed transfer, TransferID is zero once the listing is gone
+type Bid struct {
+	ID            int64
+	TransferID    int64
+	PlayerID      int64
+	SellerTeamID  int64
+	BidderTeamID  int64
+	Amount        int64
+	CounterAmount int64
+	Status        BidStatus
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func BidAdapter(model repository.Bid) Bid {
+	return Bid{
+		ID:            model.ID,
+		TransferID:    model.TransferID.Int64,
+		PlayerID:      model.PlayerID,
+		SellerTeamID:  model.SellerTeamID,
+		BidderTeamID:  model.BidderTeamID,
+		Amount:        model.Amount,
+		CounterAmount: model.CounterAmount.Int64,
+		Status:        BidStatus(model.Status),
+		CreatedAt:     model.CreatedAt.Time,
+		UpdatedAt:     model.UpdatedAt.Time,
+	}
+}
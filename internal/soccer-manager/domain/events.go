@@ -1,3 +1,36 @@
 package domain
 
-const EventONSIGNUP = "auth.Register"
+import "github.com/hexley21/soccer-manager/internal/soccer-manager/repository"
+
+// EventONSIGNUP carries a domain.SignUp, it's delivered from the outbox
+const EventONSIGNUP = repository.OutboxTopicSignUp
+
+// EventONTRANSFEREXPIRED carries the lapsed domain.Transfer after it was delisted, it's delivered from the outbox
+const EventONTRANSFEREXPIRED = repository.OutboxTopicTransferExpired
+
+// EventONTRANSFERLISTED carries the id of a new listing, it's delivered from the outbox
+const EventONTRANSFERLISTED = repository.OutboxTopicTransferListed
+
+// EventONTRANSFERPRICECHANGED carries the id of a repriced listing, it's delivered from the outbox
+const EventONTRANSFERPRICECHANGED = repository.OutboxTopicTransferPriceChanged
+
+// EventONWATCHLISTALERT carries a domain.WatchlistAlert, one per watcher
+const EventONWATCHLISTALERT = "watchlist.Alert"
+
+// EventONTEAMNOTICE carries a domain.TeamNotice for the inbox of the team owner
+const EventONTEAMNOTICE = "notification.Team"
+
+// EventONTRANSFERSOLD carries the id of the domain.TransferRecord of a completed sale, it's delivered from the outbox
+const EventONTRANSFERSOLD = repository.OutboxTopicTransferSold
+
+// EventONAUCTIONSETTLED carries the id of the domain.AuctionResult of a settled auction, it's delivered from the outbox
+const EventONAUCTIONSETTLED = repository.OutboxTopicAuctionSettled
+
+// EventONNOTIFICATION carries a domain.Notification once it's in the inbox
+const EventONNOTIFICATION = "notification.Created"
+
+// EventONMATCHPLAYED carries the id of a played match
+const EventONMATCHPLAYED = "match.Played"
+
+// EventONTEAMUPDATED carries the id of the user whose team was updated
+const EventONTEAMUPDATED = "team.Updated"
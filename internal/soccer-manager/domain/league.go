@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/repository"
+)
+
+type LeagueStatus string // @name LeagueStatus
+
+const (
+	LeagueStatusENROLLING LeagueStatus = "ENROLLING"
+	LeagueStatusSTARTED   LeagueStatus = "STARTED"
+)
+
+func (e LeagueStatus) Valid() bool {
+	switch e {
+	case LeagueStatusENROLLING,
+		LeagueStatusSTARTED:
+		return true
+	}
+	return false
+}
+
+type League struct {
+	ID        int64
+	Name      string
+	Status    LeagueStatus
+	CreatedAt time.Time
+}
+
+func LeagueAdapter(model repository.League) League {
+	return League{
+		ID:        model.ID,
+		Name:      model.Name,
+		Status:    LeagueStatus(model.Status),
+		CreatedAt: model.CreatedAt.Time,
+	}
+}
+
+// Fixture is a scheduled league game, MatchID is zero until it's played
+type Fixture struct {
+	ID         int64
+	LeagueID   int64
+	Round      int32
+	HomeTeamID int64
+	AwayTeamID int64
+	MatchID    int64
+	HomeScore  int32
+	AwayScore  int32
+	PlayedAt   time.Time
+}
+
+func (f Fixture) Played() bool {
+	return f.MatchID != 0
+}
+
+func FixtureAdapter(model repository.Fixture) Fixture {
+	return Fixture{
+		ID:         model.ID,
+		LeagueID:   model.LeagueID,
+		Round:      model.Round,
+		HomeTeamID: model.HomeTeamID,
+		AwayTeamID: model.AwayTeamID,
+		MatchID:    model.MatchID.Int64,
+		HomeScore:  model.HomeScore.Int32,
+		AwayScore:  model.AwayScore.Int32,
+		PlayedAt:   model.PlayedAt.Time,
+	}
+}
+
+type FormResult string // @name FormResult
+
+const (
+	FormResultWIN  FormResult = "W"
+	FormResultDRAW FormResult = "D"
+	FormResultLOSS FormResult = "L"
+)
+
+// Standing is a single row of the league table
+//
+// Form holds the latest results, the most recent one is the last
+type Standing struct {
+	TeamID         int64
+	Played         int32
+	Won            int32
+	Drawn          int32
+	Lost           int32
+	GoalsFor       int32
+	GoalsAgainst   int32
+	GoalDifference int32
+	Points         int32
+	Form           []FormResult
+}
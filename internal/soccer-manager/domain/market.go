@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/repository"
+)
+
+// MarketStats aggregates transfer records sold since Since
+type MarketStats struct {
+	Since                  time.Time
+	GeneratedAt            time.Time
+	DailyVolume            []MarketDayVolume
+	AveragePriceByPosition []MarketPositionPrice
+	TopTransfers           []TransferRecord
+	TopSpenders            []MarketTeamTotal
+	TopSellers             []MarketTeamTotal
+	AverageTimeToSell      time.Duration
+}
+
+type MarketDayVolume struct {
+	Day    time.Time
+	Sales  int64
+	Volume int64
+}
+
+func MarketDayVolumeAdapter(model repository.MarketDayVolume) MarketDayVolume {
+	return MarketDayVolume{
+		Day:    model.Day.Time,
+		Sales:  model.Sales,
+		Volume: model.Volume,
+	}
+}
+
+type MarketPositionPrice struct {
+	PositionCode PlayerPositionCode
+	Sales        int64
+	AveragePrice int64
+}
+
+func MarketPositionPriceAdapter(model repository.MarketPositionPrice) MarketPositionPrice {
+	return MarketPositionPrice{
+		PositionCode: PlayerPositionCode(model.PositionCode),
+		Sales:        model.Sales,
+		AveragePrice: model.AveragePrice,
+	}
+}
+
+// MarketTeamTotal is money spent by a buyer or earned after tax by a seller
+type MarketTeamTotal struct {
+	TeamID    int64
+	Transfers int64
+	Total     int64
+}
+
+func MarketTeamTotalAdapter(model repository.MarketTeamTotal) MarketTeamTotal {
+	return MarketTeamTotal{
+		TeamID:    model.TeamID,
+		Transfers: model.Transfers,
+		Total:     model.Total,
+	}
+}
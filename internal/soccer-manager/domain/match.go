@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/repository"
+)
+
+type MatchEventType string // @name MatchEventType
+
+const (
+	MatchEventTypeGOAL         MatchEventType = "GOAL"
+	MatchEventTypeYELLOWCARD   MatchEventType = "YELLOW_CARD"
+	MatchEventTypeREDCARD      MatchEventType = "RED_CARD"
+	MatchEventTypeSUBSTITUTION MatchEventType = "SUBSTITUTION"
+)
+
+func (e MatchEventType) Valid() bool {
+	switch e {
+	case MatchEventTypeGOAL,
+		MatchEventTypeYELLOWCARD,
+		MatchEventTypeREDCARD,
+		MatchEventTypeSUBSTITUTION:
+		return true
+	}
+	return false
+}
+
+type Match struct {
+	ID         int64
+	HomeTeamID int64
+	AwayTeamID int64
+	Seed       int64
+	HomeScore  int32
+	AwayScore  int32
+	PlayedAt   time.Time
+	Events     []MatchEvent
+}
+
+// MatchEvent is a single entry of the match timeline
+//
+// For substitutions PlayerID is the player leaving the pitch
+// and RelatedPlayerID is the one coming on
+type MatchEvent struct {
+	Minute          int32
+	Type            MatchEventType
+	TeamID          int64
+	PlayerID        int64
+	RelatedPlayerID int64
+}
+
+func MatchAdapter(model repository.Match) Match {
+	return Match{
+		ID:         model.ID,
+		HomeTeamID: model.HomeTeamID,
+		AwayTeamID: model.AwayTeamID,
+		Seed:       model.Seed,
+		HomeScore:  model.HomeScore,
+		AwayScore:  model.AwayScore,
+		PlayedAt:   model.PlayedAt.Time,
+	}
+}
+
+func MatchEventAdapter(model repository.MatchEvent) MatchEvent {
+	return MatchEvent{
+		Minute:          model.Minute,
+		Type:            MatchEventType(model.EventType),
+		TeamID:          model.TeamID,
+		PlayerID:        model.PlayerID.Int64,
+		RelatedPlayerID: model.RelatedPlayerID.Int64,
+	}
+}
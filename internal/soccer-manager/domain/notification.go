@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/repository"
+)
+
+type NotificationKind string // @name NotificationKind
+
+const (
+	NotificationPlayerSold      NotificationKind = "PLAYER_SOLD"
+	NotificationPlayerBought    NotificationKind = "PLAYER_BOUGHT"
+	NotificationTeamCreated     NotificationKind = "TEAM_CREATED"
+	NotificationWatchedListed   NotificationKind = "WATCHLIST_LISTED"
+	NotificationWatchedRepriced NotificationKind = "WATCHLIST_PRICE_CHANGED"
+	NotificationLeagueEnrolled  NotificationKind = "LEAGUE_ENROLLED"
+	NotificationLeagueLeft      NotificationKind = "LEAGUE_UNENROLLED"
+	NotificationMatchPlayed     NotificationKind = "MATCH_PLAYED"
+)
+
+// Notification is an inbox entry, Payload is a JSON object depending on Kind
+// and ReadAt is zero while unread
+type Notification struct {
+	ID        int64
+	UserID    int64
+	Kind      NotificationKind
+	Payload   json.RawMessage
+	ReadAt    time.Time
+	CreatedAt time.Time
+}
+
+func NotificationAdapter(model repository.Notification) Notification {
+	return Notification{
+		ID:        model.ID,
+		UserID:    model.UserID,
+		Kind:      NotificationKind(model.Kind),
+		Payload:   model.Payload,
+		ReadAt:    model.ReadAt.Time,
+		CreatedAt: model.CreatedAt.Time,
+	}
+}
+
+// NotificationInbox is a page of notifications and the total of unread ones
+type NotificationInbox struct {
+	Unread        int64
+	Notifications []Notification
+}
+
+// TeamNotice addresses the owner of a team, Payload is marshalled to JSON
+type TeamNotice struct {
+	TeamID  int64
+	Kind    NotificationKind
+	Payload any
+}
@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/repository"
+)
+
+type OfferStatus string // @name OfferStatus
+
+const (
+	OfferStatusPENDING   OfferStatus = "PENDING"
+	OfferStatusACCEPTED  OfferStatus = "ACCEPTED"
+	OfferStatusDECLINED  OfferStatus = "DECLINED"
+	OfferStatusWITHDRAWN OfferStatus = "WITHDRAWN"
+	OfferStatusCANCELLED OfferStatus = "CANCELLED"
+)
+
+func (e OfferStatus) Valid() bool {
+	switch e {
+	case OfferStatusPENDING,
+		OfferStatusACCEPTED,
+		OfferStatusDECLINED,
+		OfferStatusWITHDRAWN,
+		OfferStatusCANCELLED:
+		return true
+	}
+	return false
+}
+
+// Offer is a direct purchase offer for a player that is not listed
+type Offer struct {
+	ID           int64
+	PlayerID     int64
+	SellerTeamID int64
+	BuyerTeamID  int64
+	Amount       int64
+	Status       OfferStatus
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+func OfferAdapter(model repository.Offer) Offer {
+	return Offer{
+		ID:           model.ID,
+		PlayerID:     model.PlayerID,
+		SellerTeamID: model.SellerTeamID,
+		BuyerTeamID:  model.BuyerTeamID,
+		Amount:       model.Amount,
+		Status:       OfferStatus(model.Status),
+		CreatedAt:    model.CreatedAt.Time,
+		UpdatedAt:    model.UpdatedAt.Time,
+	}
+}
@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/repository"
+)
+
+// OutboxEvent is a domain event waiting for its handlers, DeliveredTo names the handlers that already got it.
+// DeadAt is set once the event ran out of attempts
+type OutboxEvent struct {
+	ID          int64
+	Topic       string
+	Payload     json.RawMessage
+	Attempts    int32
+	DeliveredTo []string
+	LastError   string
+	AvailableAt time.Time
+	DeadAt      time.Time
+	CreatedAt   time.Time
+}
+
+func OutboxEventAdapter(model repository.OutboxEvent) OutboxEvent {
+	return OutboxEvent{
+		ID:          model.ID,
+		Topic:       model.Topic,
+		Payload:     model.Payload,
+		Attempts:    model.Attempts,
+		DeliveredTo: model.DeliveredTo,
+		LastError:   model.LastError.String,
+		AvailableAt: model.AvailableAt.Time,
+		DeadAt:      model.DeadAt.Time,
+		CreatedAt:   model.CreatedAt.Time,
+	}
+}
+
+// SignUp is the payload of EventONSIGNUP
+type SignUp struct {
+	UserID   int64
+	Username string
+}
+
+func DecodeSignUp(payload json.RawMessage) (SignUp, error) {
+	var model repository.OutboxSignUp
+	if err := json.Unmarshal(payload, &model); err != nil {
+		return SignUp{}, err
+	}
+
+	return SignUp{UserID: model.UserID, Username: model.Username}, nil
+}
+
+// DecodeRef reads the id carried by events that point to a row
+func DecodeRef(payload json.RawMessage) (int64, error) {
+	var model repository.OutboxRef
+	if err := json.Unmarshal(payload, &model); err != nil {
+		return 0, err
+	}
+
+	return model.ID, nil
+}
+
+// DecodeTransfer reads the listing carried by EventONTRANSFEREXPIRED
+func DecodeTransfer(payload json.RawMessage) (Transfer, error) {
+	var model repository.Transfer
+	if err := json.Unmarshal(payload, &model); err != nil {
+		return Transfer{}, err
+	}
+
+	return TransferAdapter(model), nil
+}
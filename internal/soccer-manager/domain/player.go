@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/rating"
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/repository"
 )
 
@@ -13,6 +14,12 @@ type Player struct {
 	Age          int32              `json:"age"`
 	PositionCode PlayerPositionCode `json:"position_code"`
 	Price        int64    `json:"price"`
+	Attributes   rating.Attributes `json:"attributes"`
+}
+
+// Rating returns player's overall rating derived from attributes and position
+func (p Player) Rating() int32 {
+	return rating.Overall(string(p.PositionCode), p.Attributes)
 }
 
 func PlayerAdapter(model repository.Player) Player {
@@ -25,5 +32,13 @@ func PlayerAdapter(model repository.Player) Player {
 		Age:          model.Age,
 		PositionCode: PlayerPositionCode(model.PositionCode),
 		Price:        model.Price,
+		Attributes: rating.Attributes{
+			Pace:        model.Pace,
+			Shooting:    model.Shooting,
+			Passing:     model.Passing,
+			Defending:   model.Defending,
+			Goalkeeping: model.Goalkeeping,
+			Stamina:     model.Stamina,
+		},
 	}
 }
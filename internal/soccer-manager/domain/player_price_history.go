@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/repository"
+)
+
+// PriceHistoryBucket groups a price chart, empty keeps every recorded price
+type PriceHistoryBucket string // @name PriceHistoryBucket
+
+const (
+	PriceHistoryBucketNONE PriceHistoryBucket = ""
+	PriceHistoryBucketDAY  PriceHistoryBucket = "day"
+	PriceHistoryBucketWEEK PriceHistoryBucket = "week"
+)
+
+func (e PriceHistoryBucket) Valid() bool {
+	switch e {
+	case PriceHistoryBucketNONE,
+		PriceHistoryBucketDAY,
+		PriceHistoryBucketWEEK:
+		return true
+	}
+	return false
+}
+
+// PlayerPricePoint is a point of player's price chart.
+// Bucketed points start at At, Price is the last price in the bucket
+type PlayerPricePoint struct {
+	At        time.Time
+	Price     int64
+	LowPrice  int64
+	HighPrice int64
+}
+
+func PlayerPricePointAdapter(model repository.PlayerPricePoint) PlayerPricePoint {
+	return PlayerPricePoint{
+		At:        model.At.Time,
+		Price:     model.Price,
+		LowPrice:  model.LowPrice,
+		HighPrice: model.HighPrice,
+	}
+}
@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/repository"
+)
+
+type ProvisioningStatus string // @name ProvisioningStatus
+
+const (
+	ProvisioningPending   ProvisioningStatus = repository.ProvisioningPending
+	ProvisioningCompleted ProvisioningStatus = repository.ProvisioningCompleted
+	ProvisioningFailed    ProvisioningStatus = repository.ProvisioningFailed
+)
+
+// Provisioning tracks the setup of a new user's team, TeamID is zero until the team exists.
+// A failed provisioning ran out of attempts and waits for an admin
+type Provisioning struct {
+	UserID    int64
+	Status    ProvisioningStatus
+	TeamID    int64
+	Attempts  int32
+	LastError string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func ProvisioningAdapter(model repository.UserProvisioning) Provisioning {
+	return Provisioning{
+		UserID:    model.UserID,
+		Status:    ProvisioningStatus(model.Status),
+		TeamID:    model.TeamID.Int64,
+		Attempts:  model.Attempts,
+		LastError: model.LastError.String,
+		CreatedAt: model.CreatedAt.Time,
+		UpdatedAt: model.UpdatedAt.Time,
+	}
+}
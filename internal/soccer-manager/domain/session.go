@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/repository"
+)
+
+type SessionRevokeReason string // @name SessionRevokeReason
+
+const (
+	SessionRevokedLogout         SessionRevokeReason = repository.SessionRevokedLogout
+	SessionRevokedLogoutAll      SessionRevokeReason = repository.SessionRevokedLogoutAll
+	SessionRevokedByUser         SessionRevokeReason = repository.SessionRevokedByUser
+	SessionRevokedByAdmin        SessionRevokeReason = repository.SessionRevokedByAdmin
+	SessionRevokedPasswordChange SessionRevokeReason = repository.SessionRevokedPasswordChange
+	SessionRevokedReuse          SessionRevokeReason = repository.SessionRevokedReuse
+	SessionRevokedRoleChange     SessionRevokeReason = repository.SessionRevokedRoleChange
+)
+
+// SessionClient describes who logged in or refreshed
+type SessionClient struct {
+	UserAgent string
+	IP        string
+}
+
+// Session is a family of refresh tokens of a single login, TokenID is the only token of it that's still valid.
+// RevokedAt is zero while the session is active
+type Session struct {
+	ID            int64
+	UserID        int64
+	TokenID       int64
+	Client        SessionClient
+	CreatedAt     time.Time
+	LastUsedAt    time.Time
+	ExpiresAt     time.Time
+	RevokedAt     time.Time
+	RevokedReason SessionRevokeReason
+}
+
+func SessionAdapter(model repository.Session) Session {
+	return Session{
+		ID:            model.ID,
+		UserID:        model.UserID,
+		TokenID:       model.TokenID,
+		Client:        SessionClient{UserAgent: model.UserAgent, IP: model.IP},
+		CreatedAt:     model.CreatedAt.Time,
+		LastUsedAt:    model.LastUsedAt.Time,
+		ExpiresAt:     model.ExpiresAt.Time,
+		RevokedAt:     model.RevokedAt.Time,
+		RevokedReason: SessionRevokeReason(model.RevokedReason.String),
+	}
+}
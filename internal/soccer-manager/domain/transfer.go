@@ -6,20 +6,75 @@ import (
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/repository"
 )
 
+// Transfer is a listed player, auctions have AuctionEndsAt and ReservePrice set,
+// their Price is the opening price. Fixed price listings lapse at ExpiresAt unless it's zero
 type Transfer struct {
-	ID           int64
-	PlayerID     int64
-	SellerTeamID int64
-	Price        int64
-	ListedAt     time.Time
+	ID            int64
+	PlayerID      int64
+	SellerTeamID  int64
+	Price         int64
+	ListedAt      time.Time
+	AuctionEndsAt time.Time
+	ReservePrice  int64
+	ExpiresAt     time.Time
+}
+
+func (t Transfer) IsAuction() bool {
+	return !t.AuctionEndsAt.IsZero()
 }
 
 func TransferAdapter(model repository.Transfer) Transfer {
 	return Transfer{
-		ID:           model.ID,
-		PlayerID:     model.PlayerID,
-		SellerTeamID: model.SellerTeamID,
-		Price:        model.Price,
-		ListedAt:     model.ListedAt.Time,
+		ID:            model.ID,
+		PlayerID:      model.PlayerID,
+		SellerTeamID:  model.SellerTeamID,
+		Price:         model.Price,
+		ListedAt:      model.ListedAt.Time,
+		AuctionEndsAt: model.AuctionEndsAt.Time,
+		ReservePrice:  model.ReservePrice.Int64,
+		ExpiresAt:     model.ExpiresAt.Time,
+	}
+}
+
+type TransferSort string // @name TransferSort
+
+// Listings sorted by listed_at are ordered by id, snowflake ids follow the listing time
+// and listed_at never changes after listing
+const (
+	TransferSortID       TransferSort = ""
+	TransferSortPrice    TransferSort = "price"
+	TransferSortListedAt TransferSort = "listed_at"
+)
+
+func (e TransferSort) Valid() bool {
+	switch e {
+	case TransferSortID,
+		TransferSortPrice,
+		TransferSortListedAt:
+		return true
 	}
+	return false
+}
+
+// TransferCursor is the last listing of the previous page,
+// Price is its sort key when sorted by price and ignored otherwise
+type TransferCursor struct {
+	ID    int64
+	Price int64
+}
+
+// TransferFilter narrows down the market, zero fields are ignored.
+// Listing ages are measured from now, MinListingAge keeps listings at least that old
+type TransferFilter struct {
+	PositionCode  string
+	MinPrice      int64
+	MaxPrice      int64
+	MinAge        int32
+	MaxAge        int32
+	CountryCode   string
+	SellerTeamID  int64
+	MinListingAge time.Duration
+	MaxListingAge time.Duration
+	Sort          TransferSort
+	Descending    bool
 }
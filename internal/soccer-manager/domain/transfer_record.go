@@ -6,12 +6,14 @@ import (
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/repository"
 )
 
+// TransferRecord is a completed sale, the seller was credited SoldPrice - Tax
 type TransferRecord struct {
 	ID           int64
 	PlayerID     int64
 	SellerTeamID int64
 	BuyerTeamID  int64
 	SoldPrice    int64
+	Tax          int64
 	ListedAt     time.Time
 	SoldAt       time.Time
 }
@@ -23,7 +25,19 @@ func TransferRecordAdapter(model repository.TransferRecord) TransferRecord {
 		SellerTeamID: model.SellerTeamID,
 		BuyerTeamID:  model.BuyerTeamID,
 		SoldPrice:    model.SoldPrice,
+		Tax:          model.Tax,
 		ListedAt:     model.ListedAt.Time,
 		SoldAt:       model.SoldAt.Time,
 	}
 }
+
+// TransferRecordFilter narrows down transfer history, zero fields are ignored.
+// TeamID matches either side of the sale, SoldTo is exclusive
+type TransferRecordFilter struct {
+	PlayerID     int64
+	SellerTeamID int64
+	BuyerTeamID  int64
+	TeamID       int64
+	SoldFrom     time.Time
+	SoldTo       time.Time
+}
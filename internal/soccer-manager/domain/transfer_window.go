@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/repository"
+)
+
+// TransferWindow is a period when the market is open
+type TransferWindow struct {
+	ID        int64
+	Name      string
+	OpensAt   time.Time
+	ClosesAt  time.Time
+	CreatedAt time.Time
+}
+
+func TransferWindowAdapter(model repository.TransferWindow) TransferWindow {
+	return TransferWindow{
+		ID:        model.ID,
+		Name:      model.Name,
+		OpensAt:   model.OpensAt.Time,
+		ClosesAt:  model.ClosesAt.Time,
+		CreatedAt: model.CreatedAt.Time,
+	}
+}
+
+// TransferWindowStatus tells whether the market is open now,
+// Current is set while it's open and Next when a window is scheduled
+type TransferWindowStatus struct {
+	IsOpen  bool
+	Current *TransferWindow
+	Next    *TransferWindow
+}
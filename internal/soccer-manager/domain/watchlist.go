@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/repository"
+)
+
+// WatchlistPlayer is a watched player, TransferID and TransferPrice are zero while it's not listed
+type WatchlistPlayer struct {
+	PlayerID      int64
+	TeamID        int64
+	FirstName     string
+	LastName      string
+	Age           int32
+	PositionCode  PlayerPositionCode
+	Price         int64
+	TransferID    int64
+	TransferPrice int64
+	WatchedAt     time.Time
+}
+
+func WatchlistPlayerAdapter(model repository.WatchlistPlayer) WatchlistPlayer {
+	return WatchlistPlayer{
+		PlayerID:      model.PlayerID,
+		TeamID:        model.TeamID.Int64,
+		FirstName:     model.FirstName,
+		LastName:      model.LastName,
+		Age:           model.Age,
+		PositionCode:  PlayerPositionCode(model.PositionCode),
+		Price:         model.Price,
+		TransferID:    model.TransferID.Int64,
+		TransferPrice: model.TransferPrice.Int64,
+		WatchedAt:     model.WatchedAt.Time,
+	}
+}
+
+type WatchlistAlertKind string // @name WatchlistAlertKind
+
+const (
+	WatchlistAlertListed       WatchlistAlertKind = "LISTED"
+	WatchlistAlertPriceChanged WatchlistAlertKind = "PRICE_CHANGED"
+)
+
+// WatchlistAlert tells a watcher their player was listed or repriced
+type WatchlistAlert struct {
+	UserID     int64
+	Kind       WatchlistAlertKind
+	TransferID int64
+	PlayerID   int64
+	Price      int64
+}
@@ -0,0 +1,76 @@
+package event
+
+import (
+	"context"
+	"time"
+
+	evbus "github.com/asaskevich/EventBus"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/service"
+	"github.com/labstack/echo/v4"
+)
+
+// notificationHandlerImpl puts published notices into inboxes and announces them as domain.EventONNOTIFICATION
+type notificationHandlerImpl struct {
+	notificationService service.NotificationService
+	eventEmitter        evbus.BusPublisher
+	timeout             time.Duration
+
+	logger echo.Logger
+}
+
+func newNotificationHandler(
+	notificationService service.NotificationService,
+	eventEmitter evbus.BusPublisher,
+	timeout time.Duration,
+	logger echo.Logger,
+) *notificationHandlerImpl {
+	return &notificationHandlerImpl{
+		notificationService: notificationService,
+		eventEmitter:        eventEmitter,
+		timeout:             timeout,
+		logger:              logger,
+	}
+}
+
+func (h *notificationHandlerImpl) HandleTeamNotice(notice domain.TeamNotice) {
+	h.run(func(ctx context.Context) (domain.Notification, error) {
+		return h.notificationService.NotifyTeam(ctx, notice)
+	})
+}
+
+func (h *notificationHandlerImpl) HandleWatchlistAlert(alert domain.WatchlistAlert) {
+	kind := domain.NotificationWatchedListed
+	if alert.Kind == domain.WatchlistAlertPriceChanged {
+		kind = domain.NotificationWatchedRepriced
+	}
+
+	h.run(func(ctx context.Context) (domain.Notification, error) {
+		return h.notificationService.NotifyUser(ctx, alert.UserID, kind, map[string]int64{
+			"transfer_id": alert.TransferID,
+			"player_id":   alert.PlayerID,
+			"price":       alert.Price,
+		})
+	})
+}
+
+func (h *notificationHandlerImpl) run(notify func(ctx context.Context) (domain.Notification, error)) {
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				h.logger.Errorf("panic recovered while notifying: %v", r)
+			}
+		}()
+
+		ctx, cancel := context.WithTimeout(context.Background(), h.timeout)
+		defer cancel()
+
+		notification, err := notify(ctx)
+		if err != nil {
+			h.logger.Errorf("failed to notify: %v", err)
+			return
+		}
+
+		h.eventEmitter.Publish(domain.EventONNOTIFICATION, notification)
+	}()
+}
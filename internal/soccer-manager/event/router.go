@@ -4,15 +4,66 @@ import (
 	evbus "github.com/asaskevich/EventBus"
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/delivery"
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/outbox"
 )
 
 func RegisterEventHandlers(eventEmitter evbus.BusSubscriber, c *delivery.Components) {
 	onSignup := newUserSignUpHandler(
 		c.Services.TeamService,
 		c.Services.PlayerService,
+		c.Services.ProvisioningService,
+		c.Services.NotificationService,
+		c.EventBus,
 		c.Cfg.Events.UserSignUp,
 		c.Logger,
 	)
 
-	eventEmitter.Subscribe(domain.EventONSIGNUP, onSignup.Handle)
+	outbox.On(c.Outbox, domain.EventONSIGNUP, "team provisioning", domain.DecodeSignUp, onSignup.Handle)
+
+	onListingChange := newWatchlistHandler(
+		c.Services.TransferService,
+		c.Services.WatchlistService,
+		c.EventBus,
+		c.Cfg.Events.Watchlist.Timeout,
+		c.Logger,
+	)
+
+	outbox.On(c.Outbox, domain.EventONTRANSFERLISTED, "watchlist alerts", domain.DecodeRef, onListingChange.HandleListed)
+	outbox.On(
+		c.Outbox,
+		domain.EventONTRANSFERPRICECHANGED,
+		"watchlist alerts",
+		domain.DecodeRef,
+		onListingChange.HandlePriceChanged,
+	)
+
+	onNotice := newNotificationHandler(
+		c.Services.NotificationService,
+		c.EventBus,
+		c.Cfg.Events.Notifications.Timeout,
+		c.Logger,
+	)
+
+	eventEmitter.Subscribe(domain.EventONTEAMNOTICE, onNotice.HandleTeamNotice)
+	eventEmitter.Subscribe(domain.EventONWATCHLISTALERT, onNotice.HandleWatchlistAlert)
+
+	onStreamed := newStreamHandler(
+		c.Services.TransferService,
+		c.Services.TransferRecordService,
+		c.Services.AuctionService,
+		c.Services.MatchService,
+		c.Services.TeamService,
+		c.Broker,
+		c.Cfg.Stream.Timeout,
+		c.Logger,
+	)
+
+	outbox.On(c.Outbox, domain.EventONTRANSFERLISTED, "stream", domain.DecodeRef, onStreamed.HandleListed)
+	outbox.On(c.Outbox, domain.EventONTRANSFERPRICECHANGED, "stream", domain.DecodeRef, onStreamed.HandlePriceChanged)
+	outbox.On(c.Outbox, domain.EventONTRANSFEREXPIRED, "stream", domain.DecodeTransfer, onStreamed.HandleExpired)
+	outbox.On(c.Outbox, domain.EventONTRANSFERSOLD, "stream", domain.DecodeRef, onStreamed.HandleSold)
+	outbox.On(c.Outbox, domain.EventONAUCTIONSETTLED, "stream", domain.DecodeRef, onStreamed.HandleAuctionSettled)
+	eventEmitter.Subscribe(domain.EventONMATCHPLAYED, onStreamed.HandleMatchPlayed)
+	eventEmitter.Subscribe(domain.EventONTEAMUPDATED, onStreamed.HandleTeamUpdated)
+	eventEmitter.Subscribe(domain.EventONNOTIFICATION, onStreamed.HandleNotification)
 }
@@ -0,0 +1,157 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/service"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/stream"
+	"github.com/labstack/echo/v4"
+)
+
+// streamHandlerImpl relays bus and outbox events to the stream broker, the broker never blocks
+type streamHandlerImpl struct {
+	transferService       service.TransferService
+	transferRecordService service.TransferRecordService
+	auctionService        service.AuctionService
+	matchService          service.MatchService
+	teamService           service.TeamService
+	broker                *stream.Broker
+	timeout               time.Duration
+
+	logger echo.Logger
+}
+
+func newStreamHandler(
+	transferService service.TransferService,
+	transferRecordService service.TransferRecordService,
+	auctionService service.AuctionService,
+	matchService service.MatchService,
+	teamService service.TeamService,
+	broker *stream.Broker,
+	timeout time.Duration,
+	logger echo.Logger,
+) *streamHandlerImpl {
+	return &streamHandlerImpl{
+		transferService:       transferService,
+		transferRecordService: transferRecordService,
+		auctionService:        auctionService,
+		matchService:          matchService,
+		teamService:           teamService,
+		broker:                broker,
+		timeout:               timeout,
+		logger:                logger,
+	}
+}
+
+func (h *streamHandlerImpl) HandleListed(ctx context.Context, transferID int64) error {
+	return h.relayTransfer(ctx, stream.TransferListed, transferID)
+}
+
+func (h *streamHandlerImpl) HandlePriceChanged(ctx context.Context, transferID int64) error {
+	return h.relayTransfer(ctx, stream.TransferPriceChanged, transferID)
+}
+
+func (h *streamHandlerImpl) HandleMatchPlayed(matchID int64) {
+	h.relay(func(ctx context.Context) (stream.Message, error) {
+		match, err := h.matchService.GetMatchByID(ctx, matchID)
+		if err != nil {
+			return stream.Message{}, err
+		}
+
+		return stream.MatchPlayed(match), nil
+	})
+}
+
+func (h *streamHandlerImpl) HandleTeamUpdated(userID int64) {
+	h.relay(func(ctx context.Context) (stream.Message, error) {
+		team, err := h.teamService.GetTeamByUserId(ctx, domain.LocaleCode(""), userID)
+		if err != nil {
+			return stream.Message{}, err
+		}
+
+		return stream.TeamUpdated(team), nil
+	})
+}
+
+func (h *streamHandlerImpl) HandleExpired(ctx context.Context, transfer domain.Transfer) error {
+	h.broker.Publish(stream.TransferExpired(transfer))
+	return nil
+}
+
+func (h *streamHandlerImpl) HandleSold(ctx context.Context, recordID int64) error {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	record, err := h.transferRecordService.GetTransferRecordByID(ctx, recordID)
+	if err != nil {
+		return fmt.Errorf("fetch transfer record %d: %w", recordID, err)
+	}
+
+	h.broker.Publish(stream.TransferSold(record))
+	return nil
+}
+
+func (h *streamHandlerImpl) HandleAuctionSettled(ctx context.Context, resultID int64) error {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	result, err := h.auctionService.GetAuctionResultByID(ctx, resultID)
+	if err != nil {
+		return fmt.Errorf("fetch auction result %d: %w", resultID, err)
+	}
+
+	h.broker.Publish(stream.AuctionSettled(result))
+	return nil
+}
+
+func (h *streamHandlerImpl) HandleNotification(notification domain.Notification) {
+	h.broker.Publish(stream.Notification(notification))
+}
+
+func (h *streamHandlerImpl) relayTransfer(
+	ctx context.Context,
+	message func(domain.Transfer) stream.Message,
+	transferID int64,
+) error {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	transfer, err := h.transferService.GetTransferByID(ctx, transferID)
+	if err != nil {
+		// sold or delisted since, the listing has its own event
+		if errors.Is(err, service.ErrTransferNotFound) {
+			return nil
+		}
+
+		return fmt.Errorf("fetch transfer %d: %w", transferID, err)
+	}
+
+	h.broker.Publish(message(transfer))
+	return nil
+}
+
+// relay builds the message from fresh data in the background, the publisher isn't held up by lookups
+func (h *streamHandlerImpl) relay(build func(ctx context.Context) (stream.Message, error)) {
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				h.logger.Errorf("panic recovered while streaming: %v", r)
+			}
+		}()
+
+		ctx, cancel := context.WithTimeout(context.Background(), h.timeout)
+		defer cancel()
+
+		msg, err := build(ctx)
+		if err != nil {
+			h.logger.Errorf("failed to build stream message: %v", err)
+			return
+		}
+
+		h.broker.Publish(msg)
+	}()
+}
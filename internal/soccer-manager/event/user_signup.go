@@ -2,89 +2,144 @@ package event
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"math/rand"
 	"time"
 
+	evbus "github.com/asaskevich/EventBus"
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/rating"
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/service"
 	"github.com/hexley21/soccer-manager/pkg/config"
 	"github.com/labstack/echo/v4"
 )
 
 type userSignUpHandlerImpl struct {
-	teamService   service.TeamService
-	playerService service.PlayerService
-	cfg           config.UserSignUp
+	teamService         service.TeamService
+	playerService       service.PlayerService
+	provisioningService service.ProvisioningService
+	notificationService service.NotificationService
+	eventEmitter        evbus.BusPublisher
+	cfg                 config.UserSignUp
 
 	logger echo.Logger
-
-	sem chan struct{}
 }
 
 func newUserSignUpHandler(
 	teamService service.TeamService,
 	playerService service.PlayerService,
+	provisioningService service.ProvisioningService,
+	notificationService service.NotificationService,
+	eventEmitter evbus.BusPublisher,
 	cfg config.UserSignUp,
 	logger echo.Logger,
 ) *userSignUpHandlerImpl {
 	return &userSignUpHandlerImpl{
-		teamService:   teamService,
-		playerService: playerService,
-		cfg:           cfg,
-		logger:        logger,
-
-		sem: make(chan struct{}, cfg.GoroutineCount),
+		teamService:         teamService,
+		playerService:       playerService,
+		provisioningService: provisioningService,
+		notificationService: notificationService,
+		eventEmitter:        eventEmitter,
+		cfg:                 cfg,
+		logger:              logger,
 	}
 }
 
-func (h *userSignUpHandlerImpl) Acquire() {
-	h.sem <- struct{}{}
-}
+// Handle provisions the team of a new user and tracks every attempt,
+// a failed attempt is retried by the outbox until the provisioning gives up
+//
+// TODO: add random or ip based team assignation
+func (h *userSignUpHandlerImpl) Handle(ctx context.Context, signUp domain.SignUp) error {
+	h.logger.Debugf("received signal for: %v %v", signUp.UserID, signUp.Username)
+
+	ctx, cancel := context.WithTimeout(ctx, h.cfg.Timeout)
+	defer cancel()
+
+	attempt, err := h.provisioningService.StartAttempt(ctx, signUp.UserID)
+	if err != nil {
+		// the account was deleted in the meantime
+		if errors.Is(err, service.ErrUserNotFound) {
+			return nil
+		}
+
+		return err
+	}
+	if attempt.Status == domain.ProvisioningCompleted {
+		h.logger.Debugf("on signup already done, user: %v", signUp.UserID)
+		return nil
+	}
+
+	team, err := h.provision(ctx, signUp)
+	if err != nil {
+		status, failErr := h.provisioningService.FailAttempt(ctx, attempt, team.ID, err)
+		if failErr != nil {
+			return errors.Join(err, failErr)
+		}
+		if status == domain.ProvisioningFailed {
+			h.logger.Errorf("gave up provisioning user %d after %d attempts: %v", signUp.UserID, attempt.Attempts, err)
+			return nil
+		}
+
+		return err
+	}
 
-func (h *userSignUpHandlerImpl) Release() {
-	<-h.sem
+	if err := h.provisioningService.CompleteProvisioning(ctx, signUp.UserID, team.ID); err != nil {
+		return err
+	}
+
+	notification, err := h.notificationService.NotifyUser(ctx, signUp.UserID, domain.NotificationTeamCreated, map[string]any{
+		"team_id": team.ID,
+		"name":    team.Name,
+	})
+	if err != nil {
+		// the team is in place, a retry wouldn't notify again
+		h.logger.Errorf("failed to notify about team creation at signup: %v", err)
+	} else {
+		h.eventEmitter.Publish(domain.EventONNOTIFICATION, notification)
+	}
+
+	h.logger.Debugf("on signup done, team: %v", team)
+	return nil
 }
 
-// TODO: add random or ip based team assignation
-// TODO: add process failure handling
-func (h *userSignUpHandlerImpl) Handle(userId int64, username string) {
-	h.logger.Debugf("received signal for: %v %v", userId, username)
-	go func() {
-		defer func() {
-			if r := recover(); r != nil {
-				h.logger.Errorf("panic recovered while handling user signup: %v", r)
-			}
-		}()
-
-		h.Acquire()
-		defer h.Release()
-
-		ctx, cancel := context.WithTimeout(context.Background(), h.cfg.Timeout)
-		defer cancel()
-
-		team, err := h.Createteam(ctx, userId, username, h.cfg.TeamBudgetParsed)
+// provision creates whatever an earlier attempt left out, the team is returned even if players failed
+func (h *userSignUpHandlerImpl) provision(ctx context.Context, signUp domain.SignUp) (domain.Team, error) {
+	team, err := h.teamService.GetTeamByUserId(ctx, domain.LocaleCode(""), signUp.UserID)
+	switch {
+	case errors.Is(err, service.ErrTeamNotFound):
+		team, err = h.Createteam(ctx, signUp.UserID, signUp.Username, h.cfg.TeamBudgetParsed)
 		if err != nil {
-			h.logger.Errorf("failed to create teams at signup: %v", err)
-			return
+			return domain.Team{}, fmt.Errorf("create team: %w", err)
 		}
-
-		// prepare argments for palyer insertion
-		args := buildBatchPlayers(
-			team.ID,
-			team.CountryCode,
-			h.cfg.PlayerMinAge,
-			h.cfg.PlayerMaxAge,
-			h.cfg.PlayerBudgetParsed,
-			h.cfg.TeamMembers,
-		)
-
-		if err := h.playerService.CreatePlayersBatch(ctx, args); err != nil {
-			h.logger.Errorf("failed to create players at signup: %v", err)
-			return
+	case err != nil:
+		return domain.Team{}, err
+	default:
+		players, err := h.playerService.GetPlayersByTeamId(ctx, team.ID, 0, 1)
+		if err != nil {
+			return team, err
+		}
+		if len(players) > 0 {
+			return team, nil
 		}
+	}
+
+	// prepare argments for palyer insertion
+	args := buildBatchPlayers(
+		team.ID,
+		team.CountryCode,
+		h.cfg.PlayerMinAge,
+		h.cfg.PlayerMaxAge,
+		h.cfg.PlayerBudgetParsed,
+		h.cfg.TeamMembers,
+	)
+
+	// a concurrent run of the same signup may have filled the team meanwhile
+	if err := h.playerService.CreateRoster(ctx, team.ID, args); err != nil && !errors.Is(err, service.ErrTeamHasPlayers) {
+		return team, fmt.Errorf("create players: %w", err)
+	}
 
-		h.logger.Debugf("on signup done, team: %v", team)
-	}()
+	return team, nil
 }
 
 func (h *userSignUpHandlerImpl) Createteam(
@@ -128,6 +183,7 @@ func buildBatchPlayers(
 	var players []service.CreatePlayerArgs
 	for position, amount := range members {
 		for range amount {
+			attributes := rating.Generate(rand, string(position))
 			player := service.NewCreatePlayerArgs(
 				teamID,
 				countryCode,
@@ -135,7 +191,8 @@ func buildBatchPlayers(
 				"lastname",
 				int32(rand.Intn(maxAge-minAge+1)+minAge),
 				position,
-				price,
+				rating.Price(price, rating.Overall(string(position), attributes)),
+				attributes,
 			)
 			players = append(players, player)
 		}
@@ -0,0 +1,81 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	evbus "github.com/asaskevich/EventBus"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/service"
+	"github.com/labstack/echo/v4"
+)
+
+// watchlistHandlerImpl fans listing changes out to watchers of the player as domain.EventONWATCHLISTALERT
+type watchlistHandlerImpl struct {
+	transferService  service.TransferService
+	watchlistService service.WatchlistService
+	eventEmitter     evbus.BusPublisher
+	timeout          time.Duration
+
+	logger echo.Logger
+}
+
+func newWatchlistHandler(
+	transferService service.TransferService,
+	watchlistService service.WatchlistService,
+	eventEmitter evbus.BusPublisher,
+	timeout time.Duration,
+	logger echo.Logger,
+) *watchlistHandlerImpl {
+	return &watchlistHandlerImpl{
+		transferService:  transferService,
+		watchlistService: watchlistService,
+		eventEmitter:     eventEmitter,
+		timeout:          timeout,
+		logger:           logger,
+	}
+}
+
+func (h *watchlistHandlerImpl) HandleListed(ctx context.Context, transferID int64) error {
+	return h.alert(ctx, domain.WatchlistAlertListed, transferID)
+}
+
+func (h *watchlistHandlerImpl) HandlePriceChanged(ctx context.Context, transferID int64) error {
+	return h.alert(ctx, domain.WatchlistAlertPriceChanged, transferID)
+}
+
+func (h *watchlistHandlerImpl) alert(ctx context.Context, kind domain.WatchlistAlertKind, transferID int64) error {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	// the listing is read back so watchers see its state after the change
+	transfer, err := h.transferService.GetTransferByID(ctx, transferID)
+	if err != nil {
+		// sold or delisted since, there's nothing to watch anymore
+		if errors.Is(err, service.ErrTransferNotFound) {
+			return nil
+		}
+
+		return fmt.Errorf("fetch transfer %d: %w", transferID, err)
+	}
+
+	watchers, err := h.watchlistService.ListWatchers(ctx, transfer)
+	if err != nil {
+		return fmt.Errorf("fetch watchers of player %d: %w", transfer.PlayerID, err)
+	}
+
+	for _, userID := range watchers {
+		h.eventEmitter.Publish(domain.EventONWATCHLISTALERT, domain.WatchlistAlert{
+			UserID:     userID,
+			Kind:       kind,
+			TransferID: transfer.ID,
+			PlayerID:   transfer.PlayerID,
+			Price:      transfer.Price,
+		})
+	}
+
+	h.logger.Debugf("alerted %d watchers of player %d: %s", len(watchers), transfer.PlayerID, kind)
+	return nil
+}
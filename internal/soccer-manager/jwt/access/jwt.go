@@ -7,21 +7,23 @@ import (
 	jwtgo "github.com/golang-jwt/jwt/v5"
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/jwt"
-	"github.com/hexley21/soccer-manager/pkg/config"
 )
 
 const CtxKey = "access_user"
 
 type Manager = jwt.ManagerWithTTL[Data]
 
+// Data is the identity of the bearer, SessionID is the session the token was issued for
 type Data struct {
-	UserID int64           `json:"uid"`
-	Role   domain.UserRole `json:"role"`
+	UserID    int64           `json:"uid"`
+	SessionID int64           `json:"sid"`
+	Role      domain.UserRole `json:"role"`
 }
 
-func NewData(UserID int64, Role domain.UserRole) Data {
+func NewData(UserID int64, SessionID int64, Role domain.UserRole) Data {
 	return Data{
 		UserID: UserID,
+		SessionID: SessionID,
 		Role: Role,
 	}
 }
@@ -32,20 +34,18 @@ type Claims struct {
 }
 
 type tokenManager struct {
-	secret string
-	ttl    time.Duration
+	keys *jwt.KeySet
+	ttl  time.Duration
 }
 
-func NewManager(cfg config.TokenParams) *tokenManager {
+func NewManager(keys *jwt.KeySet, ttl time.Duration) *tokenManager {
 	return &tokenManager{
-		secret: cfg.Secret,
-		ttl:    cfg.TTL,
+		keys: keys,
+		ttl:  ttl,
 	}
 }
 
 func (m *tokenManager) CreateTokenString(data Data) (string, error) {
-	keyByte := []byte(m.secret)
-
 	currTime := time.Now()
 
 	claims := Claims{
@@ -56,8 +56,7 @@ func (m *tokenManager) CreateTokenString(data Data) (string, error) {
 		},
 	}
 
-	token := jwtgo.NewWithClaims(jwtgo.SigningMethodHS256, claims)
-	ss, err := token.SignedString(keyByte)
+	ss, err := m.keys.Sign(claims)
 	if err != nil {
 		return "", fmt.Errorf("%w: %w", jwt.ErrErrorSigningToken, err)
 	}
@@ -69,9 +68,8 @@ func (m *tokenManager) ParseTokenString(tokenString string) (Data, error) {
 	token, err := jwtgo.ParseWithClaims(
 		tokenString,
 		&Claims{},
-		func(token *jwtgo.Token) (interface{}, error) {
-			return []byte(m.secret), nil
-		},
+		m.keys.Keyfunc,
+		jwtgo.WithValidMethods(m.keys.ValidMethods()),
 	)
 	if err != nil {
 		return Data{}, fmt.Errorf("%w: %w", jwt.ErrErrorParsingToken, err)
@@ -8,15 +8,14 @@ import (
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/jwt"
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/jwt/access"
-	"github.com/hexley21/soccer-manager/pkg/config"
 	"github.com/stretchr/testify/assert"
 )
 
 func Test_CreateTokenString(t *testing.T) {
-	manager := access.NewManager(config.TokenParams{Secret: "secret", TTL: time.Hour})
+	manager := access.NewManager(jwt.NewHMACKeySet("secret"), time.Hour)
 
 	t.Run("OK", func(t *testing.T) {
-		token, err := manager.CreateTokenString(access.NewData(1234, domain.UserRoleADMIN))
+		token, err := manager.CreateTokenString(access.NewData(1234, 1, domain.UserRoleADMIN))
 
 		assert.NoError(t, err)
 		assert.NotEmpty(t, token)
@@ -24,10 +23,10 @@ func Test_CreateTokenString(t *testing.T) {
 }
 
 func Test_ParseTokenString(t *testing.T) {
-	manager := access.NewManager(config.TokenParams{Secret: "secret", TTL: time.Hour})
+	manager := access.NewManager(jwt.NewHMACKeySet("secret"), time.Hour)
 
 	t.Run("OK", func(t *testing.T) {
-		data := access.NewData(1234, domain.UserRoleADMIN)
+		data := access.NewData(1234, 1, domain.UserRoleADMIN)
 
 		token, err := manager.CreateTokenString(data)
 		assert.NoError(t, err)
@@ -38,12 +37,10 @@ func Test_ParseTokenString(t *testing.T) {
 	})
 
 	t.Run("invalid signKey", func(t *testing.T) {
-		token, err := manager.CreateTokenString(access.NewData(1234, domain.UserRoleADMIN))
+		token, err := manager.CreateTokenString(access.NewData(1234, 1, domain.UserRoleADMIN))
 		assert.NoError(t, err)
 
-		managerInvalidKey := access.NewManager(
-			config.TokenParams{Secret: "invalidKey", TTL: time.Hour},
-		)
+		managerInvalidKey := access.NewManager(jwt.NewHMACKeySet("invalidKey"), time.Hour)
 
 		parsedData, err := managerInvalidKey.ParseTokenString(token)
 		assert.Error(t, err)
@@ -68,7 +65,7 @@ func Test_ParseTokenString(t *testing.T) {
 		currTime := time.Now()
 
 		claims := access.Claims{
-			Data: access.NewData(1234, domain.UserRoleADMIN),
+			Data: access.NewData(1234, 1, domain.UserRoleADMIN),
 			RegisteredClaims: jwtgo.RegisteredClaims{
 				ExpiresAt: jwtgo.NewNumericDate(currTime.Add(-time.Hour)),
 				IssuedAt:  jwtgo.NewNumericDate(currTime),
@@ -87,7 +84,7 @@ func Test_ParseTokenString(t *testing.T) {
 }
 
 func Test_NewManager(t *testing.T) {
-	manager := access.NewManager(config.TokenParams{Secret: "secret", TTL: time.Hour})
+	manager := access.NewManager(jwt.NewHMACKeySet("secret"), time.Hour)
 
 	assert.Equal(t, manager.TTL(), time.Hour)
 	assert.NotNil(t, manager.CreateTokenString)
@@ -0,0 +1,218 @@
+package jwt
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"slices"
+	"strings"
+
+	jwtgo "github.com/golang-jwt/jwt/v5"
+	"github.com/hexley21/soccer-manager/pkg/config"
+)
+
+var (
+	ErrUnknownKey        = errors.New("unknown key id")
+	ErrUnsupportedKey    = errors.New("unsupported key, use RSA or Ed25519")
+	ErrSigningKeyMissing = errors.New("signing key is not configured")
+	ErrSigningKeyPublic  = errors.New("signing key has no private part")
+)
+
+// Key verifies tokens of its kid, only keys with a private part can sign
+type Key struct {
+	ID     string
+	Method jwtgo.SigningMethod
+
+	sign   any
+	verify any
+}
+
+// KeySet signs with a single key and verifies with all of them, the kid header picks the key.
+// Rotate by adding the new key, switching the signing key to it once every instance knows it,
+// and dropping the old one after tokens signed with it expired
+type KeySet struct {
+	signing *Key
+	keys    map[string]*Key
+}
+
+// NewHMACKeySet signs and verifies with HS256, tokens carry no kid. Meant for local development
+func NewHMACKeySet(secret string) *KeySet {
+	key := &Key{Method: jwtgo.SigningMethodHS256, sign: []byte(secret), verify: []byte(secret)}
+	return &KeySet{signing: key, keys: map[string]*Key{"": key}}
+}
+
+// LoadKeySet reads the PEM files of cfg.Keys and signs with cfg.SigningKey.
+// Without keys it falls back to HS256 with cfg.Secret, with keys the secret only verifies
+// tokens without a kid, so a switch from HS256 doesn't log everyone out
+func LoadKeySet(cfg config.TokenParams) (*KeySet, error) {
+	if len(cfg.Keys) == 0 {
+		return NewHMACKeySet(cfg.Secret), nil
+	}
+
+	set := &KeySet{keys: make(map[string]*Key, len(cfg.Keys)+1)}
+	if cfg.Secret != "" {
+		set.keys[""] = &Key{Method: jwtgo.SigningMethodHS256, verify: []byte(cfg.Secret)}
+	}
+
+	for _, k := range cfg.Keys {
+		if k.ID == "" {
+			return nil, fmt.Errorf("key %s: id is required", k.Path)
+		}
+		if _, ok := set.keys[k.ID]; ok {
+			return nil, fmt.Errorf("key %s: duplicate id", k.ID)
+		}
+
+		data, err := os.ReadFile(k.Path)
+		if err != nil {
+			return nil, fmt.Errorf("key %s: %w", k.ID, err)
+		}
+
+		key, err := ParseKey(k.ID, data)
+		if err != nil {
+			return nil, fmt.Errorf("key %s: %w", k.ID, err)
+		}
+		set.keys[k.ID] = key
+	}
+
+	signing, ok := set.keys[cfg.SigningKey]
+	if !ok || cfg.SigningKey == "" {
+		return nil, fmt.Errorf("%w: %q", ErrSigningKeyMissing, cfg.SigningKey)
+	}
+	if signing.sign == nil {
+		return nil, fmt.Errorf("%w: %s", ErrSigningKeyPublic, cfg.SigningKey)
+	}
+	set.signing = signing
+
+	return set, nil
+}
+
+// ParseKey reads a PEM encoded RSA or Ed25519 key. A private key (PKCS#1 or PKCS#8) can sign,
+// a public one (PKIX) only verifies
+func ParseKey(id string, data []byte) (*Key, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+
+	var parsed any
+	var err error
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		parsed, err = x509.ParsePKIXPublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("%w: PEM block %s", ErrUnsupportedKey, block.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	key := &Key{ID: id}
+	switch k := parsed.(type) {
+	case *rsa.PrivateKey:
+		key.Method, key.sign, key.verify = jwtgo.SigningMethodRS256, k, &k.PublicKey
+	case *rsa.PublicKey:
+		key.Method, key.verify = jwtgo.SigningMethodRS256, k
+	case ed25519.PrivateKey:
+		key.Method, key.sign, key.verify = jwtgo.SigningMethodEdDSA, k, k.Public()
+	case ed25519.PublicKey:
+		key.Method, key.verify = jwtgo.SigningMethodEdDSA, k
+	default:
+		return nil, fmt.Errorf("%w: %T", ErrUnsupportedKey, parsed)
+	}
+
+	return key, nil
+}
+
+// Sign signs the claims with the signing key and sets its kid
+func (s *KeySet) Sign(claims jwtgo.Claims) (string, error) {
+	token := jwtgo.NewWithClaims(s.signing.Method, claims)
+	if s.signing.ID != "" {
+		token.Header["kid"] = s.signing.ID
+	}
+
+	return token.SignedString(s.signing.sign)
+}
+
+// Keyfunc finds the verification key of the token, a token must use the algorithm of its key
+func (s *KeySet) Keyfunc(token *jwtgo.Token) (any, error) {
+	kid, _ := token.Header["kid"].(string)
+	key, ok := s.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownKey, kid)
+	}
+	if token.Method.Alg() != key.Method.Alg() {
+		return nil, fmt.Errorf("%w: %s key %q used with %s", ErrInvalidToken, key.Method.Alg(), kid, token.Method.Alg())
+	}
+
+	return key.verify, nil
+}
+
+// ValidMethods lists the algorithms of every key, for jwtgo.WithValidMethods
+func (s *KeySet) ValidMethods() []string {
+	var methods []string
+	seen := make(map[string]bool)
+	for _, key := range s.keys {
+		if alg := key.Method.Alg(); !seen[alg] {
+			seen[alg] = true
+			methods = append(methods, alg)
+		}
+	}
+
+	return methods
+}
+
+// JWK is a public key in the JSON Web Key format (RFC 7517)
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+
+	// RSA
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+
+	// Ed25519
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+} // @name JWK
+
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+} // @name JWKS
+
+// JWKS publishes the public keys, HS256 secrets are never part of it
+func (s *KeySet) JWKS() JWKS {
+	res := JWKS{Keys: []JWK{}}
+	for _, key := range s.keys {
+		jwk := JWK{Kid: key.ID, Use: "sig", Alg: key.Method.Alg()}
+
+		switch k := key.verify.(type) {
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(k.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(k.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.Kty = "OKP"
+			jwk.Crv = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(k)
+		default:
+			continue
+		}
+
+		res.Keys = append(res.Keys, jwk)
+	}
+	slices.SortFunc(res.Keys, func(a, b JWK) int { return strings.Compare(a.Kid, b.Kid) })
+
+	return res
+}
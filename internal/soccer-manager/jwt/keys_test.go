@@ -0,0 +1,253 @@
+package jwt_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	jwtgo "github.com/golang-jwt/jwt/v5"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/jwt"
+	"github.com/hexley21/soccer-manager/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type keyFiles struct {
+	dir        string
+	rsaKey     *rsa.PrivateKey
+	edKey      ed25519.PrivateKey
+	rsaPrivate string
+	rsaPublic  string
+	edPrivate  string
+}
+
+func writeKeys(t *testing.T) keyFiles {
+	t.Helper()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	rsaPublic, err := x509.MarshalPKIXPublicKey(&rsaKey.PublicKey)
+	require.NoError(t, err)
+	edPrivate, err := x509.MarshalPKCS8PrivateKey(edKey)
+	require.NoError(t, err)
+
+	f := keyFiles{dir: t.TempDir(), rsaKey: rsaKey, edKey: edKey}
+	f.rsaPrivate = f.write(t, "rsa.pem", "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(rsaKey))
+	f.rsaPublic = f.write(t, "rsa.pub.pem", "PUBLIC KEY", rsaPublic)
+	f.edPrivate = f.write(t, "ed25519.pem", "PRIVATE KEY", edPrivate)
+
+	return f
+}
+
+func (f keyFiles) write(t *testing.T, name string, blockType string, der []byte) string {
+	path := filepath.Join(f.dir, name)
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0o600))
+	return path
+}
+
+func claims() jwtgo.RegisteredClaims {
+	return jwtgo.RegisteredClaims{
+		Subject:   "1234",
+		ExpiresAt: jwtgo.NewNumericDate(time.Now().Add(time.Hour)),
+	}
+}
+
+func parse(set *jwt.KeySet, token string) (*jwtgo.Token, error) {
+	return jwtgo.ParseWithClaims(token, &jwtgo.RegisteredClaims{}, set.Keyfunc, jwtgo.WithValidMethods(set.ValidMethods()))
+}
+
+func Test_LoadKeySet(t *testing.T) {
+	f := writeKeys(t)
+
+	t.Run("HS256 without keys", func(t *testing.T) {
+		set, err := jwt.LoadKeySet(config.TokenParams{Secret: "secret"})
+		require.NoError(t, err)
+
+		token, err := set.Sign(claims())
+		require.NoError(t, err)
+
+		parsed, err := parse(set, token)
+		require.NoError(t, err)
+		assert.Equal(t, "HS256", parsed.Method.Alg())
+		assert.NotContains(t, parsed.Header, "kid")
+		assert.Empty(t, set.JWKS().Keys)
+	})
+
+	for name, tc := range map[string]struct {
+		path string
+		alg  string
+	}{
+		"RS256": {path: f.rsaPrivate, alg: "RS256"},
+		"EdDSA": {path: f.edPrivate, alg: "EdDSA"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			set, err := jwt.LoadKeySet(config.TokenParams{
+				SigningKey: "k1",
+				Keys:       []config.KeyFile{{ID: "k1", Path: tc.path}},
+			})
+			require.NoError(t, err)
+
+			token, err := set.Sign(claims())
+			require.NoError(t, err)
+
+			parsed, err := parse(set, token)
+			require.NoError(t, err)
+			assert.Equal(t, tc.alg, parsed.Method.Alg())
+			assert.Equal(t, "k1", parsed.Header["kid"])
+		})
+	}
+
+	t.Run("rotation", func(t *testing.T) {
+		before, err := jwt.LoadKeySet(config.TokenParams{
+			SigningKey: "old",
+			Keys:       []config.KeyFile{{ID: "old", Path: f.rsaPrivate}},
+		})
+		require.NoError(t, err)
+		after, err := jwt.LoadKeySet(config.TokenParams{
+			SigningKey: "new",
+			Keys: []config.KeyFile{
+				{ID: "old", Path: f.rsaPublic},
+				{ID: "new", Path: f.edPrivate},
+			},
+		})
+		require.NoError(t, err)
+
+		oldToken, err := before.Sign(claims())
+		require.NoError(t, err)
+		newToken, err := after.Sign(claims())
+		require.NoError(t, err)
+
+		_, err = parse(after, oldToken)
+		assert.NoError(t, err)
+		_, err = parse(before, newToken)
+		assert.Error(t, err)
+	})
+
+	t.Run("HS256 tokens verify after switching to keys", func(t *testing.T) {
+		hmac := jwt.NewHMACKeySet("secret")
+		set, err := jwt.LoadKeySet(config.TokenParams{
+			Secret:     "secret",
+			SigningKey: "k1",
+			Keys:       []config.KeyFile{{ID: "k1", Path: f.rsaPrivate}},
+		})
+		require.NoError(t, err)
+
+		token, err := hmac.Sign(claims())
+		require.NoError(t, err)
+
+		_, err = parse(set, token)
+		assert.NoError(t, err)
+	})
+
+	t.Run("rejects algorithm of another key", func(t *testing.T) {
+		set, err := jwt.LoadKeySet(config.TokenParams{
+			SigningKey: "k1",
+			Keys:       []config.KeyFile{{ID: "k1", Path: f.rsaPrivate}},
+		})
+		require.NoError(t, err)
+
+		// HS256 signed with the public key, the classic algorithm confusion
+		publicPEM, err := os.ReadFile(f.rsaPublic)
+		require.NoError(t, err)
+		forged := jwtgo.NewWithClaims(jwtgo.SigningMethodHS256, claims())
+		forged.Header["kid"] = "k1"
+		token, err := forged.SignedString(publicPEM)
+		require.NoError(t, err)
+
+		_, err = parse(set, token)
+		assert.Error(t, err)
+	})
+
+	t.Run("unknown key id", func(t *testing.T) {
+		set, err := jwt.LoadKeySet(config.TokenParams{
+			SigningKey: "k1",
+			Keys:       []config.KeyFile{{ID: "k1", Path: f.rsaPrivate}},
+		})
+		require.NoError(t, err)
+
+		unknown := jwtgo.NewWithClaims(jwtgo.SigningMethodRS256, claims())
+		unknown.Header["kid"] = "k2"
+		token, err := unknown.SignedString(f.rsaKey)
+		require.NoError(t, err)
+
+		_, err = parse(set, token)
+		assert.ErrorIs(t, err, jwt.ErrUnknownKey)
+	})
+
+	t.Run("signing key without private part", func(t *testing.T) {
+		_, err := jwt.LoadKeySet(config.TokenParams{
+			SigningKey: "k1",
+			Keys:       []config.KeyFile{{ID: "k1", Path: f.rsaPublic}},
+		})
+		assert.ErrorIs(t, err, jwt.ErrSigningKeyPublic)
+	})
+
+	t.Run("unknown signing key", func(t *testing.T) {
+		_, err := jwt.LoadKeySet(config.TokenParams{
+			SigningKey: "k2",
+			Keys:       []config.KeyFile{{ID: "k1", Path: f.rsaPrivate}},
+		})
+		assert.ErrorIs(t, err, jwt.ErrSigningKeyMissing)
+	})
+
+	t.Run("duplicate key id", func(t *testing.T) {
+		_, err := jwt.LoadKeySet(config.TokenParams{
+			SigningKey: "k1",
+			Keys: []config.KeyFile{
+				{ID: "k1", Path: f.rsaPrivate},
+				{ID: "k1", Path: f.edPrivate},
+			},
+		})
+		assert.Error(t, err)
+	})
+}
+
+func Test_KeySet_JWKS(t *testing.T) {
+	f := writeKeys(t)
+
+	set, err := jwt.LoadKeySet(config.TokenParams{
+		Secret:     "secret",
+		SigningKey: "ed",
+		Keys: []config.KeyFile{
+			{ID: "rsa", Path: f.rsaPublic},
+			{ID: "ed", Path: f.edPrivate},
+		},
+	})
+	require.NoError(t, err)
+
+	jwks := set.JWKS()
+	require.Len(t, jwks.Keys, 2)
+
+	ed, rsaKey := jwks.Keys[0], jwks.Keys[1]
+
+	assert.Equal(t, jwt.JWK{
+		Kty: "OKP",
+		Kid: "ed",
+		Use: "sig",
+		Alg: "EdDSA",
+		Crv: "Ed25519",
+		X:   base64.RawURLEncoding.EncodeToString(f.edKey.Public().(ed25519.PublicKey)),
+	}, ed)
+
+	assert.Equal(t, "RSA", rsaKey.Kty)
+	assert.Equal(t, "rsa", rsaKey.Kid)
+	assert.Equal(t, "RS256", rsaKey.Alg)
+
+	n, err := base64.RawURLEncoding.DecodeString(rsaKey.N)
+	require.NoError(t, err)
+	e, err := base64.RawURLEncoding.DecodeString(rsaKey.E)
+	require.NoError(t, err)
+	assert.Equal(t, f.rsaKey.N, new(big.Int).SetBytes(n))
+	assert.Equal(t, f.rsaKey.E, int(new(big.Int).SetBytes(e).Int64()))
+}
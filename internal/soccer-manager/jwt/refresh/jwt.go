@@ -2,21 +2,24 @@ package refresh
 
 import (
 	"fmt"
+	"strconv"
 	"time"
 
 	jwtgo "github.com/golang-jwt/jwt/v5"
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/jwt"
-	"github.com/hexley21/soccer-manager/pkg/config"
 )
 
 type Manager = jwt.ManagerWithTTL[Data]
 
+// Data identifies the refresh token within its session, TokenID travels as the jti claim
 type Data struct {
-	UserID int64 `json:"uid"`
+	UserID    int64 `json:"uid"`
+	SessionID int64 `json:"sid"`
+	TokenID   int64 `json:"-"`
 }
 
-func NewData(UserID int64) Data {
-	return Data{UserID: UserID}
+func NewData(UserID int64, SessionID int64, TokenID int64) Data {
+	return Data{UserID: UserID, SessionID: SessionID, TokenID: TokenID}
 }
 
 type Claims struct {
@@ -25,32 +28,30 @@ type Claims struct {
 }
 
 type tokenManager struct {
-	secret string
-	ttl    time.Duration
+	keys *jwt.KeySet
+	ttl  time.Duration
 }
 
-func NewManager(cfg config.TokenParams) *tokenManager {
+func NewManager(keys *jwt.KeySet, ttl time.Duration) *tokenManager {
 	return &tokenManager{
-		secret: cfg.Secret,
-		ttl:    cfg.TTL,
+		keys: keys,
+		ttl:  ttl,
 	}
 }
 
 func (m *tokenManager) CreateTokenString(data Data) (string, error) {
-	keyByte := []byte(m.secret)
-
 	currTime := time.Now()
 
 	claims := Claims{
 		Data: data,
 		RegisteredClaims: jwtgo.RegisteredClaims{
+			ID:        strconv.FormatInt(data.TokenID, 10),
 			ExpiresAt: jwtgo.NewNumericDate(currTime.Add(m.ttl)),
 			NotBefore: jwtgo.NewNumericDate(currTime),
 		},
 	}
 
-	token := jwtgo.NewWithClaims(jwtgo.SigningMethodHS256, claims)
-	ss, err := token.SignedString(keyByte)
+	ss, err := m.keys.Sign(claims)
 	if err != nil {
 		return "", fmt.Errorf("%w: %w", jwt.ErrErrorSigningToken, err)
 	}
@@ -62,9 +63,8 @@ func (m *tokenManager) ParseTokenString(tokenString string) (Data, error) {
 	token, err := jwtgo.ParseWithClaims(
 		tokenString,
 		&Claims{},
-		func(token *jwtgo.Token) (interface{}, error) {
-			return []byte(m.secret), nil
-		},
+		m.keys.Keyfunc,
+		jwtgo.WithValidMethods(m.keys.ValidMethods()),
 	)
 	if err != nil {
 		return Data{}, fmt.Errorf("%w: %w", jwt.ErrErrorParsingToken, err)
@@ -75,6 +75,12 @@ func (m *tokenManager) ParseTokenString(tokenString string) (Data, error) {
 		return Data{}, jwt.ErrInvalidToken
 	}
 
+	tokenID, err := strconv.ParseInt(claims.ID, 10, 64)
+	if err != nil {
+		return Data{}, fmt.Errorf("%w: jti: %w", jwt.ErrInvalidToken, err)
+	}
+	claims.TokenID = tokenID
+
 	return claims.Data, nil
 }
 
@@ -7,15 +7,14 @@ import (
 	jwtgo "github.com/golang-jwt/jwt/v5"
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/jwt"
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/jwt/refresh"
-	"github.com/hexley21/soccer-manager/pkg/config"
 	"github.com/stretchr/testify/assert"
 )
 
 func Test_CreateTokenString(t *testing.T) {
-	manager := refresh.NewManager(config.TokenParams{Secret: "secret", TTL: time.Hour})
+	manager := refresh.NewManager(jwt.NewHMACKeySet("secret"), time.Hour)
 
 	t.Run("OK", func(t *testing.T) {
-		token, err := manager.CreateTokenString(refresh.NewData(123))
+		token, err := manager.CreateTokenString(refresh.NewData(123, 1, 2))
 
 		assert.NoError(t, err)
 		assert.NotEmpty(t, token)
@@ -23,10 +22,10 @@ func Test_CreateTokenString(t *testing.T) {
 }
 
 func Test_ParseTokenString(t *testing.T) {
-	manager := refresh.NewManager(config.TokenParams{Secret: "secret", TTL: time.Hour})
+	manager := refresh.NewManager(jwt.NewHMACKeySet("secret"), time.Hour)
 
 	t.Run("OK", func(t *testing.T) {
-		data := refresh.NewData(123)
+		data := refresh.NewData(123, 1, 2)
 
 		token, err := manager.CreateTokenString(data)
 		assert.NoError(t, err)
@@ -37,12 +36,10 @@ func Test_ParseTokenString(t *testing.T) {
 	})
 
 	t.Run("invalid signKey", func(t *testing.T) {
-		token, err := manager.CreateTokenString(refresh.NewData(123))
+		token, err := manager.CreateTokenString(refresh.NewData(123, 1, 2))
 		assert.NoError(t, err)
 
-		managerInvalidKey := refresh.NewManager(
-			config.TokenParams{Secret: "invalidSecret", TTL: time.Hour},
-		)
+		managerInvalidKey := refresh.NewManager(jwt.NewHMACKeySet("invalidSecret"), time.Hour)
 
 		parsedData, err := managerInvalidKey.ParseTokenString(token)
 		assert.Error(t, err)
@@ -61,13 +58,29 @@ func Test_ParseTokenString(t *testing.T) {
 		assert.ErrorIs(t, err, jwt.ErrErrorParsingToken)
 	})
 
+	t.Run("missing token id", func(t *testing.T) {
+		claims := refresh.Claims{
+			Data: refresh.NewData(123, 1, 2),
+			RegisteredClaims: jwtgo.RegisteredClaims{
+				ExpiresAt: jwtgo.NewNumericDate(time.Now().Add(time.Hour)),
+			},
+		}
+
+		ss, err := jwtgo.NewWithClaims(jwtgo.SigningMethodHS256, claims).SignedString([]byte("secret"))
+		assert.NoError(t, err)
+
+		parsedData, err := manager.ParseTokenString(ss)
+		assert.Empty(t, parsedData)
+		assert.ErrorIs(t, err, jwt.ErrInvalidToken)
+	})
+
 	t.Run("expired token", func(t *testing.T) {
 		keyByte := []byte("secret")
 
 		currTime := time.Now()
 
 		claims := refresh.Claims{
-			Data: refresh.NewData(123),
+			Data: refresh.NewData(123, 1, 2),
 			RegisteredClaims: jwtgo.RegisteredClaims{
 				ExpiresAt: jwtgo.NewNumericDate(currTime.Add(-time.Hour)),
 				IssuedAt:  jwtgo.NewNumericDate(currTime),
@@ -86,7 +99,7 @@ func Test_ParseTokenString(t *testing.T) {
 }
 
 func Test_NewManager(t *testing.T) {
-	manager := refresh.NewManager(config.TokenParams{Secret: "secret", TTL: time.Hour})
+	manager := refresh.NewManager(jwt.NewHMACKeySet("secret"), time.Hour)
 
 	assert.Equal(t, manager.TTL(), time.Hour)
 	assert.NotNil(t, manager.CreateTokenString)
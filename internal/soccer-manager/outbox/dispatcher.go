@@ -0,0 +1,166 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/service"
+	"github.com/hexley21/soccer-manager/pkg/config"
+	"github.com/labstack/echo/v4"
+)
+
+// Handler receives the raw payload of an event, a failing handler gets the event again later
+type Handler func(ctx context.Context, payload json.RawMessage) error
+
+type subscriber struct {
+	name   string
+	handle Handler
+}
+
+// permanentError marks failures that no retry can fix
+type permanentError struct {
+	err error
+}
+
+func (e permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e permanentError) Unwrap() error {
+	return e.err
+}
+
+// Permanent wraps an error so the event is dead-lettered right away instead of retried
+func Permanent(err error) error {
+	return permanentError{err: err}
+}
+
+// Dispatcher delivers outbox events to subscribed handlers at least once, handlers must tolerate duplicates.
+// Every handler is tracked on its own, a retry only reaches the handlers that failed
+type Dispatcher struct {
+	outboxService service.OutboxService
+	cfg           config.Outbox
+	subscribers   map[string][]subscriber
+
+	logger echo.Logger
+}
+
+func NewDispatcher(outboxService service.OutboxService, cfg config.Outbox, logger echo.Logger) *Dispatcher {
+	return &Dispatcher{
+		outboxService: outboxService,
+		cfg:           cfg,
+		subscribers:   make(map[string][]subscriber),
+		logger:        logger,
+	}
+}
+
+// Subscribe registers a handler of the topic, subscriptions must be done before dispatching starts.
+// The name records the delivery, it has to be unique within the topic and stable across releases
+func (d *Dispatcher) Subscribe(topic string, name string, handle Handler) {
+	d.subscribers[topic] = append(d.subscribers[topic], subscriber{name: name, handle: handle})
+}
+
+// On subscribes a handler of decoded payloads, an undecodable payload is dead-lettered
+func On[T any](
+	d *Dispatcher,
+	topic string,
+	name string,
+	decode func(json.RawMessage) (T, error),
+	handle func(ctx context.Context, payload T) error,
+) {
+	d.Subscribe(topic, name, func(ctx context.Context, payload json.RawMessage) error {
+		v, err := decode(payload)
+		if err != nil {
+			return Permanent(fmt.Errorf("decode payload: %w", err))
+		}
+
+		return handle(ctx, v)
+	})
+}
+
+// Dispatch delivers a batch of due events and returns how many of them were completed.
+// Events left over when ctx is done are claimed again once their lease runs out
+func (d *Dispatcher) Dispatch(ctx context.Context) (int, error) {
+	events, err := d.outboxService.ClaimEvents(ctx, d.cfg.BatchSize, d.cfg.Lease)
+	if err != nil {
+		return 0, err
+	}
+
+	var completed int
+	var errs []error
+	for _, e := range events {
+		if ctx.Err() != nil {
+			break
+		}
+
+		done, err := d.deliver(ctx, e)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("outbox event %d: %w", e.ID, err))
+			continue
+		}
+		if done {
+			completed++
+		}
+	}
+
+	return completed, errors.Join(errs...)
+}
+
+func (d *Dispatcher) deliver(ctx context.Context, e domain.OutboxEvent) (bool, error) {
+	deliveredTo := slices.Clone(e.DeliveredTo)
+
+	var failures []error
+	var permanent bool
+	for _, s := range d.subscribers[e.Topic] {
+		if slices.Contains(deliveredTo, s.name) {
+			continue
+		}
+
+		if err := d.call(ctx, s, e.Payload); err != nil {
+			failures = append(failures, fmt.Errorf("%s: %w", s.name, err))
+			permanent = permanent || errors.As(err, &permanentError{})
+			continue
+		}
+
+		deliveredTo = append(deliveredTo, s.name)
+	}
+
+	if len(failures) == 0 {
+		return true, d.outboxService.CompleteEvent(ctx, e.ID)
+	}
+
+	cause := errors.Join(failures...)
+	if permanent || e.Attempts >= d.cfg.MaxAttempts {
+		d.logger.Errorf("outbox event %d (%s) is dead after %d attempts: %v", e.ID, e.Topic, e.Attempts, cause)
+		return false, d.outboxService.BuryEvent(ctx, e.ID, deliveredTo, cause)
+	}
+
+	d.logger.Warnf("outbox event %d (%s) failed attempt %d: %v", e.ID, e.Topic, e.Attempts, cause)
+	return false, d.outboxService.RetryEvent(ctx, e.ID, deliveredTo, cause, time.Now().Add(d.backoff(e.Attempts)))
+}
+
+// call runs a single handler, a panic counts as a failure
+func (d *Dispatcher) call(ctx context.Context, s subscriber, payload json.RawMessage) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic recovered: %v", r)
+		}
+	}()
+
+	return s.handle(ctx, payload)
+}
+
+// backoff doubles the delay with every attempt, claiming counts as an attempt so the first one is 1
+func (d *Dispatcher) backoff(attempts int32) time.Duration {
+	delay := d.cfg.RetryBase
+	for i := int32(1); i < attempts && delay < d.cfg.RetryMax; i++ {
+		delay *= 2
+	}
+
+	return min(delay, d.cfg.RetryMax)
+}
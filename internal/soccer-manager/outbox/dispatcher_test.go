@@ -0,0 +1,172 @@
+package outbox_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/outbox"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/service/mock"
+	"github.com/hexley21/soccer-manager/pkg/config"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const topic = "test.Happened"
+
+var cfg = config.Outbox{
+	Lease:       time.Minute,
+	BatchSize:   10,
+	MaxAttempts: 10,
+	RetryBase:   time.Second,
+	RetryMax:    4 * time.Second,
+}
+
+func setup(t *testing.T) (*mock.MockOutboxService, *outbox.Dispatcher) {
+	ctrl := gomock.NewController(t)
+	outboxService := mock.NewMockOutboxService(ctrl)
+
+	return outboxService, outbox.NewDispatcher(outboxService, cfg, echo.New().Logger)
+}
+
+func event(attempts int32, deliveredTo ...string) domain.OutboxEvent {
+	return domain.OutboxEvent{
+		ID:          1,
+		Topic:       topic,
+		Payload:     json.RawMessage(`{"id":7}`),
+		Attempts:    attempts,
+		DeliveredTo: deliveredTo,
+	}
+}
+
+func ok(ctx context.Context, payload json.RawMessage) error {
+	return nil
+}
+
+func failing(ctx context.Context, payload json.RawMessage) error {
+	return errors.New("unavailable")
+}
+
+func Test_Dispatcher_Dispatch(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("completes delivered event", func(t *testing.T) {
+		outboxService, d := setup(t)
+		d.Subscribe(topic, "a", ok)
+		d.Subscribe(topic, "b", ok)
+
+		outboxService.EXPECT().ClaimEvents(ctx, cfg.BatchSize, cfg.Lease).Return([]domain.OutboxEvent{event(1)}, nil)
+		outboxService.EXPECT().CompleteEvent(ctx, int64(1)).Return(nil)
+
+		completed, err := d.Dispatch(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, completed)
+	})
+
+	t.Run("retries only failed handlers", func(t *testing.T) {
+		outboxService, d := setup(t)
+		d.Subscribe(topic, "a", func(ctx context.Context, payload json.RawMessage) error {
+			t.Fatal("delivered handler called again")
+			return nil
+		})
+		d.Subscribe(topic, "b", ok)
+		d.Subscribe(topic, "c", failing)
+
+		before := time.Now()
+		outboxService.EXPECT().ClaimEvents(ctx, cfg.BatchSize, cfg.Lease).Return([]domain.OutboxEvent{event(2, "a")}, nil)
+		outboxService.EXPECT().
+			RetryEvent(ctx, int64(1), []string{"a", "b"}, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, id int64, deliveredTo []string, cause error, at time.Time) error {
+				assert.ErrorContains(t, cause, "c: unavailable")
+				assert.WithinDuration(t, before.Add(2*cfg.RetryBase), at, time.Second)
+				return nil
+			})
+
+		completed, err := d.Dispatch(ctx)
+		assert.NoError(t, err)
+		assert.Zero(t, completed)
+	})
+
+	t.Run("caps backoff", func(t *testing.T) {
+		outboxService, d := setup(t)
+		d.Subscribe(topic, "a", failing)
+
+		before := time.Now()
+		outboxService.EXPECT().ClaimEvents(ctx, cfg.BatchSize, cfg.Lease).Return([]domain.OutboxEvent{event(5)}, nil)
+		outboxService.EXPECT().
+			RetryEvent(ctx, int64(1), []string(nil), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, id int64, deliveredTo []string, cause error, at time.Time) error {
+				assert.WithinDuration(t, before.Add(cfg.RetryMax), at, time.Second)
+				return nil
+			})
+
+		_, err := d.Dispatch(ctx)
+		assert.NoError(t, err)
+	})
+
+	t.Run("buries exhausted event", func(t *testing.T) {
+		outboxService, d := setup(t)
+		d.Subscribe(topic, "a", ok)
+		d.Subscribe(topic, "b", failing)
+
+		outboxService.EXPECT().
+			ClaimEvents(ctx, cfg.BatchSize, cfg.Lease).
+			Return([]domain.OutboxEvent{event(cfg.MaxAttempts)}, nil)
+		outboxService.EXPECT().BuryEvent(ctx, int64(1), []string{"a"}, gomock.Any()).Return(nil)
+
+		completed, err := d.Dispatch(ctx)
+		assert.NoError(t, err)
+		assert.Zero(t, completed)
+	})
+
+	t.Run("buries undecodable event", func(t *testing.T) {
+		outboxService, d := setup(t)
+		outbox.On(d, topic, "a", func(payload json.RawMessage) (string, error) {
+			var v string
+			return v, json.Unmarshal(payload, &v)
+		}, func(ctx context.Context, v string) error {
+			t.Fatal("handler called with undecodable payload")
+			return nil
+		})
+
+		outboxService.EXPECT().ClaimEvents(ctx, cfg.BatchSize, cfg.Lease).Return([]domain.OutboxEvent{event(1)}, nil)
+		outboxService.EXPECT().BuryEvent(ctx, int64(1), []string(nil), gomock.Any()).Return(nil)
+
+		_, err := d.Dispatch(ctx)
+		assert.NoError(t, err)
+	})
+
+	t.Run("recovers panicking handler", func(t *testing.T) {
+		outboxService, d := setup(t)
+		d.Subscribe(topic, "a", func(ctx context.Context, payload json.RawMessage) error {
+			panic("boom")
+		})
+
+		outboxService.EXPECT().ClaimEvents(ctx, cfg.BatchSize, cfg.Lease).Return([]domain.OutboxEvent{event(1)}, nil)
+		outboxService.EXPECT().
+			RetryEvent(ctx, int64(1), []string(nil), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, id int64, deliveredTo []string, cause error, at time.Time) error {
+				assert.ErrorContains(t, cause, "panic recovered: boom")
+				return nil
+			})
+
+		_, err := d.Dispatch(ctx)
+		assert.NoError(t, err)
+	})
+
+	t.Run("reports failed bookkeeping", func(t *testing.T) {
+		outboxService, d := setup(t)
+		d.Subscribe(topic, "a", ok)
+
+		outboxService.EXPECT().ClaimEvents(ctx, cfg.BatchSize, cfg.Lease).Return([]domain.OutboxEvent{event(1)}, nil)
+		outboxService.EXPECT().CompleteEvent(ctx, int64(1)).Return(errors.New("db down"))
+
+		completed, err := d.Dispatch(ctx)
+		assert.ErrorContains(t, err, "db down")
+		assert.Zero(t, completed)
+	})
+}
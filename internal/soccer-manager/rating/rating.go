@@ -0,0 +1,150 @@
+package rating
+
+import "math/rand"
+
+const (
+	MinAttribute = 1
+	MaxAttribute = 99
+
+	// AverageOverall is the overall rating which is valued exactly at the base price
+	AverageOverall = 60
+
+	minPriceRise = 0.1
+	maxPriceRise = 1.0
+)
+
+type Attributes struct {
+	Pace        int32 `json:"pace"`
+	Shooting    int32 `json:"shooting"`
+	Passing     int32 `json:"passing"`
+	Defending   int32 `json:"defending"`
+	Goalkeeping int32 `json:"goalkeeping"`
+	Stamina     int32 `json:"stamina"`
+}
+
+// weights are percentages of each attribute in the overall rating, they sum up to 100.
+// Keys are player position codes
+var weights = map[string]Attributes{
+	"GLK": {Pace: 5, Passing: 10, Defending: 10, Goalkeeping: 70, Stamina: 5},
+	"DEF": {Pace: 20, Shooting: 5, Passing: 15, Defending: 45, Stamina: 15},
+	"MID": {Pace: 10, Shooting: 15, Passing: 40, Defending: 15, Stamina: 20},
+	"ATK": {Pace: 30, Shooting: 45, Passing: 15, Stamina: 10},
+}
+
+// evenWeights are used for unknown positions
+var evenWeights = Attributes{
+	Pace:      20,
+	Shooting:  20,
+	Passing:   20,
+	Defending: 20,
+	Stamina:   20,
+}
+
+type span struct {
+	min int32
+	max int32
+}
+
+type profile struct {
+	pace        span
+	shooting    span
+	passing     span
+	defending   span
+	goalkeeping span
+	stamina     span
+}
+
+// profiles are attribute ranges generated for each position
+var profiles = map[string]profile{
+	"GLK": {
+		pace:        span{30, 60},
+		shooting:    span{10, 35},
+		passing:     span{30, 65},
+		defending:   span{30, 60},
+		goalkeeping: span{55, 90},
+		stamina:     span{40, 70},
+	},
+	"DEF": {
+		pace:        span{45, 80},
+		shooting:    span{20, 55},
+		passing:     span{40, 70},
+		defending:   span{55, 90},
+		goalkeeping: span{5, 20},
+		stamina:     span{55, 85},
+	},
+	"MID": {
+		pace:        span{50, 80},
+		shooting:    span{40, 75},
+		passing:     span{55, 90},
+		defending:   span{40, 70},
+		goalkeeping: span{5, 20},
+		stamina:     span{60, 90},
+	},
+	"ATK": {
+		pace:        span{60, 90},
+		shooting:    span{55, 90},
+		passing:     span{45, 75},
+		defending:   span{15, 45},
+		goalkeeping: span{5, 20},
+		stamina:     span{50, 80},
+	},
+}
+
+var defaultProfile = profile{
+	pace:        span{30, 70},
+	shooting:    span{30, 70},
+	passing:     span{30, 70},
+	defending:   span{30, 70},
+	goalkeeping: span{5, 20},
+	stamina:     span{30, 70},
+}
+
+// Overall calculates player's overall rating as a position weighted average of attributes
+func Overall(position string, a Attributes) int32 {
+	w, ok := weights[position]
+	if !ok {
+		w = evenWeights
+	}
+
+	total := a.Pace*w.Pace +
+		a.Shooting*w.Shooting +
+		a.Passing*w.Passing +
+		a.Defending*w.Defending +
+		a.Goalkeeping*w.Goalkeeping +
+		a.Stamina*w.Stamina
+
+	return total / 100
+}
+
+// Generate rolls attributes according to position's profile
+func Generate(rnd *rand.Rand, position string) Attributes {
+	p, ok := profiles[position]
+	if !ok {
+		p = defaultProfile
+	}
+
+	return Attributes{
+		Pace:        p.pace.roll(rnd),
+		Shooting:    p.shooting.roll(rnd),
+		Passing:     p.passing.roll(rnd),
+		Defending:   p.defending.roll(rnd),
+		Goalkeeping: p.goalkeeping.roll(rnd),
+		Stamina:     p.stamina.roll(rnd),
+	}
+}
+
+// Price scales the base price by the overall rating relative to AverageOverall
+func Price(base int64, overall int32) int64 {
+	return base * int64(overall) / AverageOverall
+}
+
+// PriceRise returns a multiplier for player's price after a sale,
+// the rise is at least 10%, higher rated players may rise up to 100%
+func PriceRise(rnd *rand.Rand, overall int32) float64 {
+	ceiling := (maxPriceRise - minPriceRise) * float64(overall) / MaxAttribute
+	return 1 + minPriceRise + rnd.Float64()*ceiling
+}
+
+func (s span) roll(rnd *rand.Rand) int32 {
+	return s.min + rnd.Int31n(s.max-s.min+1)
+}
@@ -0,0 +1,64 @@
+package rating_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/rating"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Overall(t *testing.T) {
+	t.Run("position weights", func(t *testing.T) {
+		keeper := rating.Attributes{Pace: 40, Shooting: 20, Passing: 50, Defending: 50, Goalkeeping: 90, Stamina: 60}
+
+		assert.Greater(t, rating.Overall("GLK", keeper), rating.Overall("ATK", keeper))
+	})
+
+	t.Run("maxed out", func(t *testing.T) {
+		max := rating.Attributes{Pace: 99, Shooting: 99, Passing: 99, Defending: 99, Goalkeeping: 99, Stamina: 99}
+
+		for _, position := range []string{"GLK", "DEF", "MID", "ATK", "unknown"} {
+			assert.Equal(t, int32(rating.MaxAttribute), rating.Overall(position, max), position)
+		}
+	})
+}
+
+func Test_Generate(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+
+	for range 100 {
+		for _, position := range []string{"GLK", "DEF", "MID", "ATK", "unknown"} {
+			a := rating.Generate(rnd, position)
+			for _, v := range []int32{a.Pace, a.Shooting, a.Passing, a.Defending, a.Goalkeeping, a.Stamina} {
+				assert.GreaterOrEqual(t, v, int32(rating.MinAttribute))
+				assert.LessOrEqual(t, v, int32(rating.MaxAttribute))
+			}
+		}
+
+		keeper := rating.Generate(rnd, "GLK")
+		attacker := rating.Generate(rnd, "ATK")
+		assert.Greater(t, keeper.Goalkeeping, attacker.Goalkeeping)
+		assert.Greater(t, attacker.Shooting, keeper.Shooting)
+	}
+}
+
+func Test_Price(t *testing.T) {
+	assert.Equal(t, int64(1000), rating.Price(1000, rating.AverageOverall))
+	assert.Equal(t, int64(1500), rating.Price(1000, 90))
+	assert.Equal(t, int64(500), rating.Price(1000, 30))
+}
+
+func Test_PriceRise(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+
+	for range 100 {
+		low := rating.PriceRise(rnd, 10)
+		assert.GreaterOrEqual(t, low, 1.1)
+		assert.LessOrEqual(t, low, 1.1+0.9*10/99.0)
+
+		high := rating.PriceRise(rnd, rating.MaxAttribute)
+		assert.GreaterOrEqual(t, high, 1.1)
+		assert.LessOrEqual(t, high, 2.0)
+	}
+}
@@ -0,0 +1,318 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/tax"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/valuation"
+	"github.com/hexley21/soccer-manager/pkg/infra/postgres"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+//go:generate mockgen -destination=mock/mock_auction.go -package=mock github.com/hexley21/soccer-manager/internal/soccer-manager/repository AuctionRepository
+type AuctionRepository interface {
+	ListExpiredAuctionIDs(ctx context.Context, limit int32) ([]int64, error)
+	SettleAuction(ctx context.Context, transferID int64) (AuctionResult, error)
+
+	GetAuctionResultByID(ctx context.Context, id int64) (AuctionResult, error)
+	ListAuctionResults(ctx context.Context, arg ListAuctionResultsParams) ([]AuctionResult, error)
+}
+
+type pgAuctionRepository struct {
+	db            *pgxpool.Pool
+	snowflakeNode *snowflake.Node
+	tax           tax.Policy
+	valuator      valuation.PriceValuator
+}
+
+func NewAuctionRepository(
+	db *pgxpool.Pool,
+	snowflakeNode *snowflake.Node,
+	taxPolicy tax.Policy,
+	valuator valuation.PriceValuator,
+) *pgAuctionRepository {
+	return &pgAuctionRepository{
+		db:            db,
+		snowflakeNode: snowflakeNode,
+		tax:           taxPolicy,
+		valuator:      valuator,
+	}
+}
+
+const listExpiredAuctionIDs = `-- name: ListExpiredAuctionIDs :many
+SELECT id FROM transfers WHERE auction_ends_at <= now() ORDER BY auction_ends_at LIMIT $1
+`
+
+// ListExpiredAuctionIDs returns auctions which are over and waiting to be settled, oldest first
+func (r *pgAuctionRepository) ListExpiredAuctionIDs(ctx context.Context, limit int32) ([]int64, error) {
+	rows, err := r.db.Query(ctx, listExpiredAuctionIDs, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int64{}
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getWinningBidByTransferID = `-- name: GetWinningBidByTransferID :one
+SELECT id, transfer_id, player_id, seller_team_id, bidder_team_id, amount, counter_amount, status, created_at, updated_at FROM bids
+WHERE transfer_id = $1 AND status = 'PENDING' AND amount >= $2 ORDER BY amount DESC, created_at, id LIMIT 1 FOR UPDATE
+`
+
+// SettleAuction closes an expired auction.
+// The highest bid reaching the reserve price wins and the transfer is completed like BuyPlayer
+// with the winner's reserved funds, other bids are cancelled.
+// Without a valid bid the listing is removed and all bids are cancelled.
+// Either way the outcome is recorded
+//
+// If transfer not found - ErrNotFound
+// If transfer is not an auction or it's still running - ErrConflict
+func (r *pgAuctionRepository) SettleAuction(ctx context.Context, transferID int64) (AuctionResult, error) {
+	tx, err := r.db.BeginTx(
+		ctx,
+		pgx.TxOptions{IsoLevel: pgx.Serializable, AccessMode: pgx.ReadWrite},
+	)
+	if err != nil {
+		return AuctionResult{}, err
+	}
+
+	// 0. validation
+	transfer, err := selectTransferByIdWithQuerier(ctx, tx, transferID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return AuctionResult{}, postgres.Rollback(ctx, tx, ErrNotFound)
+		}
+
+		return AuctionResult{}, postgres.Rollback(ctx, tx, err)
+	}
+	if !transfer.AuctionEndsAt.Valid || transfer.AuctionEndsAt.Time.After(time.Now()) {
+		return AuctionResult{}, postgres.Rollback(ctx, tx, ErrConflict)
+	}
+
+	result := InsertAuctionResultParams{
+		ID:           r.snowflakeNode.Generate().Int64(),
+		TransferID:   transfer.ID,
+		PlayerID:     transfer.PlayerID,
+		SellerTeamID: transfer.SellerTeamID,
+		ReservePrice: transfer.ReservePrice.Int64,
+		Outcome:      "UNSOLD",
+		EndedAt:      transfer.AuctionEndsAt,
+	}
+
+	// 1. pick the winner
+	row := tx.QueryRow(ctx, getWinningBidByTransferID, transfer.ID, transfer.ReservePrice.Int64)
+	var winner Bid
+	err = row.Scan(
+		&winner.ID,
+		&winner.TransferID,
+		&winner.PlayerID,
+		&winner.SellerTeamID,
+		&winner.BidderTeamID,
+		&winner.Amount,
+		&winner.CounterAmount,
+		&winner.Status,
+		&winner.CreatedAt,
+		&winner.UpdatedAt,
+	)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		// 2. no valid bid, delist
+		if err := releaseBidsByTransferIDWithQuerier(ctx, tx, transfer.ID, 0); err != nil {
+			return AuctionResult{}, postgres.Rollback(ctx, tx, err)
+		}
+		if err := deleteTransferByIDWithQuerier(ctx, tx, transfer.ID); err != nil {
+			return AuctionResult{}, postgres.Rollback(ctx, tx, err)
+		}
+
+	case err != nil:
+		return AuctionResult{}, postgres.Rollback(ctx, tx, err)
+
+	default:
+		// 2. cancel losing bids
+		if err := releaseBidsByTransferIDWithQuerier(ctx, tx, transfer.ID, winner.ID); err != nil {
+			return AuctionResult{}, postgres.Rollback(ctx, tx, err)
+		}
+
+		// 3. close the winning bid, funds are already reserved
+		if _, err := tx.Exec(ctx, updateBidStatus, winner.ID, "ACCEPTED"); err != nil {
+			return AuctionResult{}, postgres.Rollback(ctx, tx, err)
+		}
+
+		// 4. hand over the player
+		if _, err := completeTransferWithQuerier(
+			ctx,
+			tx,
+			r.snowflakeNode,
+			r.valuator,
+			transfer,
+			winner.BidderTeamID,
+			winner.Amount,
+			r.tax.Of(winner.Amount),
+		); err != nil {
+			return AuctionResult{}, postgres.Rollback(ctx, tx, err)
+		}
+
+		result.WinningBidID = pgtype.Int8{Int64: winner.ID, Valid: true}
+		result.BuyerTeamID = pgtype.Int8{Int64: winner.BidderTeamID, Valid: true}
+		result.SoldPrice = pgtype.Int8{Int64: winner.Amount, Valid: true}
+		result.Outcome = "SOLD"
+	}
+
+	// 5. record the outcome
+	i, err := insertAuctionResultWithQuerier(ctx, tx, result)
+	if err != nil {
+		return AuctionResult{}, postgres.Rollback(ctx, tx, err)
+	}
+
+	// 6. announce it
+	if err := insertOutboxEventWithQuerier(
+		ctx,
+		tx,
+		r.snowflakeNode,
+		OutboxTopicAuctionSettled,
+		OutboxRef{ID: i.ID},
+	); err != nil {
+		return AuctionResult{}, postgres.Rollback(ctx, tx, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return AuctionResult{}, err
+	}
+
+	return i, nil
+}
+
+const insertAuctionResult = `-- name: InsertAuctionResult :one
+INSERT INTO auction_results (id, transfer_id, player_id, seller_team_id, winning_bid_id, buyer_team_id, sold_price, reserve_price, outcome, ended_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+RETURNING id, transfer_id, player_id, seller_team_id, winning_bid_id, buyer_team_id, sold_price, reserve_price, outcome, ended_at, settled_at
+`
+
+type InsertAuctionResultParams struct {
+	ID           int64              `json:"id"`
+	TransferID   int64              `json:"transfer_id"`
+	PlayerID     int64              `json:"player_id"`
+	SellerTeamID int64              `json:"seller_team_id"`
+	WinningBidID pgtype.Int8        `json:"winning_bid_id"`
+	BuyerTeamID  pgtype.Int8        `json:"buyer_team_id"`
+	SoldPrice    pgtype.Int8        `json:"sold_price"`
+	ReservePrice int64              `json:"reserve_price"`
+	Outcome      string             `json:"outcome"`
+	EndedAt      pgtype.Timestamptz `json:"ended_at"`
+}
+
+func insertAuctionResultWithQuerier(
+	ctx context.Context,
+	querier postgres.Querier,
+	arg InsertAuctionResultParams,
+) (AuctionResult, error) {
+	row := querier.QueryRow(ctx, insertAuctionResult,
+		arg.ID,
+		arg.TransferID,
+		arg.PlayerID,
+		arg.SellerTeamID,
+		arg.WinningBidID,
+		arg.BuyerTeamID,
+		arg.SoldPrice,
+		arg.ReservePrice,
+		arg.Outcome,
+		arg.EndedAt,
+	)
+	var i AuctionResult
+	err := row.Scan(
+		&i.ID,
+		&i.TransferID,
+		&i.PlayerID,
+		&i.SellerTeamID,
+		&i.WinningBidID,
+		&i.BuyerTeamID,
+		&i.SoldPrice,
+		&i.ReservePrice,
+		&i.Outcome,
+		&i.EndedAt,
+		&i.SettledAt,
+	)
+	return i, err
+}
+
+const getAuctionResultByID = `-- name: GetAuctionResultByID :one
+SELECT id, transfer_id, player_id, seller_team_id, winning_bid_id, buyer_team_id, sold_price, reserve_price, outcome, ended_at, settled_at FROM auction_results WHERE id = $1
+`
+
+func (r *pgAuctionRepository) GetAuctionResultByID(ctx context.Context, id int64) (AuctionResult, error) {
+	row := r.db.QueryRow(ctx, getAuctionResultByID, id)
+	var i AuctionResult
+	err := row.Scan(
+		&i.ID,
+		&i.TransferID,
+		&i.PlayerID,
+		&i.SellerTeamID,
+		&i.WinningBidID,
+		&i.BuyerTeamID,
+		&i.SoldPrice,
+		&i.ReservePrice,
+		&i.Outcome,
+		&i.EndedAt,
+		&i.SettledAt,
+	)
+	return i, err
+}
+
+const listAuctionResults = `-- name: ListAuctionResults :many
+SELECT id, transfer_id, player_id, seller_team_id, winning_bid_id, buyer_team_id, sold_price, reserve_price, outcome, ended_at, settled_at FROM auction_results WHERE id > $1 ORDER BY id LIMIT $2
+`
+
+type ListAuctionResultsParams struct {
+	ID    int64 `json:"id"`
+	Limit int32 `json:"limit"`
+}
+
+func (r *pgAuctionRepository) ListAuctionResults(
+	ctx context.Context,
+	arg ListAuctionResultsParams,
+) ([]AuctionResult, error) {
+	rows, err := r.db.Query(ctx, listAuctionResults, arg.ID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AuctionResult{}
+	for rows.Next() {
+		var i AuctionResult
+		if err := rows.Scan(
+			&i.ID,
+			&i.TransferID,
+			&i.PlayerID,
+			&i.SellerTeamID,
+			&i.WinningBidID,
+			&i.BuyerTeamID,
+			&i.SoldPrice,
+			&i.ReservePrice,
+			&i.Outcome,
+			&i.EndedAt,
+			&i.SettledAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
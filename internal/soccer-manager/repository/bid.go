@@ -0,0 +1,617 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/tax"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/valuation"
+	"github.com/hexley21/soccer-manager/pkg/infra/postgres"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+//go:generate mockgen -destination=mock/mock_bid.go -package=mock github.com/hexley21/soccer-manager/internal/soccer-manager/repository BidRepository
+type BidRepository interface {
+	GetBidByIDAndUserID(ctx context.Context, arg GetBidByIDAndUserIDParams) (Bid, error)
+	ListBidsByTransferID(ctx context.Context, arg ListBidsByTransferIDParams) ([]Bid, error)
+	ListBidsByUserID(ctx context.Context, arg ListBidsByUserIDParams) ([]Bid, error)
+
+	PlaceBid(ctx context.Context, arg PlaceBidParams) (Bid, error)
+	AcceptBid(ctx context.Context, id int64, userID int64) (TransferRecord, error)
+	RejectBid(ctx context.Context, id int64, userID int64) error
+	CounterBid(ctx context.Context, arg CounterBidParams) error
+	WithdrawBid(ctx context.Context, id int64, userID int64) error
+}
+
+type pgBidRepository struct {
+	db            *pgxpool.Pool
+	snowflakeNode *snowflake.Node
+	tax           tax.Policy
+	valuator      valuation.PriceValuator
+}
+
+func NewBidRepository(
+	db *pgxpool.Pool,
+	snowflakeNode *snowflake.Node,
+	taxPolicy tax.Policy,
+	valuator valuation.PriceValuator,
+) *pgBidRepository {
+	return &pgBidRepository{
+		db:            db,
+		snowflakeNode: snowflakeNode,
+		tax:           taxPolicy,
+		valuator:      valuator,
+	}
+}
+
+const getBidByIDAndUserID = `-- name: GetBidByIDAndUserID :one
+SELECT b.id, b.transfer_id, b.player_id, b.seller_team_id, b.bidder_team_id, b.amount, b.counter_amount, b.status, b.created_at, b.updated_at
+FROM bids b JOIN teams t ON t.id IN (b.seller_team_id, b.bidder_team_id) WHERE b.id = $1 AND t.user_id = $2
+`
+
+type GetBidByIDAndUserIDParams struct {
+	ID     int64 `json:"id"`
+	UserID int64 `json:"user_id"`
+}
+
+// GetBidByIDAndUserID returns the bid only if user's team is the seller or the bidder
+func (r *pgBidRepository) GetBidByIDAndUserID(
+	ctx context.Context,
+	arg GetBidByIDAndUserIDParams,
+) (Bid, error) {
+	row := r.db.QueryRow(ctx, getBidByIDAndUserID, arg.ID, arg.UserID)
+	var i Bid
+	err := row.Scan(
+		&i.ID,
+		&i.TransferID,
+		&i.PlayerID,
+		&i.SellerTeamID,
+		&i.BidderTeamID,
+		&i.Amount,
+		&i.CounterAmount,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listBidsByTransferID = `-- name: ListBidsByTransferID :many
+SELECT b.id, b.transfer_id, b.player_id, b.seller_team_id, b.bidder_team_id, b.amount, b.counter_amount, b.status, b.created_at, b.updated_at
+FROM bids b JOIN teams t ON t.id = b.seller_team_id WHERE b.transfer_id = $1 AND t.user_id = $2 AND b.id > $3 ORDER BY b.id LIMIT $4
+`
+
+type ListBidsByTransferIDParams struct {
+	TransferID int64 `json:"transfer_id"`
+	UserID     int64 `json:"user_id"`
+	ID         int64 `json:"id"`
+	Limit      int32 `json:"limit"`
+}
+
+// ListBidsByTransferID returns bids of the transfer, only the seller can see them
+func (r *pgBidRepository) ListBidsByTransferID(
+	ctx context.Context,
+	arg ListBidsByTransferIDParams,
+) ([]Bid, error) {
+	rows, err := r.db.Query(ctx, listBidsByTransferID, arg.TransferID, arg.UserID, arg.ID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	return scanBids(rows)
+}
+
+const listBidsByUserID = `-- name: ListBidsByUserID :many
+SELECT b.id, b.transfer_id, b.player_id, b.seller_team_id, b.bidder_team_id, b.amount, b.counter_amount, b.status, b.created_at, b.updated_at
+FROM bids b JOIN teams t ON t.id = b.bidder_team_id WHERE t.user_id = $1 AND b.id > $2 ORDER BY b.id LIMIT $3
+`
+
+type ListBidsByUserIDParams struct {
+	UserID int64 `json:"user_id"`
+	ID     int64 `json:"id"`
+	Limit  int32 `json:"limit"`
+}
+
+// ListBidsByUserID returns bids placed by user's team
+func (r *pgBidRepository) ListBidsByUserID(
+	ctx context.Context,
+	arg ListBidsByUserIDParams,
+) ([]Bid, error) {
+	rows, err := r.db.Query(ctx, listBidsByUserID, arg.UserID, arg.ID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	return scanBids(rows)
+}
+
+func scanBids(rows pgx.Rows) ([]Bid, error) {
+	defer rows.Close()
+	items := []Bid{}
+	for rows.Next() {
+		var i Bid
+		if err := rows.Scan(
+			&i.ID,
+			&i.TransferID,
+			&i.PlayerID,
+			&i.SellerTeamID,
+			&i.BidderTeamID,
+			&i.Amount,
+			&i.CounterAmount,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getBidByIDForUpdate = `-- name: GetBidByIDForUpdate :one
+SELECT id, transfer_id, player_id, seller_team_id, bidder_team_id, amount, counter_amount, status, created_at, updated_at FROM bids WHERE id = $1 FOR UPDATE
+`
+
+func getBidByIDForUpdateWithQuerier(
+	ctx context.Context,
+	querier postgres.Querier,
+	id int64,
+) (Bid, error) {
+	row := querier.QueryRow(ctx, getBidByIDForUpdate, id)
+	var i Bid
+	err := row.Scan(
+		&i.ID,
+		&i.TransferID,
+		&i.PlayerID,
+		&i.SellerTeamID,
+		&i.BidderTeamID,
+		&i.Amount,
+		&i.CounterAmount,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const insertBid = `-- name: InsertBid :one
+INSERT INTO bids (id, transfer_id, player_id, seller_team_id, bidder_team_id, amount) VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, transfer_id, player_id, seller_team_id, bidder_team_id, amount, counter_amount, status, created_at, updated_at
+`
+
+type PlaceBidParams struct {
+	TransferID int64 `json:"transfer_id"`
+	UserID     int64 `json:"user_id"`
+	Amount     int64 `json:"amount"`
+}
+
+const getHighestBidAmountByTransferID = `-- name: GetHighestBidAmountByTransferID :one
+SELECT COALESCE(MAX(amount), 0)::BIGINT FROM bids WHERE transfer_id = $1 AND status = 'PENDING'
+`
+
+// PlaceBid inserts a bid and reserves its amount from bidder's budget.
+// Auction bids must reach the opening price and outbid the current highest bid
+//
+// If transfer not found or lapsed - ErrNotFound
+// If bidding on own transfer - ErrConflict
+// If amount exceeds the budget - ErrViolation
+// If auction has ended - ErrNotAllowed
+// If amount is below the opening price or the highest bid - ErrTooLow
+func (r *pgBidRepository) PlaceBid(ctx context.Context, arg PlaceBidParams) (Bid, error) {
+	tx, err := r.db.BeginTx(
+		ctx,
+		pgx.TxOptions{IsoLevel: pgx.Serializable, AccessMode: pgx.ReadWrite},
+	)
+	if err != nil {
+		return Bid{}, err
+	}
+
+	transfer, err := selectTransferByIdWithQuerier(ctx, tx, arg.TransferID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return Bid{}, postgres.Rollback(ctx, tx, ErrNotFound)
+		}
+
+		return Bid{}, postgres.Rollback(ctx, tx, err)
+	}
+	// lapsed listings are gone, even if not swept yet
+	if transfer.ExpiresAt.Valid && !time.Now().Before(transfer.ExpiresAt.Time) {
+		return Bid{}, postgres.Rollback(ctx, tx, ErrNotFound)
+	}
+
+	bidderTeam, err := getTeamByUserIDWithQuerier(ctx, tx, arg.UserID)
+	if err != nil {
+		return Bid{}, postgres.Rollback(ctx, tx, err)
+	}
+
+	if transfer.SellerTeamID == bidderTeam.ID {
+		return Bid{}, postgres.Rollback(ctx, tx, ErrConflict)
+	}
+	if arg.Amount > bidderTeam.Budget {
+		return Bid{}, postgres.Rollback(ctx, tx, ErrViolation)
+	}
+
+	if transfer.AuctionEndsAt.Valid {
+		if !time.Now().Before(transfer.AuctionEndsAt.Time) {
+			return Bid{}, postgres.Rollback(ctx, tx, ErrNotAllowed)
+		}
+
+		var highest int64
+		if err := tx.QueryRow(ctx, getHighestBidAmountByTransferID, transfer.ID).Scan(&highest); err != nil {
+			return Bid{}, postgres.Rollback(ctx, tx, err)
+		}
+		if arg.Amount < transfer.Price || arg.Amount <= highest {
+			return Bid{}, postgres.Rollback(ctx, tx, ErrTooLow)
+		}
+	}
+
+	row := tx.QueryRow(ctx, insertBid,
+		r.snowflakeNode.Generate().Int64(),
+		transfer.ID,
+		transfer.PlayerID,
+		transfer.SellerTeamID,
+		bidderTeam.ID,
+		arg.Amount,
+	)
+	var i Bid
+	if err := row.Scan(
+		&i.ID,
+		&i.TransferID,
+		&i.PlayerID,
+		&i.SellerTeamID,
+		&i.BidderTeamID,
+		&i.Amount,
+		&i.CounterAmount,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	); err != nil {
+		return Bid{}, postgres.Rollback(ctx, tx, err)
+	}
+
+	// reserve funds
+	if err := addTeamBudgetWithQuerier(ctx, tx, AddTeamBudgetParams{
+		ID:     bidderTeam.ID,
+		Budget: -arg.Amount,
+	}); err != nil {
+		return Bid{}, postgres.Rollback(ctx, tx, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return Bid{}, err
+	}
+
+	return i, nil
+}
+
+const updateBidStatus = `-- name: UpdateBidStatus :exec
+UPDATE bids SET status = $2, updated_at = now() WHERE id = $1
+`
+
+// AcceptBid executes the transfer at the agreed price.
+// Seller accepts a pending bid at its amount,
+// bidder accepts a countered bid at the counter amount, the difference is charged on top of reserved funds.
+// Other open bids of the transfer are cancelled
+//
+// If bid not found or user is not a party - ErrNotFound
+// If bid is not awaiting user's decision or the listing has lapsed - ErrConflict
+// If bidder can't cover the counter amount - ErrViolation
+// If the bid is on an auction - ErrNotAllowed
+func (r *pgBidRepository) AcceptBid(ctx context.Context, id int64, userID int64) (TransferRecord, error) {
+	tx, err := r.db.BeginTx(
+		ctx,
+		pgx.TxOptions{IsoLevel: pgx.Serializable, AccessMode: pgx.ReadWrite},
+	)
+	if err != nil {
+		return TransferRecord{}, err
+	}
+
+	// 0. validation
+	bid, err := getBidByIDForUpdateWithQuerier(ctx, tx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return TransferRecord{}, postgres.Rollback(ctx, tx, ErrNotFound)
+		}
+
+		return TransferRecord{}, postgres.Rollback(ctx, tx, err)
+	}
+
+	team, err := getTeamByUserIDWithQuerier(ctx, tx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return TransferRecord{}, postgres.Rollback(ctx, tx, ErrNotFound)
+		}
+
+		return TransferRecord{}, postgres.Rollback(ctx, tx, err)
+	}
+
+	var price int64
+	switch team.ID {
+	case bid.SellerTeamID:
+		if bid.Status != "PENDING" {
+			return TransferRecord{}, postgres.Rollback(ctx, tx, ErrConflict)
+		}
+		price = bid.Amount
+
+	case bid.BidderTeamID:
+		if bid.Status != "COUNTERED" {
+			return TransferRecord{}, postgres.Rollback(ctx, tx, ErrConflict)
+		}
+		price = bid.CounterAmount.Int64
+
+		// reserve the rest of the counter amount
+		extra := price - bid.Amount
+		if extra > team.Budget {
+			return TransferRecord{}, postgres.Rollback(ctx, tx, ErrViolation)
+		}
+		if err := addTeamBudgetWithQuerier(ctx, tx, AddTeamBudgetParams{
+			ID:     team.ID,
+			Budget: -extra,
+		}); err != nil {
+			return TransferRecord{}, postgres.Rollback(ctx, tx, err)
+		}
+
+	default:
+		return TransferRecord{}, postgres.Rollback(ctx, tx, ErrNotFound)
+	}
+
+	if !bid.TransferID.Valid {
+		return TransferRecord{}, postgres.Rollback(ctx, tx, ErrConflict)
+	}
+
+	transfer, err := selectTransferByIdWithQuerier(ctx, tx, bid.TransferID.Int64)
+	if err != nil {
+		return TransferRecord{}, postgres.Rollback(ctx, tx, err)
+	}
+	if transfer.AuctionEndsAt.Valid {
+		return TransferRecord{}, postgres.Rollback(ctx, tx, ErrNotAllowed)
+	}
+	// the listing has lapsed and is about to be swept
+	if transfer.ExpiresAt.Valid && !time.Now().Before(transfer.ExpiresAt.Time) {
+		return TransferRecord{}, postgres.Rollback(ctx, tx, ErrConflict)
+	}
+
+	// 1. cancel competing bids
+	if err := releaseBidsByTransferIDWithQuerier(ctx, tx, transfer.ID, bid.ID); err != nil {
+		return TransferRecord{}, postgres.Rollback(ctx, tx, err)
+	}
+
+	// 2. close the bid, funds are already reserved
+	if _, err := tx.Exec(ctx, updateBidStatus, bid.ID, "ACCEPTED"); err != nil {
+		return TransferRecord{}, postgres.Rollback(ctx, tx, err)
+	}
+
+	// 3. hand over the player
+	record, err := completeTransferWithQuerier(
+		ctx,
+		tx,
+		r.snowflakeNode,
+		r.valuator,
+		transfer,
+		bid.BidderTeamID,
+		price,
+		r.tax.Of(price),
+	)
+	if err != nil {
+		return TransferRecord{}, postgres.Rollback(ctx, tx, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return TransferRecord{}, err
+	}
+
+	return record, nil
+}
+
+// RejectBid closes an open bid by the seller and gives back reserved funds
+//
+// If bid not found or user is not the seller - ErrNotFound
+// If bid is not open - ErrConflict
+// If the bid is on an auction - ErrNotAllowed
+func (r *pgBidRepository) RejectBid(ctx context.Context, id int64, userID int64) error {
+	return r.closeBid(ctx, id, userID, "REJECTED", false, func(bid Bid) int64 { return bid.SellerTeamID })
+}
+
+// WithdrawBid closes an open bid by the bidder and gives back reserved funds,
+// auction bids may be withdrawn until the auction ends
+//
+// If bid not found or user is not the bidder - ErrNotFound
+// If bid is not open - ErrConflict
+// If the bid is on an ended auction - ErrNotAllowed
+func (r *pgBidRepository) WithdrawBid(ctx context.Context, id int64, userID int64) error {
+	return r.closeBid(ctx, id, userID, "WITHDRAWN", true, func(bid Bid) int64 { return bid.BidderTeamID })
+}
+
+func (r *pgBidRepository) closeBid(
+	ctx context.Context,
+	id int64,
+	userID int64,
+	status string,
+	onAuctions bool,
+	party func(Bid) int64,
+) error {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return err
+	}
+
+	bid, err := openBidOfPartyWithQuerier(ctx, tx, id, userID, party)
+	if err != nil {
+		return postgres.Rollback(ctx, tx, err)
+	}
+	if onAuctions {
+		if err := ensureAuctionRunningWithQuerier(ctx, tx, bid); err != nil {
+			return postgres.Rollback(ctx, tx, err)
+		}
+	} else {
+		if err := ensureNotAuctionBidWithQuerier(ctx, tx, bid); err != nil {
+			return postgres.Rollback(ctx, tx, err)
+		}
+	}
+
+	if _, err := tx.Exec(ctx, updateBidStatus, bid.ID, status); err != nil {
+		return postgres.Rollback(ctx, tx, err)
+	}
+
+	// give back reserved funds
+	if err := addTeamBudgetWithQuerier(ctx, tx, AddTeamBudgetParams{
+		ID:     bid.BidderTeamID,
+		Budget: bid.Amount,
+	}); err != nil {
+		return postgres.Rollback(ctx, tx, err)
+	}
+
+	return tx.Commit(ctx)
+}
+
+const counterBid = `-- name: CounterBid :exec
+UPDATE bids SET status = 'COUNTERED', counter_amount = $2, updated_at = now() WHERE id = $1
+`
+
+type CounterBidParams struct {
+	ID     int64 `json:"id"`
+	UserID int64 `json:"user_id"`
+	Amount int64 `json:"amount"`
+}
+
+// CounterBid makes seller's counter offer, the bidder may accept it or withdraw
+//
+// If bid not found or user is not the seller - ErrNotFound
+// If bid is not open - ErrConflict
+// If counter amount doesn't exceed the bid - ErrViolation
+// If the bid is on an auction - ErrNotAllowed
+func (r *pgBidRepository) CounterBid(ctx context.Context, arg CounterBidParams) error {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return err
+	}
+
+	bid, err := openBidOfPartyWithQuerier(ctx, tx, arg.ID, arg.UserID, func(bid Bid) int64 { return bid.SellerTeamID })
+	if err != nil {
+		return postgres.Rollback(ctx, tx, err)
+	}
+
+	if err := ensureNotAuctionBidWithQuerier(ctx, tx, bid); err != nil {
+		return postgres.Rollback(ctx, tx, err)
+	}
+	if arg.Amount <= bid.Amount {
+		return postgres.Rollback(ctx, tx, ErrViolation)
+	}
+
+	if _, err := tx.Exec(ctx, counterBid, bid.ID, arg.Amount); err != nil {
+		return postgres.Rollback(ctx, tx, err)
+	}
+
+	return tx.Commit(ctx)
+}
+
+// openBidOfPartyWithQuerier locks the bid and makes sure it's open and user's team is the expected party
+func openBidOfPartyWithQuerier(
+	ctx context.Context,
+	querier postgres.Querier,
+	id int64,
+	userID int64,
+	party func(Bid) int64,
+) (Bid, error) {
+	bid, err := getBidByIDForUpdateWithQuerier(ctx, querier, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return Bid{}, ErrNotFound
+		}
+
+		return Bid{}, err
+	}
+
+	team, err := getTeamByUserIDWithQuerier(ctx, querier, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return Bid{}, ErrNotFound
+		}
+
+		return Bid{}, err
+	}
+
+	if party(bid) != team.ID {
+		return Bid{}, ErrNotFound
+	}
+	if bid.Status != "PENDING" && bid.Status != "COUNTERED" {
+		return Bid{}, ErrConflict
+	}
+
+	return bid, nil
+}
+
+// ensureNotAuctionBidWithQuerier rejects seller decisions on auction bids, those are settled by the auction
+func ensureNotAuctionBidWithQuerier(ctx context.Context, querier postgres.Querier, bid Bid) error {
+	if !bid.TransferID.Valid {
+		return nil
+	}
+
+	transfer, err := selectTransferByIdWithQuerier(ctx, querier, bid.TransferID.Int64)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	}
+	if transfer.AuctionEndsAt.Valid {
+		return ErrNotAllowed
+	}
+
+	return nil
+}
+
+// ensureAuctionRunningWithQuerier rejects changes to auction bids once the auction has ended,
+// the bids stand as they are until the auction is settled
+func ensureAuctionRunningWithQuerier(ctx context.Context, querier postgres.Querier, bid Bid) error {
+	if !bid.TransferID.Valid {
+		return nil
+	}
+
+	transfer, err := selectTransferByIdWithQuerier(ctx, querier, bid.TransferID.Int64)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	}
+	if transfer.AuctionEndsAt.Valid && !time.Now().Before(transfer.AuctionEndsAt.Time) {
+		return ErrNotAllowed
+	}
+
+	return nil
+}
+
+const releaseBidsByTransferID = `-- name: ReleaseBidsByTransferID :exec
+WITH released AS (
+  UPDATE bids SET status = 'CANCELLED', updated_at = now()
+  WHERE transfer_id = $1 AND id <> $2 AND status IN ('PENDING', 'COUNTERED')
+  RETURNING bidder_team_id, amount
+)
+UPDATE teams SET budget = teams.budget + released.amount FROM released WHERE teams.id = released.bidder_team_id
+`
+
+// releaseBidsByTransferIDWithQuerier cancels open bids of the transfer except the given one
+// and gives back their reserved funds, must run before the transfer is deleted
+func releaseBidsByTransferIDWithQuerier(
+	ctx context.Context,
+	querier postgres.Querier,
+	transferID int64,
+	exceptBidID int64,
+) error {
+	_, err := querier.Exec(ctx, releaseBidsByTransferID, transferID, exceptBidID)
+	return err
+}
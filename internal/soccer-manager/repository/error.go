@@ -5,4 +5,6 @@ import "errors"
 var ErrNotFound = errors.New("not found")
 
 var ErrConflict = errors.New("conflict")
-var ErrViolation = errors.New("constraint violation")
\ No newline at end of file
+var ErrViolation = errors.New("constraint violation")
+var ErrNotAllowed = errors.New("not allowed")
+var ErrTooLow = errors.New("value too low")
@@ -0,0 +1,419 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bwmarrin/snowflake"
+	"github.com/hexley21/soccer-manager/pkg/infra/postgres"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+//go:generate mockgen -destination=mock/mock_league.go -package=mock github.com/hexley21/soccer-manager/internal/soccer-manager/repository LeagueRepository
+type LeagueRepository interface {
+	InsertLeague(ctx context.Context, name string) (League, error)
+	GetLeagueByID(ctx context.Context, id int64) (League, error)
+	ListLeaguesCursor(ctx context.Context, arg ListLeaguesCursorParams) ([]League, error)
+
+	InsertLeagueTeam(ctx context.Context, arg InsertLeagueTeamParams) error
+	DeleteLeagueTeam(ctx context.Context, arg DeleteLeagueTeamParams) error
+	ListLeagueTeams(ctx context.Context, leagueID int64) ([]Team, error)
+
+	StartLeague(ctx context.Context, leagueID int64, schedule func(teamIDs []int64) []InsertFixtureParams) error
+	GetFixtureByID(ctx context.Context, arg GetFixtureByIDParams) (Fixture, error)
+	ListFixturesByLeagueID(ctx context.Context, leagueID int64) ([]Fixture, error)
+	InsertFixtureMatch(ctx context.Context, fixtureID int64, arg InsertMatchParams) (int64, error)
+}
+
+type pgLeagueRepository struct {
+	db            *pgxpool.Pool
+	snowflakeNode *snowflake.Node
+}
+
+func NewLeagueRepository(db *pgxpool.Pool, snowflakeNode *snowflake.Node) *pgLeagueRepository {
+	return &pgLeagueRepository{
+		db:            db,
+		snowflakeNode: snowflakeNode,
+	}
+}
+
+const insertLeague = `-- name: InsertLeague :one
+INSERT INTO leagues (id, name) VALUES ($1, $2) RETURNING id, name, status, created_at
+`
+
+func (r *pgLeagueRepository) InsertLeague(ctx context.Context, name string) (League, error) {
+	row := r.db.QueryRow(ctx, insertLeague, r.snowflakeNode.Generate().Int64(), name)
+	var i League
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Status,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getLeagueByID = `-- name: GetLeagueByID :one
+SELECT id, name, status, created_at FROM leagues WHERE id = $1
+`
+
+func (r *pgLeagueRepository) GetLeagueByID(ctx context.Context, id int64) (League, error) {
+	row := r.db.QueryRow(ctx, getLeagueByID, id)
+	var i League
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Status,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listLeaguesCursor = `-- name: ListLeaguesCursor :many
+SELECT id, name, status, created_at FROM leagues WHERE id > $1 ORDER BY id LIMIT $2
+`
+
+type ListLeaguesCursorParams struct {
+	ID    int64 `json:"id"`
+	Limit int32 `json:"limit"`
+}
+
+func (r *pgLeagueRepository) ListLeaguesCursor(
+	ctx context.Context,
+	arg ListLeaguesCursorParams,
+) ([]League, error) {
+	rows, err := r.db.Query(ctx, listLeaguesCursor, arg.ID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []League{}
+	for rows.Next() {
+		var i League
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Status,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const shareLeagueStatus = `-- name: ShareLeagueStatus :one
+SELECT status FROM leagues WHERE id = $1 FOR SHARE
+`
+
+const lockLeagueStatus = `-- name: LockLeagueStatus :one
+SELECT status FROM leagues WHERE id = $1 FOR UPDATE
+`
+
+// ensureLeagueEnrollingWithQuerier locks the league with the given query until the transaction ends.
+// Enrollment changes share the lock and the start takes it exclusively, so teams can't change while fixtures are made
+//
+// If league not found - ErrNotFound
+// If league is not in enrollment - ErrConflict
+func ensureLeagueEnrollingWithQuerier(ctx context.Context, querier postgres.Querier, lock string, leagueID int64) error {
+	var status string
+	if err := querier.QueryRow(ctx, lock, leagueID).Scan(&status); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+
+		return err
+	}
+	if status != "ENROLLING" {
+		return ErrConflict
+	}
+
+	return nil
+}
+
+const insertLeagueTeam = `-- name: InsertLeagueTeam :exec
+INSERT INTO league_teams (league_id, team_id) VALUES ($1, $2)
+`
+
+type InsertLeagueTeamParams struct {
+	LeagueID int64 `json:"league_id"`
+	TeamID   int64 `json:"team_id"`
+}
+
+// InsertLeagueTeam enrolls the team while the league is in enrollment
+//
+// If league not found - ErrNotFound
+// If league is not in enrollment - ErrConflict
+func (r *pgLeagueRepository) InsertLeagueTeam(ctx context.Context, arg InsertLeagueTeamParams) error {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := ensureLeagueEnrollingWithQuerier(ctx, tx, shareLeagueStatus, arg.LeagueID); err != nil {
+		return postgres.Rollback(ctx, tx, err)
+	}
+
+	if _, err := tx.Exec(ctx, insertLeagueTeam, arg.LeagueID, arg.TeamID); err != nil {
+		return postgres.Rollback(ctx, tx, err)
+	}
+
+	return tx.Commit(ctx)
+}
+
+const deleteLeagueTeam = `-- name: DeleteLeagueTeam :exec
+DELETE FROM league_teams WHERE league_id = $1 AND team_id = $2
+`
+
+type DeleteLeagueTeamParams struct {
+	LeagueID int64 `json:"league_id"`
+	TeamID   int64 `json:"team_id"`
+}
+
+// DeleteLeagueTeam unenrolls the team while the league is in enrollment
+//
+// If league not found or team not enrolled - ErrNotFound
+// If league is not in enrollment - ErrConflict
+func (r *pgLeagueRepository) DeleteLeagueTeam(ctx context.Context, arg DeleteLeagueTeamParams) error {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := ensureLeagueEnrollingWithQuerier(ctx, tx, shareLeagueStatus, arg.LeagueID); err != nil {
+		return postgres.Rollback(ctx, tx, err)
+	}
+
+	res, err := tx.Exec(ctx, deleteLeagueTeam, arg.LeagueID, arg.TeamID)
+	if err != nil {
+		return postgres.Rollback(ctx, tx, err)
+	}
+	if res.RowsAffected() == 0 {
+		return postgres.Rollback(ctx, tx, ErrNotFound)
+	}
+
+	return tx.Commit(ctx)
+}
+
+const listLeagueTeams = `-- name: ListLeagueTeams :many
+SELECT t.id, t.user_id, t.name, t.country_code, t.budget, t.total_players FROM teams t JOIN league_teams lt ON lt.team_id = t.id WHERE lt.league_id = $1 ORDER BY t.id
+`
+
+func (r *pgLeagueRepository) ListLeagueTeams(ctx context.Context, leagueID int64) ([]Team, error) {
+	return listLeagueTeamsWithQuerier(ctx, r.db, leagueID)
+}
+
+func listLeagueTeamsWithQuerier(ctx context.Context, querier postgres.Querier, leagueID int64) ([]Team, error) {
+	rows, err := querier.Query(ctx, listLeagueTeams, leagueID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Team{}
+	for rows.Next() {
+		var i Team
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Name,
+			&i.CountryCode,
+			&i.Budget,
+			&i.TotalPlayers,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const startLeague = `-- name: StartLeague :exec
+UPDATE leagues SET status = 'STARTED' WHERE id = $1 AND status = 'ENROLLING'
+`
+
+const insertFixture = `-- name: InsertFixture :exec
+INSERT INTO fixtures (id, league_id, round, home_team_id, away_team_id) VALUES ($1, $2, $3, $4, $5)
+`
+
+type InsertFixtureParams struct {
+	Round      int32 `json:"round"`
+	HomeTeamID int64 `json:"home_team_id"`
+	AwayTeamID int64 `json:"away_team_id"`
+}
+
+// StartLeague closes the enrollment and writes the fixture list of the enrolled teams in a single transaction.
+// The league is locked first, so the teams given to schedule are the ones enrolled when it starts
+//
+// If league not found - ErrNotFound
+// If league is not in enrollment - ErrConflict
+// If less than two teams are enrolled - ErrNotAllowed
+func (r *pgLeagueRepository) StartLeague(
+	ctx context.Context,
+	leagueID int64,
+	schedule func(teamIDs []int64) []InsertFixtureParams,
+) error {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := ensureLeagueEnrollingWithQuerier(ctx, tx, lockLeagueStatus, leagueID); err != nil {
+		return postgres.Rollback(ctx, tx, err)
+	}
+
+	teams, err := listLeagueTeamsWithQuerier(ctx, tx, leagueID)
+	if err != nil {
+		return postgres.Rollback(ctx, tx, err)
+	}
+	if len(teams) < 2 {
+		return postgres.Rollback(ctx, tx, ErrNotAllowed)
+	}
+
+	teamIDs := make([]int64, len(teams))
+	for i, t := range teams {
+		teamIDs[i] = t.ID
+	}
+
+	if _, err := tx.Exec(ctx, startLeague, leagueID); err != nil {
+		return postgres.Rollback(ctx, tx, err)
+	}
+
+	for _, f := range schedule(teamIDs) {
+		if _, err := tx.Exec(ctx, insertFixture,
+			r.snowflakeNode.Generate().Int64(),
+			leagueID,
+			f.Round,
+			f.HomeTeamID,
+			f.AwayTeamID,
+		); err != nil {
+			return postgres.Rollback(ctx, tx, err)
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+const getFixtureByID = `-- name: GetFixtureByID :one
+SELECT f.id, f.league_id, f.round, f.home_team_id, f.away_team_id, f.match_id, m.home_score, m.away_score, m.played_at
+FROM fixtures f LEFT JOIN matches m ON m.id = f.match_id WHERE f.league_id = $1 AND f.id = $2
+`
+
+type GetFixtureByIDParams struct {
+	LeagueID int64 `json:"league_id"`
+	ID       int64 `json:"id"`
+}
+
+func (r *pgLeagueRepository) GetFixtureByID(
+	ctx context.Context,
+	arg GetFixtureByIDParams,
+) (Fixture, error) {
+	row := r.db.QueryRow(ctx, getFixtureByID, arg.LeagueID, arg.ID)
+	var i Fixture
+	err := row.Scan(
+		&i.ID,
+		&i.LeagueID,
+		&i.Round,
+		&i.HomeTeamID,
+		&i.AwayTeamID,
+		&i.MatchID,
+		&i.HomeScore,
+		&i.AwayScore,
+		&i.PlayedAt,
+	)
+	return i, err
+}
+
+const listFixturesByLeagueID = `-- name: ListFixturesByLeagueID :many
+SELECT f.id, f.league_id, f.round, f.home_team_id, f.away_team_id, f.match_id, m.home_score, m.away_score, m.played_at
+FROM fixtures f LEFT JOIN matches m ON m.id = f.match_id WHERE f.league_id = $1 ORDER BY f.round, f.id
+`
+
+func (r *pgLeagueRepository) ListFixturesByLeagueID(
+	ctx context.Context,
+	leagueID int64,
+) ([]Fixture, error) {
+	rows, err := r.db.Query(ctx, listFixturesByLeagueID, leagueID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Fixture{}
+	for rows.Next() {
+		var i Fixture
+		if err := rows.Scan(
+			&i.ID,
+			&i.LeagueID,
+			&i.Round,
+			&i.HomeTeamID,
+			&i.AwayTeamID,
+			&i.MatchID,
+			&i.HomeScore,
+			&i.AwayScore,
+			&i.PlayedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setFixtureMatch = `-- name: SetFixtureMatch :exec
+UPDATE fixtures SET match_id = $2 WHERE id = $1 AND match_id IS NULL
+`
+
+// InsertFixtureMatch stores the match and attaches it to the fixture in a single transaction
+//
+// If fixture was already played - ErrConflict
+func (r *pgLeagueRepository) InsertFixtureMatch(
+	ctx context.Context,
+	fixtureID int64,
+	arg InsertMatchParams,
+) (int64, error) {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	matchID, err := insertMatchWithQuerier(ctx, tx, r.snowflakeNode, arg)
+	if err != nil {
+		return 0, postgres.Rollback(ctx, tx, err)
+	}
+
+	res, err := tx.Exec(ctx, setFixtureMatch, fixtureID, matchID)
+	if err != nil {
+		return 0, postgres.Rollback(ctx, tx, err)
+	}
+	if res.RowsAffected() == 0 {
+		return 0, postgres.Rollback(ctx, tx, ErrConflict)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+
+	return matchID, nil
+}
@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/throttle"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// pgLoginFailureRepository is a throttle.Tracker shared by every instance
+type pgLoginFailureRepository struct {
+	db     *pgxpool.Pool
+	window time.Duration
+}
+
+func NewLoginFailureRepository(db *pgxpool.Pool, window time.Duration) *pgLoginFailureRepository {
+	return &pgLoginFailureRepository{
+		db:     db,
+		window: window,
+	}
+}
+
+const getLoginFailure = `-- name: GetLoginFailure :one
+SELECT failures, last_failed_at FROM login_failures
+WHERE key = $1 AND last_failed_at > now() - make_interval(secs => $2)
+`
+
+func (r *pgLoginFailureRepository) getLoginFailure(ctx context.Context, key string) (throttle.Record, error) {
+	row := r.db.QueryRow(ctx, getLoginFailure, key, r.window.Seconds())
+	var i throttle.Record
+	if err := row.Scan(&i.Failures, &i.LastFailedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return throttle.Record{}, nil
+		}
+		return throttle.Record{}, err
+	}
+
+	return i, nil
+}
+
+const attemptLogin = `-- name: AttemptLogin :one
+INSERT INTO login_failures (key, failures) VALUES ($1, 1)
+ON CONFLICT (key) DO UPDATE SET
+  failures = CASE
+    WHEN login_failures.last_failed_at > now() - make_interval(secs => $2) THEN login_failures.failures + 1
+    ELSE 1
+  END,
+  last_failed_at = now()
+WHERE login_failures.last_failed_at <= now() - make_interval(secs => $2)
+  OR login_failures.failures < $3::INT
+  OR login_failures.last_failed_at + make_interval(
+    secs => LEAST($4::FLOAT8 * power(2, LEAST(login_failures.failures - $3::INT, 62)), $5::FLOAT8)
+  ) <= now()
+RETURNING failures, last_failed_at
+`
+
+// Attempt checks the policy on the locked row of the key, concurrent attempts of a key are counted one by one.
+// The delay is the one of throttle.Policy.Delay, counted on the database clock
+func (r *pgLoginFailureRepository) Attempt(
+	ctx context.Context,
+	key string,
+	policy throttle.Policy,
+) (throttle.Record, bool, error) {
+	row := r.db.QueryRow(ctx, attemptLogin,
+		key,
+		r.window.Seconds(),
+		policy.FreeAttempts,
+		policy.BaseDelay.Seconds(),
+		policy.MaxDelay.Seconds(),
+	)
+	var i throttle.Record
+	if err := row.Scan(&i.Failures, &i.LastFailedAt); err != nil {
+		// nothing is counted while the key has to wait
+		if errors.Is(err, pgx.ErrNoRows) {
+			record, err := r.getLoginFailure(ctx, key)
+			return record, false, err
+		}
+		return throttle.Record{}, false, err
+	}
+
+	return i, true, nil
+}
+
+const forgiveLoginFailure = `-- name: ForgiveLoginFailure :exec
+UPDATE login_failures SET failures = failures - 1 WHERE key = $1 AND failures > 0
+`
+
+func (r *pgLoginFailureRepository) Forgive(ctx context.Context, key string) error {
+	_, err := r.db.Exec(ctx, forgiveLoginFailure, key)
+	return err
+}
+
+const deleteLoginFailure = `-- name: DeleteLoginFailure :exec
+DELETE FROM login_failures WHERE key = $1
+`
+
+func (r *pgLoginFailureRepository) Reset(ctx context.Context, key string) error {
+	_, err := r.db.Exec(ctx, deleteLoginFailure, key)
+	return err
+}
+
+const pruneLoginFailures = `-- name: PruneLoginFailures :execrows
+DELETE FROM login_failures WHERE last_failed_at <= now() - make_interval(secs => $1)
+`
+
+func (r *pgLoginFailureRepository) Prune(ctx context.Context) (int64, error) {
+	res, err := r.db.Exec(ctx, pruneLoginFailures, r.window.Seconds())
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected(), nil
+}
@@ -0,0 +1,208 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+//go:generate mockgen -destination=mock/mock_market_stats.go -package=mock github.com/hexley21/soccer-manager/internal/soccer-manager/repository MarketStatsRepository
+type MarketStatsRepository interface {
+	ListDailyVolume(ctx context.Context, since pgtype.Timestamptz) ([]MarketDayVolume, error)
+	ListAveragePriceByPosition(ctx context.Context, since pgtype.Timestamptz) ([]MarketPositionPrice, error)
+	ListTopTransferRecords(ctx context.Context, arg MarketStatsParams) ([]TransferRecord, error)
+	ListTopSpenders(ctx context.Context, arg MarketStatsParams) ([]MarketTeamTotal, error)
+	ListTopSellers(ctx context.Context, arg MarketStatsParams) ([]MarketTeamTotal, error)
+	GetAverageTimeToSell(ctx context.Context, since pgtype.Timestamptz) (int64, error)
+}
+
+type pgMarketStatsRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewMarketStatsRepository(db *pgxpool.Pool) *pgMarketStatsRepository {
+	return &pgMarketStatsRepository{
+		db: db,
+	}
+}
+
+type MarketStatsParams struct {
+	Since pgtype.Timestamptz `json:"since"`
+	Limit int32              `json:"limit"`
+}
+
+const listDailyVolume = `-- name: ListDailyVolume :many
+SELECT date_trunc('day', sold_at) AS day, count(*), COALESCE(sum(sold_price), 0)::BIGINT
+FROM transfer_records
+WHERE sold_at >= $1
+GROUP BY day
+ORDER BY day
+`
+
+// ListDailyVolume returns the number of sales and money spent per day, days without sales are omitted
+func (r *pgMarketStatsRepository) ListDailyVolume(
+	ctx context.Context,
+	since pgtype.Timestamptz,
+) ([]MarketDayVolume, error) {
+	rows, err := r.db.Query(ctx, listDailyVolume, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []MarketDayVolume{}
+	for rows.Next() {
+		var i MarketDayVolume
+		if err := rows.Scan(&i.Day, &i.Sales, &i.Volume); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAveragePriceByPosition = `-- name: ListAveragePriceByPosition :many
+SELECT p.position_code, count(*), avg(tr.sold_price)::BIGINT
+FROM transfer_records tr JOIN players p ON p.id = tr.player_id
+WHERE tr.sold_at >= $1
+GROUP BY p.position_code
+ORDER BY p.position_code
+`
+
+func (r *pgMarketStatsRepository) ListAveragePriceByPosition(
+	ctx context.Context,
+	since pgtype.Timestamptz,
+) ([]MarketPositionPrice, error) {
+	rows, err := r.db.Query(ctx, listAveragePriceByPosition, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []MarketPositionPrice{}
+	for rows.Next() {
+		var i MarketPositionPrice
+		if err := rows.Scan(&i.PositionCode, &i.Sales, &i.AveragePrice); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTopTransferRecords = `-- name: ListTopTransferRecords :many
+SELECT id, player_id, seller_team_id, buyer_team_id, sold_price, tax, listed_at, sold_at FROM transfer_records
+WHERE sold_at >= $1
+ORDER BY sold_price DESC, id
+LIMIT $2
+`
+
+// ListTopTransferRecords returns the most expensive sales
+func (r *pgMarketStatsRepository) ListTopTransferRecords(
+	ctx context.Context,
+	arg MarketStatsParams,
+) ([]TransferRecord, error) {
+	rows, err := r.db.Query(ctx, listTopTransferRecords, arg.Since, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []TransferRecord{}
+	for rows.Next() {
+		var i TransferRecord
+		if err := rows.Scan(
+			&i.ID,
+			&i.PlayerID,
+			&i.SellerTeamID,
+			&i.BuyerTeamID,
+			&i.SoldPrice,
+			&i.Tax,
+			&i.ListedAt,
+			&i.SoldAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTopSpenders = `-- name: ListTopSpenders :many
+SELECT buyer_team_id, count(*), sum(sold_price)::BIGINT AS total
+FROM transfer_records
+WHERE sold_at >= $1
+GROUP BY buyer_team_id
+ORDER BY total DESC, buyer_team_id
+LIMIT $2
+`
+
+// ListTopSpenders returns teams that paid the most for players
+func (r *pgMarketStatsRepository) ListTopSpenders(
+	ctx context.Context,
+	arg MarketStatsParams,
+) ([]MarketTeamTotal, error) {
+	return r.listTeamTotals(ctx, listTopSpenders, arg)
+}
+
+const listTopSellers = `-- name: ListTopSellers :many
+SELECT seller_team_id, count(*), sum(sold_price - tax)::BIGINT AS total
+FROM transfer_records
+WHERE sold_at >= $1
+GROUP BY seller_team_id
+ORDER BY total DESC, seller_team_id
+LIMIT $2
+`
+
+// ListTopSellers returns teams that earned the most from sales, after tax
+func (r *pgMarketStatsRepository) ListTopSellers(
+	ctx context.Context,
+	arg MarketStatsParams,
+) ([]MarketTeamTotal, error) {
+	return r.listTeamTotals(ctx, listTopSellers, arg)
+}
+
+func (r *pgMarketStatsRepository) listTeamTotals(
+	ctx context.Context,
+	query string,
+	arg MarketStatsParams,
+) ([]MarketTeamTotal, error) {
+	rows, err := r.db.Query(ctx, query, arg.Since, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []MarketTeamTotal{}
+	for rows.Next() {
+		var i MarketTeamTotal
+		if err := rows.Scan(&i.TeamID, &i.Transfers, &i.Total); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAverageTimeToSell = `-- name: GetAverageTimeToSell :one
+SELECT COALESCE(avg(extract(EPOCH FROM sold_at - listed_at)), 0)::BIGINT FROM transfer_records WHERE sold_at >= $1
+`
+
+// GetAverageTimeToSell returns the average number of seconds from listed_at to sold_at
+func (r *pgMarketStatsRepository) GetAverageTimeToSell(
+	ctx context.Context,
+	since pgtype.Timestamptz,
+) (int64, error) {
+	var seconds int64
+	err := r.db.QueryRow(ctx, getAverageTimeToSell, since).Scan(&seconds)
+	return seconds, err
+}
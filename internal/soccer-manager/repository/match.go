@@ -0,0 +1,250 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/bwmarrin/snowflake"
+	"github.com/hexley21/soccer-manager/pkg/infra/postgres"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+//go:generate mockgen -destination=mock/mock_match.go -package=mock github.com/hexley21/soccer-manager/internal/soccer-manager/repository MatchRepository
+type MatchRepository interface {
+	InsertMatch(ctx context.Context, arg InsertMatchParams) (int64, error)
+	GetMatchByID(ctx context.Context, id int64) (Match, error)
+	ListMatchesCursor(ctx context.Context, arg ListMatchesCursorParams) ([]Match, error)
+	ListMatchesByTeamID(ctx context.Context, arg ListMatchesByTeamIDParams) ([]Match, error)
+	ListMatchEventsByMatchID(ctx context.Context, matchID int64) ([]MatchEvent, error)
+}
+
+type pgMatchRepository struct {
+	db            *pgxpool.Pool
+	snowflakeNode *snowflake.Node
+}
+
+func NewMatchRepository(db *pgxpool.Pool, snowflakeNode *snowflake.Node) *pgMatchRepository {
+	return &pgMatchRepository{
+		db:            db,
+		snowflakeNode: snowflakeNode,
+	}
+}
+
+const insertMatch = `-- name: InsertMatch :exec
+INSERT INTO matches (id, home_team_id, away_team_id, seed, home_score, away_score) VALUES ($1, $2, $3, $4, $5, $6)
+`
+
+type InsertMatchParams struct {
+	HomeTeamID int64                    `json:"home_team_id"`
+	AwayTeamID int64                    `json:"away_team_id"`
+	Seed       int64                    `json:"seed"`
+	HomeScore  int32                    `json:"home_score"`
+	AwayScore  int32                    `json:"away_score"`
+	Events     []InsertMatchEventParams `json:"events"`
+}
+
+// InsertMatch writes the match together with its timeline in a single transaction
+// and returns the id of the created match
+func (r *pgMatchRepository) InsertMatch(ctx context.Context, arg InsertMatchParams) (int64, error) {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	matchID, err := insertMatchWithQuerier(ctx, tx, r.snowflakeNode, arg)
+	if err != nil {
+		return 0, postgres.Rollback(ctx, tx, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+
+	return matchID, nil
+}
+
+func insertMatchWithQuerier(
+	ctx context.Context,
+	querier postgres.Querier,
+	snowflakeNode *snowflake.Node,
+	arg InsertMatchParams,
+) (int64, error) {
+	matchID := snowflakeNode.Generate().Int64()
+	if _, err := querier.Exec(ctx, insertMatch,
+		matchID,
+		arg.HomeTeamID,
+		arg.AwayTeamID,
+		arg.Seed,
+		arg.HomeScore,
+		arg.AwayScore,
+	); err != nil {
+		return 0, err
+	}
+
+	for _, event := range arg.Events {
+		if err := insertMatchEventWithQuerier(ctx, querier, snowflakeNode, matchID, event); err != nil {
+			return 0, err
+		}
+	}
+
+	return matchID, nil
+}
+
+const insertMatchEvent = `-- name: InsertMatchEvent :exec
+INSERT INTO match_events (id, match_id, minute, event_type, team_id, player_id, related_player_id) VALUES ($1, $2, $3, $4, $5, $6, $7)
+`
+
+type InsertMatchEventParams struct {
+	Minute          int32  `json:"minute"`
+	EventType       string `json:"event_type"`
+	TeamID          int64  `json:"team_id"`
+	PlayerID        int64  `json:"player_id"`
+	RelatedPlayerID int64  `json:"related_player_id"`
+}
+
+func insertMatchEventWithQuerier(
+	ctx context.Context,
+	querier postgres.Querier,
+	snowflakeNode *snowflake.Node,
+	matchID int64,
+	arg InsertMatchEventParams,
+) error {
+	pId := pgtype.Int8{Int64: arg.PlayerID, Valid: arg.PlayerID != 0}
+	rpId := pgtype.Int8{Int64: arg.RelatedPlayerID, Valid: arg.RelatedPlayerID != 0}
+
+	_, err := querier.Exec(ctx, insertMatchEvent,
+		snowflakeNode.Generate().Int64(),
+		matchID,
+		arg.Minute,
+		arg.EventType,
+		arg.TeamID,
+		pId,
+		rpId,
+	)
+	return err
+}
+
+const getMatchByID = `-- name: GetMatchByID :one
+SELECT id, home_team_id, away_team_id, seed, home_score, away_score, played_at FROM matches WHERE id = $1
+`
+
+func (r *pgMatchRepository) GetMatchByID(ctx context.Context, id int64) (Match, error) {
+	row := r.db.QueryRow(ctx, getMatchByID, id)
+	var i Match
+	err := row.Scan(
+		&i.ID,
+		&i.HomeTeamID,
+		&i.AwayTeamID,
+		&i.Seed,
+		&i.HomeScore,
+		&i.AwayScore,
+		&i.PlayedAt,
+	)
+	return i, err
+}
+
+const listMatchesCursor = `-- name: ListMatchesCursor :many
+SELECT id, home_team_id, away_team_id, seed, home_score, away_score, played_at FROM matches WHERE id > $1 ORDER BY id LIMIT $2
+`
+
+type ListMatchesCursorParams struct {
+	ID    int64 `json:"id"`
+	Limit int32 `json:"limit"`
+}
+
+func (r *pgMatchRepository) ListMatchesCursor(
+	ctx context.Context,
+	arg ListMatchesCursorParams,
+) ([]Match, error) {
+	rows, err := r.db.Query(ctx, listMatchesCursor, arg.ID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanMatches(rows)
+}
+
+const listMatchesByTeamID = `-- name: ListMatchesByTeamID :many
+SELECT id, home_team_id, away_team_id, seed, home_score, away_score, played_at FROM matches WHERE (home_team_id = $1 OR away_team_id = $1) AND id > $2 ORDER BY id LIMIT $3
+`
+
+type ListMatchesByTeamIDParams struct {
+	TeamID int64 `json:"team_id"`
+	ID     int64 `json:"id"`
+	Limit  int32 `json:"limit"`
+}
+
+func (r *pgMatchRepository) ListMatchesByTeamID(
+	ctx context.Context,
+	arg ListMatchesByTeamIDParams,
+) ([]Match, error) {
+	rows, err := r.db.Query(ctx, listMatchesByTeamID, arg.TeamID, arg.ID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanMatches(rows)
+}
+
+func scanMatches(rows pgx.Rows) ([]Match, error) {
+	defer rows.Close()
+	items := []Match{}
+	for rows.Next() {
+		var i Match
+		if err := rows.Scan(
+			&i.ID,
+			&i.HomeTeamID,
+			&i.AwayTeamID,
+			&i.Seed,
+			&i.HomeScore,
+			&i.AwayScore,
+			&i.PlayedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMatchEventsByMatchID = `-- name: ListMatchEventsByMatchID :many
+SELECT id, match_id, minute, event_type, team_id, player_id, related_player_id FROM match_events WHERE match_id = $1 ORDER BY minute, id
+`
+
+func (r *pgMatchRepository) ListMatchEventsByMatchID(
+	ctx context.Context,
+	matchID int64,
+) ([]MatchEvent, error) {
+	rows, err := r.db.Query(ctx, listMatchEventsByMatchID, matchID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []MatchEvent{}
+	for rows.Next() {
+		var i MatchEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.MatchID,
+			&i.Minute,
+			&i.EventType,
+			&i.TeamID,
+			&i.PlayerID,
+			&i.RelatedPlayerID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
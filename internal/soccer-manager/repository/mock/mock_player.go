@@ -70,18 +70,18 @@ func (mr *MockPlayerRepositoryMockRecorder) InsertPlayer(ctx, arg any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertPlayer", reflect.TypeOf((*MockPlayerRepository)(nil).InsertPlayer), ctx, arg)
 }
 
-// InsertPlayersBatch mocks base method.
-func (m *MockPlayerRepository) InsertPlayersBatch(ctx context.Context, args []repository.InsertPlayerParams) error {
+// InsertRoster mocks base method.
+func (m *MockPlayerRepository) InsertRoster(ctx context.Context, teamID int64, args []repository.InsertPlayerParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "InsertPlayersBatch", ctx, args)
+	ret := m.ctrl.Call(m, "InsertRoster", ctx, teamID, args)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// InsertPlayersBatch indicates an expected call of InsertPlayersBatch.
-func (mr *MockPlayerRepositoryMockRecorder) InsertPlayersBatch(ctx, args any) *gomock.Call {
+// InsertRoster indicates an expected call of InsertRoster.
+func (mr *MockPlayerRepositoryMockRecorder) InsertRoster(ctx, teamID, args any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertPlayersBatch", reflect.TypeOf((*MockPlayerRepository)(nil).InsertPlayersBatch), ctx, args)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertRoster", reflect.TypeOf((*MockPlayerRepository)(nil).InsertRoster), ctx, teamID, args)
 }
 
 // ListPlayersByCursor mocks base method.
@@ -42,11 +42,12 @@ func (m *MockTransferRepository) EXPECT() *MockTransferRepositoryMockRecorder {
 }
 
 // BuyPlayer mocks base method.
-func (m *MockTransferRepository) BuyPlayer(ctx context.Context, transferId, buyerTeamId int64) error {
+func (m *MockTransferRepository) BuyPlayer(ctx context.Context, transferId, buyerTeamId int64) (repository.TransferRecord, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "BuyPlayer", ctx, transferId, buyerTeamId)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(repository.TransferRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // BuyPlayer indicates an expected call of BuyPlayer.
@@ -69,6 +70,21 @@ func (mr *MockTransferRepositoryMockRecorder) DeleteTransferByIDAndUserID(ctx, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTransferByIDAndUserID", reflect.TypeOf((*MockTransferRepository)(nil).DeleteTransferByIDAndUserID), ctx, arg)
 }
 
+// ExpireTransfer mocks base method.
+func (m *MockTransferRepository) ExpireTransfer(ctx context.Context, id int64) (repository.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireTransfer", ctx, id)
+	ret0, _ := ret[0].(repository.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireTransfer indicates an expected call of ExpireTransfer.
+func (mr *MockTransferRepositoryMockRecorder) ExpireTransfer(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireTransfer", reflect.TypeOf((*MockTransferRepository)(nil).ExpireTransfer), ctx, id)
+}
+
 // GetTransferByPlayerID mocks base method.
 func (m *MockTransferRepository) GetTransferByPlayerID(ctx context.Context, playerID int64) (repository.Transfer, error) {
 	m.ctrl.T.Helper()
@@ -99,6 +115,21 @@ func (mr *MockTransferRepositoryMockRecorder) InsertTransferRecordByUser(ctx, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertTransferRecordByUser", reflect.TypeOf((*MockTransferRepository)(nil).InsertTransferRecordByUser), ctx, arg)
 }
 
+// ListExpiredTransferIDs mocks base method.
+func (m *MockTransferRepository) ListExpiredTransferIDs(ctx context.Context, limit int32) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExpiredTransferIDs", ctx, limit)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExpiredTransferIDs indicates an expected call of ListExpiredTransferIDs.
+func (mr *MockTransferRepositoryMockRecorder) ListExpiredTransferIDs(ctx, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExpiredTransferIDs", reflect.TypeOf((*MockTransferRepository)(nil).ListExpiredTransferIDs), ctx, limit)
+}
+
 // ListTransfers mocks base method.
 func (m *MockTransferRepository) ListTransfers(ctx context.Context, arg repository.ListTransfersParams) ([]repository.Transfer, error) {
 	m.ctrl.T.Helper()
@@ -129,6 +160,20 @@ func (mr *MockTransferRepositoryMockRecorder) ListTransfersByTeamId(ctx, arg any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransfersByTeamId", reflect.TypeOf((*MockTransferRepository)(nil).ListTransfersByTeamId), ctx, arg)
 }
 
+// RenewTransferByIDAndUserID mocks base method.
+func (m *MockTransferRepository) RenewTransferByIDAndUserID(ctx context.Context, arg repository.RenewTransferByIDAndUserIDParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenewTransferByIDAndUserID", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenewTransferByIDAndUserID indicates an expected call of RenewTransferByIDAndUserID.
+func (mr *MockTransferRepositoryMockRecorder) RenewTransferByIDAndUserID(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenewTransferByIDAndUserID", reflect.TypeOf((*MockTransferRepository)(nil).RenewTransferByIDAndUserID), ctx, arg)
+}
+
 // SelectTransferById mocks base method.
 func (m *MockTransferRepository) SelectTransferById(ctx context.Context, id int64) (repository.Transfer, error) {
 	m.ctrl.T.Helper()
@@ -160,6 +160,21 @@ func (mr *MockUserRepositoryMockRecorder) ListUsersCursor(ctx, arg any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsersCursor", reflect.TypeOf((*MockUserRepository)(nil).ListUsersCursor), ctx, arg)
 }
 
+// PromoteFirstAdmin mocks base method.
+func (m *MockUserRepository) PromoteFirstAdmin(ctx context.Context, username string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PromoteFirstAdmin", ctx, username)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PromoteFirstAdmin indicates an expected call of PromoteFirstAdmin.
+func (mr *MockUserRepositoryMockRecorder) PromoteFirstAdmin(ctx, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PromoteFirstAdmin", reflect.TypeOf((*MockUserRepository)(nil).PromoteFirstAdmin), ctx, username)
+}
+
 // UpdateUserHash mocks base method.
 func (m *MockUserRepository) UpdateUserHash(ctx context.Context, arg repository.UpdateUserHashParams) error {
 	m.ctrl.T.Helper()
@@ -173,3 +188,18 @@ func (mr *MockUserRepositoryMockRecorder) UpdateUserHash(ctx, arg any) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserHash", reflect.TypeOf((*MockUserRepository)(nil).UpdateUserHash), ctx, arg)
 }
+
+// UpdateUserRole mocks base method.
+func (m *MockUserRepository) UpdateUserRole(ctx context.Context, arg repository.UpdateUserRoleParams) (repository.GetUserByIDRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserRole", ctx, arg)
+	ret0, _ := ret[0].(repository.GetUserByIDRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserRole indicates an expected call of UpdateUserRole.
+func (mr *MockUserRepositoryMockRecorder) UpdateUserRole(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserRole", reflect.TypeOf((*MockUserRepository)(nil).UpdateUserRole), ctx, arg)
+}
@@ -66,16 +66,97 @@ type (
 		Age          int32
 		PositionCode string
 		Price        int64
+		Pace         int32
+		Shooting     int32
+		Passing      int32
+		Defending    int32
+		Goalkeeping  int32
+		Stamina      int32
+	}
+)
+
+type (
+	PlayerPriceHistory struct {
+		ID         int64
+		PlayerID   int64
+		Price      int64
+		Reason     string
+		RecordedAt pgtype.Timestamptz
+	}
+
+	// PlayerPricePoint is a point of a price chart, a single record has equal prices
+	PlayerPricePoint struct {
+		At        pgtype.Timestamptz
+		Price     int64
+		LowPrice  int64
+		HighPrice int64
 	}
 )
 
 type (
 	Transfer struct {
+		ID            int64
+		PlayerID      int64
+		SellerTeamID  int64
+		Price         int64
+		ListedAt      pgtype.Timestamptz
+		AuctionEndsAt pgtype.Timestamptz
+		ReservePrice  pgtype.Int8
+		ExpiresAt     pgtype.Timestamptz
+	}
+)
+
+type (
+	TransferWindow struct {
+		ID        int64
+		Name      string
+		OpensAt   pgtype.Timestamptz
+		ClosesAt  pgtype.Timestamptz
+		CreatedAt pgtype.Timestamptz
+	}
+)
+
+type (
+	Bid struct {
+		ID            int64
+		TransferID    pgtype.Int8
+		PlayerID      int64
+		SellerTeamID  int64
+		BidderTeamID  int64
+		Amount        int64
+		CounterAmount pgtype.Int8
+		Status        string
+		CreatedAt     pgtype.Timestamptz
+		UpdatedAt     pgtype.Timestamptz
+	}
+)
+
+type (
+	Offer struct {
 		ID           int64
 		PlayerID     int64
 		SellerTeamID int64
-		Price        int64
-		ListedAt     pgtype.Timestamptz
+		BuyerTeamID  int64
+		Amount       int64
+		Status       string
+		CreatedAt    pgtype.Timestamptz
+		UpdatedAt    pgtype.Timestamptz
+	}
+)
+
+type (
+	AuctionResult struct {
+		ID           int64
+		TransferID   int64
+		PlayerID     int64
+		SellerTeamID int64
+		WinningBidID pgtype.Int8
+		BuyerTeamID  pgtype.Int8
+		SoldPrice    pgtype.Int8
+		ReservePrice int64
+		Outcome      string
+		EndedAt      pgtype.Timestamptz
+		SettledAt    pgtype.Timestamptz
 	}
 )
 
@@ -86,7 +167,136 @@ type (
 		SellerTeamID int64
 		BuyerTeamID  int64
 		SoldPrice    int64
+		Tax          int64
 		ListedAt     pgtype.Timestamptz
 		SoldAt       pgtype.Timestamptz
 	}
 )
+
+// Market analytics
+type (
+	MarketDayVolume struct {
+		Day    pgtype.Timestamptz
+		Sales  int64
+		Volume int64
+	}
+
+	MarketPositionPrice struct {
+		PositionCode string
+		Sales        int64
+		AveragePrice int64
+	}
+
+	MarketTeamTotal struct {
+		TeamID    int64
+		Transfers int64
+		Total     int64
+	}
+)
+
+type (
+	// WatchlistPlayer is a watched player with its live listing, if any
+	WatchlistPlayer struct {
+		PlayerID      int64
+		TeamID        pgtype.Int8
+		FirstName     string
+		LastName      string
+		Age           int32
+		PositionCode  string
+		Price         int64
+		TransferID    pgtype.Int8
+		TransferPrice pgtype.Int8
+		WatchedAt     pgtype.Timestamptz
+	}
+)
+
+type (
+	Notification struct {
+		ID        int64
+		UserID    int64
+		Kind      string
+		Payload   []byte
+		ReadAt    pgtype.Timestamptz
+		CreatedAt pgtype.Timestamptz
+	}
+)
+
+type (
+	OutboxEvent struct {
+		ID          int64
+		Topic       string
+		Payload     []byte
+		Attempts    int32
+		DeliveredTo []string
+		LastError   pgtype.Text
+		AvailableAt pgtype.Timestamptz
+		DeadAt      pgtype.Timestamptz
+		CreatedAt   pgtype.Timestamptz
+	}
+)
+
+type (
+	Match struct {
+		ID         int64
+		HomeTeamID int64
+		AwayTeamID int64
+		Seed       int64
+		HomeScore  int32
+		AwayScore  int32
+		PlayedAt   pgtype.Timestamptz
+	}
+
+	MatchEvent struct {
+		ID              int64
+		MatchID         int64
+		Minute          int32
+		EventType       string
+		TeamID          int64
+		PlayerID        pgtype.Int8
+		RelatedPlayerID pgtype.Int8
+	}
+)
+
+type (
+	League struct {
+		ID        int64
+		Name      string
+		Status    string
+		CreatedAt pgtype.Timestamptz
+	}
+
+	Fixture struct {
+		ID         int64
+		LeagueID   int64
+		Round      int32
+		HomeTeamID int64
+		AwayTeamID int64
+		MatchID    pgtype.Int8
+		HomeScore  pgtype.Int4
+		AwayScore  pgtype.Int4
+		PlayedAt   pgtype.Timestamptz
+	}
+)
+
+type UserProvisioning struct {
+	UserID    int64
+	Status    string
+	TeamID    pgtype.Int8
+	Attempts  int32
+	LastError pgtype.Text
+	CreatedAt pgtype.Timestamptz
+	UpdatedAt pgtype.Timestamptz
+}
+
+type Session struct {
+	ID            int64
+	UserID        int64
+	TokenID       int64
+	UserAgent     string
+	IP            string
+	CreatedAt     pgtype.Timestamptz
+	LastUsedAt    pgtype.Timestamptz
+	ExpiresAt     pgtype.Timestamptz
+	RevokedAt     pgtype.Timestamptz
+	RevokedReason pgtype.Text
+}
@@ -0,0 +1,218 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/bwmarrin/snowflake"
+	"github.com/hexley21/soccer-manager/pkg/infra/postgres"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+//go:generate mockgen -destination=mock/mock_notification.go -package=mock github.com/hexley21/soccer-manager/internal/soccer-manager/repository NotificationRepository
+type NotificationRepository interface {
+	ListNotificationsByUserID(ctx context.Context, arg ListNotificationsByUserIDParams) ([]Notification, error)
+	CountUnreadNotificationsByUserID(ctx context.Context, userID int64) (int64, error)
+
+	InsertNotification(ctx context.Context, arg InsertNotificationParams) (Notification, error)
+	InsertTeamNotification(ctx context.Context, arg InsertTeamNotificationParams) (Notification, error)
+	MarkNotificationRead(ctx context.Context, arg MarkNotificationReadParams) error
+	MarkAllNotificationsRead(ctx context.Context, userID int64) (int64, error)
+}
+
+type pgNotificationRepository struct {
+	db            *pgxpool.Pool
+	snowflakeNode *snowflake.Node
+}
+
+func NewNotificationRepository(db *pgxpool.Pool, snowflakeNode *snowflake.Node) *pgNotificationRepository {
+	return &pgNotificationRepository{
+		db:            db,
+		snowflakeNode: snowflakeNode,
+	}
+}
+
+const listNotificationsByUserID = `-- name: ListNotificationsByUserID :many
+SELECT id, user_id, kind, payload, read_at, created_at FROM notifications
+WHERE user_id = $1
+  AND ($2::BIGINT = 0 OR id < $2)
+  AND (NOT $3::BOOLEAN OR read_at IS NULL)
+ORDER BY id DESC
+LIMIT $4
+`
+
+// ListNotificationsByUserIDParams pages newest first, ID is the last id of the previous page
+type ListNotificationsByUserIDParams struct {
+	UserID     int64 `json:"user_id"`
+	ID         int64 `json:"id"`
+	UnreadOnly bool  `json:"unread_only"`
+	Limit      int32 `json:"limit"`
+}
+
+func (r *pgNotificationRepository) ListNotificationsByUserID(
+	ctx context.Context,
+	arg ListNotificationsByUserIDParams,
+) ([]Notification, error) {
+	rows, err := r.db.Query(ctx, listNotificationsByUserID, arg.UserID, arg.ID, arg.UnreadOnly, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Notification{}
+	for rows.Next() {
+		var i Notification
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Kind,
+			&i.Payload,
+			&i.ReadAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const countUnreadNotificationsByUserID = `-- name: CountUnreadNotificationsByUserID :one
+SELECT count(*) FROM notifications WHERE user_id = $1 AND read_at IS NULL
+`
+
+func (r *pgNotificationRepository) CountUnreadNotificationsByUserID(ctx context.Context, userID int64) (int64, error) {
+	var count int64
+	err := r.db.QueryRow(ctx, countUnreadNotificationsByUserID, userID).Scan(&count)
+	return count, err
+}
+
+const insertNotification = `-- name: InsertNotification :one
+INSERT INTO notifications (id, user_id, kind, payload) VALUES ($1, $2, $3, $4)
+RETURNING id, user_id, kind, payload, read_at, created_at
+`
+
+type InsertNotificationParams struct {
+	UserID  int64  `json:"user_id"`
+	Kind    string `json:"kind"`
+	Payload []byte `json:"payload"`
+}
+
+func (r *pgNotificationRepository) InsertNotification(
+	ctx context.Context,
+	arg InsertNotificationParams,
+) (Notification, error) {
+	return scanNotification(r.db.QueryRow(ctx, insertNotification,
+		r.snowflakeNode.Generate().Int64(),
+		arg.UserID,
+		arg.Kind,
+		arg.Payload,
+	))
+}
+
+const insertTeamNotification = `-- name: InsertTeamNotification :one
+INSERT INTO notifications (id, user_id, kind, payload)
+SELECT $1, user_id, $3, $4 FROM teams WHERE id = $2
+RETURNING id, user_id, kind, payload, read_at, created_at
+`
+
+// InsertTeamNotificationParams addresses the owner of the team
+type InsertTeamNotificationParams struct {
+	TeamID  int64  `json:"team_id"`
+	Kind    string `json:"kind"`
+	Payload []byte `json:"payload"`
+}
+
+// InsertTeamNotification notifies the owner of the team, pgx.ErrNoRows when there's no such team
+func (r *pgNotificationRepository) InsertTeamNotification(
+	ctx context.Context,
+	arg InsertTeamNotificationParams,
+) (Notification, error) {
+	return scanNotification(r.db.QueryRow(ctx, insertTeamNotification,
+		r.snowflakeNode.Generate().Int64(),
+		arg.TeamID,
+		arg.Kind,
+		arg.Payload,
+	))
+}
+
+const markNotificationRead = `-- name: MarkNotificationRead :exec
+UPDATE notifications SET read_at = COALESCE(read_at, now()) WHERE id = $1 AND user_id = $2
+`
+
+type MarkNotificationReadParams struct {
+	ID     int64 `json:"id"`
+	UserID int64 `json:"user_id"`
+}
+
+func (r *pgNotificationRepository) MarkNotificationRead(ctx context.Context, arg MarkNotificationReadParams) error {
+	res, err := r.db.Exec(ctx, markNotificationRead, arg.ID, arg.UserID)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+const markAllNotificationsRead = `-- name: MarkAllNotificationsRead :exec
+UPDATE notifications SET read_at = now() WHERE user_id = $1 AND read_at IS NULL
+`
+
+// MarkAllNotificationsRead returns the number of notifications that were unread
+func (r *pgNotificationRepository) MarkAllNotificationsRead(ctx context.Context, userID int64) (int64, error) {
+	res, err := r.db.Exec(ctx, markAllNotificationsRead, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected(), nil
+}
+
+const insertSaleNotifications = `-- name: InsertSaleNotifications :exec
+INSERT INTO notifications (id, user_id, kind, payload)
+SELECT $1, user_id, 'PLAYER_SOLD', jsonb_build_object(
+  'player_id', $3::BIGINT, 'seller_team_id', $4::BIGINT, 'buyer_team_id', $5::BIGINT, 'price', $6::BIGINT, 'tax', $7::BIGINT
+) FROM teams WHERE id = $4
+UNION ALL
+SELECT $2, user_id, 'PLAYER_BOUGHT', jsonb_build_object(
+  'player_id', $3::BIGINT, 'seller_team_id', $4::BIGINT, 'buyer_team_id', $5::BIGINT, 'price', $6::BIGINT, 'tax', $7::BIGINT
+) FROM teams WHERE id = $5
+`
+
+// insertSaleNotificationsWithQuerier tells the seller and the buyer about the sale,
+// it must run in the same transaction as the sale
+func insertSaleNotificationsWithQuerier(
+	ctx context.Context,
+	querier postgres.Querier,
+	snowflakeNode *snowflake.Node,
+	arg HandOverPlayerParams,
+) error {
+	_, err := querier.Exec(ctx, insertSaleNotifications,
+		snowflakeNode.Generate().Int64(),
+		snowflakeNode.Generate().Int64(),
+		arg.PlayerID,
+		arg.SellerTeamID,
+		arg.BuyerTeamID,
+		arg.Price,
+		arg.Tax,
+	)
+	return err
+}
+
+func scanNotification(row pgx.Row) (Notification, error) {
+	var i Notification
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Kind,
+		&i.Payload,
+		&i.ReadAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,463 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bwmarrin/snowflake"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/tax"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/valuation"
+	"github.com/hexley21/soccer-manager/pkg/infra/postgres"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+//go:generate mockgen -destination=mock/mock_offer.go -package=mock github.com/hexley21/soccer-manager/internal/soccer-manager/repository OfferRepository
+type OfferRepository interface {
+	GetOfferByIDAndUserID(ctx context.Context, arg GetOfferByIDAndUserIDParams) (Offer, error)
+	ListOffersByPlayerID(ctx context.Context, arg ListOffersByPlayerIDParams) ([]Offer, error)
+	ListSentOffersByUserID(ctx context.Context, arg ListOffersByUserIDParams) ([]Offer, error)
+	ListReceivedOffersByUserID(ctx context.Context, arg ListOffersByUserIDParams) ([]Offer, error)
+
+	PlaceOffer(ctx context.Context, arg PlaceOfferParams) (Offer, error)
+	AcceptOffer(ctx context.Context, id int64, userID int64) (TransferRecord, error)
+	DeclineOffer(ctx context.Context, id int64, userID int64) error
+	WithdrawOffer(ctx context.Context, id int64, userID int64) error
+}
+
+type pgOfferRepository struct {
+	db            *pgxpool.Pool
+	snowflakeNode *snowflake.Node
+	tax           tax.Policy
+	valuator      valuation.PriceValuator
+}
+
+func NewOfferRepository(
+	db *pgxpool.Pool,
+	snowflakeNode *snowflake.Node,
+	taxPolicy tax.Policy,
+	valuator valuation.PriceValuator,
+) *pgOfferRepository {
+	return &pgOfferRepository{
+		db:            db,
+		snowflakeNode: snowflakeNode,
+		tax:           taxPolicy,
+		valuator:      valuator,
+	}
+}
+
+const getOfferByIDAndUserID = `-- name: GetOfferByIDAndUserID :one
+SELECT o.id, o.player_id, o.seller_team_id, o.buyer_team_id, o.amount, o.status, o.created_at, o.updated_at
+FROM offers o JOIN teams t ON t.id IN (o.seller_team_id, o.buyer_team_id) WHERE o.id = $1 AND t.user_id = $2
+`
+
+type GetOfferByIDAndUserIDParams struct {
+	ID     int64 `json:"id"`
+	UserID int64 `json:"user_id"`
+}
+
+// GetOfferByIDAndUserID returns the offer only if user's team is the seller or the buyer
+func (r *pgOfferRepository) GetOfferByIDAndUserID(
+	ctx context.Context,
+	arg GetOfferByIDAndUserIDParams,
+) (Offer, error) {
+	row := r.db.QueryRow(ctx, getOfferByIDAndUserID, arg.ID, arg.UserID)
+	var i Offer
+	err := row.Scan(
+		&i.ID,
+		&i.PlayerID,
+		&i.SellerTeamID,
+		&i.BuyerTeamID,
+		&i.Amount,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listOffersByPlayerID = `-- name: ListOffersByPlayerID :many
+SELECT o.id, o.player_id, o.seller_team_id, o.buyer_team_id, o.amount, o.status, o.created_at, o.updated_at
+FROM offers o JOIN teams t ON t.id = o.seller_team_id WHERE o.player_id = $1 AND t.user_id = $2 AND o.id > $3 ORDER BY o.id LIMIT $4
+`
+
+type ListOffersByPlayerIDParams struct {
+	PlayerID int64 `json:"player_id"`
+	UserID   int64 `json:"user_id"`
+	ID       int64 `json:"id"`
+	Limit    int32 `json:"limit"`
+}
+
+// ListOffersByPlayerID returns offers received for the player, only the owner can see them
+func (r *pgOfferRepository) ListOffersByPlayerID(
+	ctx context.Context,
+	arg ListOffersByPlayerIDParams,
+) ([]Offer, error) {
+	rows, err := r.db.Query(ctx, listOffersByPlayerID, arg.PlayerID, arg.UserID, arg.ID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	return scanOffers(rows)
+}
+
+const listSentOffersByUserID = `-- name: ListSentOffersByUserID :many
+SELECT o.id, o.player_id, o.seller_team_id, o.buyer_team_id, o.amount, o.status, o.created_at, o.updated_at
+FROM offers o JOIN teams t ON t.id = o.buyer_team_id WHERE t.user_id = $1 AND o.id > $2 ORDER BY o.id LIMIT $3
+`
+
+const listReceivedOffersByUserID = `-- name: ListReceivedOffersByUserID :many
+SELECT o.id, o.player_id, o.seller_team_id, o.buyer_team_id, o.amount, o.status, o.created_at, o.updated_at
+FROM offers o JOIN teams t ON t.id = o.seller_team_id WHERE t.user_id = $1 AND o.id > $2 ORDER BY o.id LIMIT $3
+`
+
+type ListOffersByUserIDParams struct {
+	UserID int64 `json:"user_id"`
+	ID     int64 `json:"id"`
+	Limit  int32 `json:"limit"`
+}
+
+// ListSentOffersByUserID returns offers made by user's team
+func (r *pgOfferRepository) ListSentOffersByUserID(
+	ctx context.Context,
+	arg ListOffersByUserIDParams,
+) ([]Offer, error) {
+	rows, err := r.db.Query(ctx, listSentOffersByUserID, arg.UserID, arg.ID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	return scanOffers(rows)
+}
+
+// ListReceivedOffersByUserID returns offers made for players of user's team
+func (r *pgOfferRepository) ListReceivedOffersByUserID(
+	ctx context.Context,
+	arg ListOffersByUserIDParams,
+) ([]Offer, error) {
+	rows, err := r.db.Query(ctx, listReceivedOffersByUserID, arg.UserID, arg.ID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	return scanOffers(rows)
+}
+
+func scanOffers(rows pgx.Rows) ([]Offer, error) {
+	defer rows.Close()
+	items := []Offer{}
+	for rows.Next() {
+		var i Offer
+		if err := rows.Scan(
+			&i.ID,
+			&i.PlayerID,
+			&i.SellerTeamID,
+			&i.BuyerTeamID,
+			&i.Amount,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getOfferByIDForUpdate = `-- name: GetOfferByIDForUpdate :one
+SELECT id, player_id, seller_team_id, buyer_team_id, amount, status, created_at, updated_at FROM offers WHERE id = $1 FOR UPDATE
+`
+
+func getOfferByIDForUpdateWithQuerier(
+	ctx context.Context,
+	querier postgres.Querier,
+	id int64,
+) (Offer, error) {
+	row := querier.QueryRow(ctx, getOfferByIDForUpdate, id)
+	var i Offer
+	err := row.Scan(
+		&i.ID,
+		&i.PlayerID,
+		&i.SellerTeamID,
+		&i.BuyerTeamID,
+		&i.Amount,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const insertOffer = `-- name: InsertOffer :one
+INSERT INTO offers (id, player_id, seller_team_id, buyer_team_id, amount) VALUES ($1, $2, $3, $4, $5)
+RETURNING id, player_id, seller_team_id, buyer_team_id, amount, status, created_at, updated_at
+`
+
+type PlaceOfferParams struct {
+	PlayerID int64 `json:"player_id"`
+	UserID   int64 `json:"user_id"`
+	Amount   int64 `json:"amount"`
+}
+
+// PlaceOffer inserts a direct offer for an unlisted player and reserves its amount from buyer's budget
+//
+// If player not found or has no team - ErrNotFound
+// If offering for own player - ErrConflict
+// If player is listed - ErrNotAllowed
+// If amount exceeds the budget - ErrViolation
+func (r *pgOfferRepository) PlaceOffer(ctx context.Context, arg PlaceOfferParams) (Offer, error) {
+	tx, err := r.db.BeginTx(
+		ctx,
+		pgx.TxOptions{IsoLevel: pgx.Serializable, AccessMode: pgx.ReadWrite},
+	)
+	if err != nil {
+		return Offer{}, err
+	}
+
+	player, err := getPlayerByIDWithQuerier(ctx, tx, arg.PlayerID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return Offer{}, postgres.Rollback(ctx, tx, ErrNotFound)
+		}
+
+		return Offer{}, postgres.Rollback(ctx, tx, err)
+	}
+	if !player.TeamID.Valid {
+		return Offer{}, postgres.Rollback(ctx, tx, ErrNotFound)
+	}
+
+	buyerTeam, err := getTeamByUserIDWithQuerier(ctx, tx, arg.UserID)
+	if err != nil {
+		return Offer{}, postgres.Rollback(ctx, tx, err)
+	}
+
+	if player.TeamID.Int64 == buyerTeam.ID {
+		return Offer{}, postgres.Rollback(ctx, tx, ErrConflict)
+	}
+
+	// listed players are bought through their listing
+	if _, err := getTransferByPlayerIDWithQuerier(ctx, tx, player.ID); err == nil {
+		return Offer{}, postgres.Rollback(ctx, tx, ErrNotAllowed)
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		return Offer{}, postgres.Rollback(ctx, tx, err)
+	}
+
+	if arg.Amount > buyerTeam.Budget {
+		return Offer{}, postgres.Rollback(ctx, tx, ErrViolation)
+	}
+
+	row := tx.QueryRow(ctx, insertOffer,
+		r.snowflakeNode.Generate().Int64(),
+		player.ID,
+		player.TeamID.Int64,
+		buyerTeam.ID,
+		arg.Amount,
+	)
+	var i Offer
+	if err := row.Scan(
+		&i.ID,
+		&i.PlayerID,
+		&i.SellerTeamID,
+		&i.BuyerTeamID,
+		&i.Amount,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	); err != nil {
+		return Offer{}, postgres.Rollback(ctx, tx, err)
+	}
+
+	// reserve funds
+	if err := addTeamBudgetWithQuerier(ctx, tx, AddTeamBudgetParams{
+		ID:     buyerTeam.ID,
+		Budget: -arg.Amount,
+	}); err != nil {
+		return Offer{}, postgres.Rollback(ctx, tx, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return Offer{}, err
+	}
+
+	return i, nil
+}
+
+const updateOfferStatus = `-- name: UpdateOfferStatus :exec
+UPDATE offers SET status = $2, updated_at = now() WHERE id = $1
+`
+
+// AcceptOffer sells the player to the buyer at the offered amount,
+// the transfer record is listed at the offer time.
+// If the player got listed meanwhile, the listing is removed and its bids are cancelled
+//
+// If offer not found or user is not the seller - ErrNotFound
+// If offer is not pending or the player changed team - ErrConflict
+func (r *pgOfferRepository) AcceptOffer(ctx context.Context, id int64, userID int64) (TransferRecord, error) {
+	tx, err := r.db.BeginTx(
+		ctx,
+		pgx.TxOptions{IsoLevel: pgx.Serializable, AccessMode: pgx.ReadWrite},
+	)
+	if err != nil {
+		return TransferRecord{}, err
+	}
+
+	// 0. validation
+	offer, err := pendingOfferOfPartyWithQuerier(ctx, tx, id, userID, func(o Offer) int64 { return o.SellerTeamID })
+	if err != nil {
+		return TransferRecord{}, postgres.Rollback(ctx, tx, err)
+	}
+
+	player, err := getPlayerByIDWithQuerier(ctx, tx, offer.PlayerID)
+	if err != nil {
+		return TransferRecord{}, postgres.Rollback(ctx, tx, err)
+	}
+	if player.TeamID.Int64 != offer.SellerTeamID {
+		return TransferRecord{}, postgres.Rollback(ctx, tx, ErrConflict)
+	}
+
+	// 1. remove the listing if any
+	transfer, err := getTransferByPlayerIDWithQuerier(ctx, tx, player.ID)
+	switch {
+	case err == nil:
+		if err := releaseBidsByTransferIDWithQuerier(ctx, tx, transfer.ID, 0); err != nil {
+			return TransferRecord{}, postgres.Rollback(ctx, tx, err)
+		}
+		if err := deleteTransferByIDWithQuerier(ctx, tx, transfer.ID); err != nil {
+			return TransferRecord{}, postgres.Rollback(ctx, tx, err)
+		}
+	case !errors.Is(err, pgx.ErrNoRows):
+		return TransferRecord{}, postgres.Rollback(ctx, tx, err)
+	}
+
+	// 2. close the offer, funds are already reserved
+	if _, err := tx.Exec(ctx, updateOfferStatus, offer.ID, "ACCEPTED"); err != nil {
+		return TransferRecord{}, postgres.Rollback(ctx, tx, err)
+	}
+
+	// 3. hand over the player
+	record, err := handOverPlayerWithQuerier(ctx, tx, r.snowflakeNode, r.valuator, HandOverPlayerParams{
+		PlayerID:     offer.PlayerID,
+		SellerTeamID: offer.SellerTeamID,
+		BuyerTeamID:  offer.BuyerTeamID,
+		Price:        offer.Amount,
+		Tax:          r.tax.Of(offer.Amount),
+		ListedAt:     offer.CreatedAt,
+	})
+	if err != nil {
+		return TransferRecord{}, postgres.Rollback(ctx, tx, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return TransferRecord{}, err
+	}
+
+	return record, nil
+}
+
+// DeclineOffer closes a pending offer by the seller and gives back reserved funds
+//
+// If offer not found or user is not the seller - ErrNotFound
+// If offer is not pending - ErrConflict
+func (r *pgOfferRepository) DeclineOffer(ctx context.Context, id int64, userID int64) error {
+	return r.closeOffer(ctx, id, userID, "DECLINED", func(o Offer) int64 { return o.SellerTeamID })
+}
+
+// WithdrawOffer closes a pending offer by the buyer and gives back reserved funds
+//
+// If offer not found or user is not the buyer - ErrNotFound
+// If offer is not pending - ErrConflict
+func (r *pgOfferRepository) WithdrawOffer(ctx context.Context, id int64, userID int64) error {
+	return r.closeOffer(ctx, id, userID, "WITHDRAWN", func(o Offer) int64 { return o.BuyerTeamID })
+}
+
+func (r *pgOfferRepository) closeOffer(
+	ctx context.Context,
+	id int64,
+	userID int64,
+	status string,
+	party func(Offer) int64,
+) error {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return err
+	}
+
+	offer, err := pendingOfferOfPartyWithQuerier(ctx, tx, id, userID, party)
+	if err != nil {
+		return postgres.Rollback(ctx, tx, err)
+	}
+
+	if _, err := tx.Exec(ctx, updateOfferStatus, offer.ID, status); err != nil {
+		return postgres.Rollback(ctx, tx, err)
+	}
+
+	// give back reserved funds
+	if err := addTeamBudgetWithQuerier(ctx, tx, AddTeamBudgetParams{
+		ID:     offer.BuyerTeamID,
+		Budget: offer.Amount,
+	}); err != nil {
+		return postgres.Rollback(ctx, tx, err)
+	}
+
+	return tx.Commit(ctx)
+}
+
+// pendingOfferOfPartyWithQuerier locks the offer and makes sure it's pending and user's team is the expected party
+func pendingOfferOfPartyWithQuerier(
+	ctx context.Context,
+	querier postgres.Querier,
+	id int64,
+	userID int64,
+	party func(Offer) int64,
+) (Offer, error) {
+	offer, err := getOfferByIDForUpdateWithQuerier(ctx, querier, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return Offer{}, ErrNotFound
+		}
+
+		return Offer{}, err
+	}
+
+	team, err := getTeamByUserIDWithQuerier(ctx, querier, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return Offer{}, ErrNotFound
+		}
+
+		return Offer{}, err
+	}
+
+	if party(offer) != team.ID {
+		return Offer{}, ErrNotFound
+	}
+	if offer.Status != "PENDING" {
+		return Offer{}, ErrConflict
+	}
+
+	return offer, nil
+}
+
+const releaseOffersByPlayerID = `-- name: ReleaseOffersByPlayerID :exec
+WITH released AS (
+  UPDATE offers SET status = 'CANCELLED', updated_at = now()
+  WHERE player_id = $1 AND status = 'PENDING'
+  RETURNING buyer_team_id, amount
+)
+UPDATE teams SET budget = teams.budget + released.amount FROM released WHERE teams.id = released.buyer_team_id
+`
+
+// releaseOffersByPlayerIDWithQuerier cancels pending offers for the player
+// and gives back their reserved funds, must run once the player changes team
+func releaseOffersByPlayerIDWithQuerier(
+	ctx context.Context,
+	querier postgres.Querier,
+	playerID int64,
+) error {
+	_, err := querier.Exec(ctx, releaseOffersByPlayerID, playerID)
+	return err
+}
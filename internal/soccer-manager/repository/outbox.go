@@ -0,0 +1,211 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/bwmarrin/snowflake"
+	"github.com/hexley21/soccer-manager/pkg/infra/postgres"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Outbox topics, events are written in the same transaction as the change they announce
+const (
+	// OutboxTopicSignUp carries an OutboxSignUp
+	OutboxTopicSignUp = "auth.Register"
+	// OutboxTopicTransferListed carries an OutboxRef of the listing
+	OutboxTopicTransferListed = "transfer.Listed"
+	// OutboxTopicTransferPriceChanged carries an OutboxRef of the listing
+	OutboxTopicTransferPriceChanged = "transfer.PriceChanged"
+	// OutboxTopicTransferExpired carries the removed Transfer
+	OutboxTopicTransferExpired = "transfer.Expired"
+	// OutboxTopicTransferSold carries an OutboxRef of the TransferRecord
+	OutboxTopicTransferSold = "transfer.Sold"
+	// OutboxTopicAuctionSettled carries an OutboxRef of the AuctionResult
+	OutboxTopicAuctionSettled = "auction.Settled"
+)
+
+type (
+	OutboxSignUp struct {
+		UserID   int64  `json:"user_id"`
+		Username string `json:"username"`
+	}
+
+	// OutboxRef points to a row that outlives the event
+	OutboxRef struct {
+		ID int64 `json:"id"`
+	}
+)
+
+//go:generate mockgen -destination=mock/mock_outbox.go -package=mock github.com/hexley21/soccer-manager/internal/soccer-manager/repository OutboxRepository
+type OutboxRepository interface {
+	ClaimOutboxEvents(ctx context.Context, arg ClaimOutboxEventsParams) ([]OutboxEvent, error)
+	DeleteOutboxEvent(ctx context.Context, id int64) error
+	FailOutboxEvent(ctx context.Context, arg FailOutboxEventParams) error
+
+	ListDeadOutboxEvents(ctx context.Context, arg ListDeadOutboxEventsParams) ([]OutboxEvent, error)
+	RequeueOutboxEvent(ctx context.Context, id int64) error
+}
+
+type pgOutboxRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewOutboxRepository(db *pgxpool.Pool) *pgOutboxRepository {
+	return &pgOutboxRepository{db: db}
+}
+
+const insertOutboxEvent = `-- name: InsertOutboxEvent :exec
+INSERT INTO outbox_events (id, topic, payload) VALUES ($1, $2, $3)
+`
+
+// insertOutboxEventWithQuerier must run in the transaction of the change the event announces,
+// payload is marshalled to JSON
+func insertOutboxEventWithQuerier(
+	ctx context.Context,
+	querier postgres.Querier,
+	snowflakeNode *snowflake.Node,
+	topic string,
+	payload any,
+) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = querier.Exec(ctx, insertOutboxEvent, snowflakeNode.Generate().Int64(), topic, data)
+	return err
+}
+
+const claimOutboxEvents = `-- name: ClaimOutboxEvents :many
+UPDATE outbox_events SET attempts = attempts + 1, available_at = $2
+WHERE id IN (
+  SELECT id FROM outbox_events
+  WHERE dead_at IS NULL AND available_at <= now()
+  ORDER BY id LIMIT $1
+  FOR UPDATE SKIP LOCKED
+)
+RETURNING id, topic, payload, attempts, delivered_to, last_error, available_at, dead_at, created_at
+`
+
+// ClaimOutboxEventsParams leases due events until LeaseUntil,
+// an event that isn't completed or failed by then is claimed again
+type ClaimOutboxEventsParams struct {
+	Limit      int32              `json:"limit"`
+	LeaseUntil pgtype.Timestamptz `json:"lease_until"`
+}
+
+// ClaimOutboxEvents counts an attempt for each claimed event, concurrent claimers never get the same event
+func (r *pgOutboxRepository) ClaimOutboxEvents(
+	ctx context.Context,
+	arg ClaimOutboxEventsParams,
+) ([]OutboxEvent, error) {
+	rows, err := r.db.Query(ctx, claimOutboxEvents, arg.Limit, arg.LeaseUntil)
+	if err != nil {
+		return nil, err
+	}
+	return scanOutboxEvents(rows)
+}
+
+const deleteOutboxEvent = `-- name: DeleteOutboxEvent :exec
+DELETE FROM outbox_events WHERE id = $1
+`
+
+// DeleteOutboxEvent removes a delivered event
+func (r *pgOutboxRepository) DeleteOutboxEvent(ctx context.Context, id int64) error {
+	_, err := r.db.Exec(ctx, deleteOutboxEvent, id)
+	return err
+}
+
+const failOutboxEvent = `-- name: FailOutboxEvent :exec
+UPDATE outbox_events SET delivered_to = $2, last_error = $3, available_at = $4, dead_at = $5 WHERE id = $1
+`
+
+// FailOutboxEventParams schedules the next attempt at AvailableAt, or dead-letters the event when DeadAt is set
+type FailOutboxEventParams struct {
+	ID          int64              `json:"id"`
+	DeliveredTo []string           `json:"delivered_to"`
+	LastError   pgtype.Text        `json:"last_error"`
+	AvailableAt pgtype.Timestamptz `json:"available_at"`
+	DeadAt      pgtype.Timestamptz `json:"dead_at"`
+}
+
+func (r *pgOutboxRepository) FailOutboxEvent(ctx context.Context, arg FailOutboxEventParams) error {
+	_, err := r.db.Exec(ctx, failOutboxEvent,
+		arg.ID,
+		arg.DeliveredTo,
+		arg.LastError,
+		arg.AvailableAt,
+		arg.DeadAt,
+	)
+	return err
+}
+
+const listDeadOutboxEvents = `-- name: ListDeadOutboxEvents :many
+SELECT id, topic, payload, attempts, delivered_to, last_error, available_at, dead_at, created_at FROM outbox_events
+WHERE dead_at IS NOT NULL AND id > $1 ORDER BY id LIMIT $2
+`
+
+type ListDeadOutboxEventsParams struct {
+	ID    int64 `json:"id"`
+	Limit int32 `json:"limit"`
+}
+
+func (r *pgOutboxRepository) ListDeadOutboxEvents(
+	ctx context.Context,
+	arg ListDeadOutboxEventsParams,
+) ([]OutboxEvent, error) {
+	rows, err := r.db.Query(ctx, listDeadOutboxEvents, arg.ID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	return scanOutboxEvents(rows)
+}
+
+const requeueOutboxEvent = `-- name: RequeueOutboxEvent :exec
+UPDATE outbox_events SET attempts = 0, available_at = now(), dead_at = NULL WHERE id = $1 AND dead_at IS NOT NULL
+`
+
+// RequeueOutboxEvent gives a dead event a fresh set of attempts,
+// handlers that already got it are still skipped
+//
+// If not found or not dead - ErrNotFound
+func (r *pgOutboxRepository) RequeueOutboxEvent(ctx context.Context, id int64) error {
+	res, err := r.db.Exec(ctx, requeueOutboxEvent, id)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func scanOutboxEvents(rows pgx.Rows) ([]OutboxEvent, error) {
+	defer rows.Close()
+	items := []OutboxEvent{}
+	for rows.Next() {
+		var i OutboxEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.Topic,
+			&i.Payload,
+			&i.Attempts,
+			&i.DeliveredTo,
+			&i.LastError,
+			&i.AvailableAt,
+			&i.DeadAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"errors"
 
 	"github.com/bwmarrin/snowflake"
 	"github.com/hexley21/soccer-manager/pkg/infra/postgres"
@@ -19,7 +20,7 @@ type PlayerRepository interface {
 	UpdatePlayerNameAndCountry(ctx context.Context, arg UpdatePlayerNameAndCountryParams) error
 	UpdatePlayerPriceAndTeam(ctx context.Context, arg UpdatePlayerPriceAndTeamParams) error
 	InsertPlayer(ctx context.Context, arg InsertPlayerParams) error
-	InsertPlayersBatch(ctx context.Context, args []InsertPlayerParams) error
+	InsertRoster(ctx context.Context, teamID int64, args []InsertPlayerParams) error
 }
 
 type pgPlayerRepository struct {
@@ -35,7 +36,7 @@ func NewPlayerRepository(db *pgxpool.Pool, snowflakeNode *snowflake.Node) *pgPla
 }
 
 const getPlayerByID = `-- name: GetPlayerByID :one
-SELECT id, team_id, country_code, first_name, last_name, age, position_code, price FROM players WHERE id = $1
+SELECT id, team_id, country_code, first_name, last_name, age, position_code, price, pace, shooting, passing, defending, goalkeeping, stamina FROM players WHERE id = $1
 `
 
 func (r *pgPlayerRepository) GetPlayerByID(ctx context.Context, id int64) (Player, error) {
@@ -58,12 +59,18 @@ func getPlayerByIDWithQuerier(
 		&i.Age,
 		&i.PositionCode,
 		&i.Price,
+		&i.Pace,
+		&i.Shooting,
+		&i.Passing,
+		&i.Defending,
+		&i.Goalkeeping,
+		&i.Stamina,
 	)
 	return i, err
 }
 
 const listPlayersByCursor = `-- name: ListPlayersByCursor :many
-SELECT id, team_id, country_code, first_name, last_name, age, position_code, price FROM players WHERE id > $1 ORDER BY id LIMIT $2
+SELECT id, team_id, country_code, first_name, last_name, age, position_code, price, pace, shooting, passing, defending, goalkeeping, stamina FROM players WHERE id > $1 ORDER BY id LIMIT $2
 `
 
 type ListPlayersByCursorParams struct {
@@ -92,6 +99,12 @@ func (r *pgPlayerRepository) ListPlayersByCursor(
 			&i.Age,
 			&i.PositionCode,
 			&i.Price,
+			&i.Pace,
+			&i.Shooting,
+			&i.Passing,
+			&i.Defending,
+			&i.Goalkeeping,
+			&i.Stamina,
 		); err != nil {
 			return nil, err
 		}
@@ -104,7 +117,7 @@ func (r *pgPlayerRepository) ListPlayersByCursor(
 }
 
 const listPlayersByTeamID = `-- name: ListPlayersByTeamID :many
-SELECT p.id, p.team_id, p.country_code, p.first_name, p.last_name, p.age, p.position_code, p.price FROM players p WHERE p.team_id = $1 AND p.id > $2 ORDER BY p.id LIMIT $3
+SELECT p.id, p.team_id, p.country_code, p.first_name, p.last_name, p.age, p.position_code, p.price, p.pace, p.shooting, p.passing, p.defending, p.goalkeeping, p.stamina FROM players p WHERE p.team_id = $1 AND p.id > $2 ORDER BY p.id LIMIT $3
 `
 
 type ListPlayersByTeamIDParams struct {
@@ -139,6 +152,12 @@ func (r *pgPlayerRepository) ListPlayersByTeamID(
 			&i.Age,
 			&i.PositionCode,
 			&i.Price,
+			&i.Pace,
+			&i.Shooting,
+			&i.Passing,
+			&i.Defending,
+			&i.Goalkeeping,
+			&i.Stamina,
 		); err != nil {
 			return nil, err
 		}
@@ -151,7 +170,7 @@ func (r *pgPlayerRepository) ListPlayersByTeamID(
 }
 
 const listPlayersByUserID = `-- name: ListPlayersByUserID :many
-SELECT p.id, p.team_id, p.country_code, p.first_name, p.last_name, p.age, p.position_code, p.price FROM players p JOIN teams t ON p.team_id = t.id WHERE t.user_id = $1 AND p.id > $2 ORDER BY p.id LIMIT $3
+SELECT p.id, p.team_id, p.country_code, p.first_name, p.last_name, p.age, p.position_code, p.price, p.pace, p.shooting, p.passing, p.defending, p.goalkeeping, p.stamina FROM players p JOIN teams t ON p.team_id = t.id WHERE t.user_id = $1 AND p.id > $2 ORDER BY p.id LIMIT $3
 `
 
 type ListPlayersByUserIDParams struct {
@@ -181,6 +200,12 @@ func (r *pgPlayerRepository) ListPlayersByUserID(
 			&i.Age,
 			&i.PositionCode,
 			&i.Price,
+			&i.Pace,
+			&i.Shooting,
+			&i.Passing,
+			&i.Defending,
+			&i.Goalkeeping,
+			&i.Stamina,
 		); err != nil {
 			return nil, err
 		}
@@ -239,11 +264,33 @@ type UpdatePlayerPriceAndTeamParams struct {
 	TeamID int64 `json:"team_id"`
 }
 
+// UpdatePlayerPriceAndTeam moves the player and records the new price in the price history
 func (r *pgPlayerRepository) UpdatePlayerPriceAndTeam(
 	ctx context.Context,
 	arg UpdatePlayerPriceAndTeamParams,
 ) error {
-	return updatePlayerPriceAndTeamWithQuerrier(ctx, r.db, arg)
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := updatePlayerPriceAndTeamWithQuerrier(ctx, tx, arg); err != nil {
+		return postgres.Rollback(ctx, tx, err)
+	}
+
+	if err := insertPlayerPriceHistoryWithQuerier(ctx, tx, InsertPlayerPriceHistoryParams{
+		ID:       r.snowflakeNode.Generate().Int64(),
+		PlayerID: arg.ID,
+		Price:    arg.Price,
+		Reason:   "SALE",
+	}); err != nil {
+		return postgres.Rollback(ctx, tx, err)
+	}
+
+	return tx.Commit(ctx)
 }
 
 func updatePlayerPriceAndTeamWithQuerrier(
@@ -269,7 +316,7 @@ func updatePlayerPriceAndTeamWithQuerrier(
 }
 
 const insertPlayer = `-- name: InsertPlayer :exec
-INSERT INTO players (id, team_id, country_code, first_name, last_name, age, position_code, price) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+INSERT INTO players (id, team_id, country_code, first_name, last_name, age, position_code, price, pace, shooting, passing, defending, goalkeeping, stamina) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
 `
 
 type InsertPlayerParams struct {
@@ -280,6 +327,12 @@ type InsertPlayerParams struct {
 	Age          int32  `json:"age"`
 	PositionCode string `json:"position_code"`
 	Price        int64  `json:"price"`
+	Pace         int32  `json:"pace"`
+	Shooting     int32  `json:"shooting"`
+	Passing      int32  `json:"passing"`
+	Defending    int32  `json:"defending"`
+	Goalkeeping  int32  `json:"goalkeeping"`
+	Stamina      int32  `json:"stamina"`
 }
 
 func (r *pgPlayerRepository) insertPlayerWithQuerier(
@@ -296,8 +349,9 @@ func (r *pgPlayerRepository) insertPlayerWithQuerier(
 		return err
 	}
 
-	_, err := querier.Exec(ctx, insertPlayer,
-		r.snowflakeNode.Generate().Int64(),
+	id := r.snowflakeNode.Generate().Int64()
+	if _, err := querier.Exec(ctx, insertPlayer,
+		id,
 		*tId,
 		*cc,
 		arg.FirstName,
@@ -305,16 +359,57 @@ func (r *pgPlayerRepository) insertPlayerWithQuerier(
 		arg.Age,
 		arg.PositionCode,
 		arg.Price,
-	)
-	return err
+		arg.Pace,
+		arg.Shooting,
+		arg.Passing,
+		arg.Defending,
+		arg.Goalkeeping,
+		arg.Stamina,
+	); err != nil {
+		return err
+	}
+
+	return insertPlayerPriceHistoryWithQuerier(ctx, querier, InsertPlayerPriceHistoryParams{
+		ID:       r.snowflakeNode.Generate().Int64(),
+		PlayerID: id,
+		Price:    arg.Price,
+		Reason:   "GENERATED",
+	})
 }
 
+// InsertPlayer creates the player along with the first point of its price history
 func (r *pgPlayerRepository) InsertPlayer(ctx context.Context, arg InsertPlayerParams) error {
-	return r.insertPlayerWithQuerier(ctx, r.db, arg)
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := r.insertPlayerWithQuerier(ctx, tx, arg); err != nil {
+		return postgres.Rollback(ctx, tx, err)
+	}
+
+	return tx.Commit(ctx)
 }
 
-func (r *pgPlayerRepository) InsertPlayersBatch(
+const lockTeamByID = `-- name: LockTeamByID :one
+SELECT id FROM teams WHERE id = $1 FOR UPDATE
+`
+
+const teamHasPlayers = `-- name: TeamHasPlayers :one
+SELECT EXISTS (SELECT 1 FROM players WHERE team_id = $1)
+`
+
+// InsertRoster fills an empty team with players. The team is locked first,
+// so concurrent provisioning of the same team can't both insert a roster
+//
+// If team not found - ErrNotFound
+// If team already has players - ErrConflict
+func (r *pgPlayerRepository) InsertRoster(
 	ctx context.Context,
+	teamID int64,
 	args []InsertPlayerParams,
 ) error {
 	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{
@@ -325,6 +420,24 @@ func (r *pgPlayerRepository) InsertPlayersBatch(
 		return err
 	}
 
+	var id int64
+	if err := tx.QueryRow(ctx, lockTeamByID, teamID).Scan(&id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return postgres.Rollback(ctx, tx, ErrNotFound)
+		}
+
+		return postgres.Rollback(ctx, tx, err)
+	}
+
+	// checked after the lock, so players of a roster that was inserted meanwhile are seen
+	var hasPlayers bool
+	if err := tx.QueryRow(ctx, teamHasPlayers, teamID).Scan(&hasPlayers); err != nil {
+		return postgres.Rollback(ctx, tx, err)
+	}
+	if hasPlayers {
+		return postgres.Rollback(ctx, tx, ErrConflict)
+	}
+
 	for _, arg := range args {
 		if err := r.insertPlayerWithQuerier(ctx, tx, arg); err != nil {
 			return postgres.Rollback(ctx, tx, err)
@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hexley21/soccer-manager/pkg/infra/postgres"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+//go:generate mockgen -destination=mock/mock_player_price_history.go -package=mock github.com/hexley21/soccer-manager/internal/soccer-manager/repository PlayerPriceHistoryRepository
+type PlayerPriceHistoryRepository interface {
+	ListPlayerPriceHistory(ctx context.Context, arg ListPlayerPriceHistoryParams) ([]PlayerPricePoint, error)
+	ListPlayerPriceHistoryBuckets(
+		ctx context.Context,
+		arg ListPlayerPriceHistoryBucketsParams,
+	) ([]PlayerPricePoint, error)
+}
+
+type pgPlayerPriceHistoryRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewPlayerPriceHistoryRepository(db *pgxpool.Pool) *pgPlayerPriceHistoryRepository {
+	return &pgPlayerPriceHistoryRepository{
+		db: db,
+	}
+}
+
+const insertPlayerPriceHistory = `-- name: InsertPlayerPriceHistory :exec
+INSERT INTO player_price_history (id, player_id, price, reason) VALUES ($1, $2, $3, $4)
+`
+
+type InsertPlayerPriceHistoryParams struct {
+	ID       int64  `json:"id"`
+	PlayerID int64  `json:"player_id"`
+	Price    int64  `json:"price"`
+	Reason   string `json:"reason"`
+}
+
+// insertPlayerPriceHistoryWithQuerier appends a price change,
+// it must run in the same transaction that changes the price
+func insertPlayerPriceHistoryWithQuerier(
+	ctx context.Context,
+	querier postgres.Querier,
+	arg InsertPlayerPriceHistoryParams,
+) error {
+	_, err := querier.Exec(ctx, insertPlayerPriceHistory,
+		arg.ID,
+		arg.PlayerID,
+		arg.Price,
+		arg.Reason,
+	)
+	return err
+}
+
+const listPlayerPriceHistory = `-- name: ListPlayerPriceHistory :many
+SELECT recorded_at, price, price, price FROM player_price_history
+WHERE player_id = $1
+  AND ($2::TIMESTAMPTZ IS NULL OR recorded_at >= $2)
+  AND ($3::TIMESTAMPTZ IS NULL OR recorded_at < $3)
+ORDER BY recorded_at, id
+`
+
+type ListPlayerPriceHistoryParams struct {
+	PlayerID int64              `json:"player_id"`
+	From     pgtype.Timestamptz `json:"from"`
+	To       pgtype.Timestamptz `json:"to"`
+}
+
+// ListPlayerPriceHistory returns every recorded price of the player in chronological order,
+// unset From and To leave the range open
+func (r *pgPlayerPriceHistoryRepository) ListPlayerPriceHistory(
+	ctx context.Context,
+	arg ListPlayerPriceHistoryParams,
+) ([]PlayerPricePoint, error) {
+	return r.listPricePoints(ctx, listPlayerPriceHistory, arg.PlayerID, arg.From, arg.To)
+}
+
+const listPlayerPriceHistoryBuckets = `-- name: ListPlayerPriceHistoryBuckets :many
+SELECT date_trunc($4, recorded_at) AS bucket,
+  (array_agg(price ORDER BY recorded_at DESC, id DESC))[1],
+  min(price),
+  max(price)
+FROM player_price_history
+WHERE player_id = $1
+  AND ($2::TIMESTAMPTZ IS NULL OR recorded_at >= $2)
+  AND ($3::TIMESTAMPTZ IS NULL OR recorded_at < $3)
+GROUP BY bucket
+ORDER BY bucket
+`
+
+type ListPlayerPriceHistoryBucketsParams struct {
+	PlayerID int64              `json:"player_id"`
+	From     pgtype.Timestamptz `json:"from"`
+	To       pgtype.Timestamptz `json:"to"`
+	Bucket   string             `json:"bucket"`
+}
+
+// ListPlayerPriceHistoryBuckets groups player's prices by the date_trunc field in Bucket (day, week),
+// each point is the start of the bucket with the last, lowest and highest price within it
+func (r *pgPlayerPriceHistoryRepository) ListPlayerPriceHistoryBuckets(
+	ctx context.Context,
+	arg ListPlayerPriceHistoryBucketsParams,
+) ([]PlayerPricePoint, error) {
+	return r.listPricePoints(ctx, listPlayerPriceHistoryBuckets, arg.PlayerID, arg.From, arg.To, arg.Bucket)
+}
+
+func (r *pgPlayerPriceHistoryRepository) listPricePoints(
+	ctx context.Context,
+	query string,
+	args ...any,
+) ([]PlayerPricePoint, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []PlayerPricePoint{}
+	for rows.Next() {
+		var i PlayerPricePoint
+		if err := rows.Scan(
+			&i.At,
+			&i.Price,
+			&i.LowPrice,
+			&i.HighPrice,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
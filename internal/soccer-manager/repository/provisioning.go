@@ -0,0 +1,234 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bwmarrin/snowflake"
+	"github.com/hexley21/soccer-manager/pkg/infra/postgres"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Provisioning statuses
+const (
+	ProvisioningPending   = "pending"
+	ProvisioningCompleted = "completed"
+	ProvisioningFailed    = "failed"
+)
+
+//go:generate mockgen -destination=mock/mock_provisioning.go -package=mock github.com/hexley21/soccer-manager/internal/soccer-manager/repository ProvisioningRepository
+type ProvisioningRepository interface {
+	GetProvisioningByUserID(ctx context.Context, userID int64) (UserProvisioning, error)
+	ListStuckProvisioning(ctx context.Context, arg ListStuckProvisioningParams) ([]UserProvisioning, error)
+
+	StartProvisioning(ctx context.Context, userID int64) (UserProvisioning, error)
+	CompleteProvisioning(ctx context.Context, arg CompleteProvisioningParams) error
+	FailProvisioning(ctx context.Context, arg FailProvisioningParams) error
+	RequeueProvisioning(ctx context.Context, arg RequeueProvisioningParams) error
+}
+
+type pgProvisioningRepository struct {
+	db            *pgxpool.Pool
+	snowflakeNode *snowflake.Node
+}
+
+func NewProvisioningRepository(db *pgxpool.Pool, snowflakeNode *snowflake.Node) *pgProvisioningRepository {
+	return &pgProvisioningRepository{
+		db:            db,
+		snowflakeNode: snowflakeNode,
+	}
+}
+
+const insertProvisioning = `-- name: InsertProvisioning :exec
+INSERT INTO user_provisioning (user_id) VALUES ($1)
+`
+
+// insertProvisioningWithQuerier must run in the transaction that creates the user
+func insertProvisioningWithQuerier(ctx context.Context, querier postgres.Querier, userID int64) error {
+	_, err := querier.Exec(ctx, insertProvisioning, userID)
+	return err
+}
+
+const getProvisioningByUserID = `-- name: GetProvisioningByUserID :one
+SELECT user_id, status, team_id, attempts, last_error, created_at, updated_at FROM user_provisioning WHERE user_id = $1
+`
+
+func (r *pgProvisioningRepository) GetProvisioningByUserID(
+	ctx context.Context,
+	userID int64,
+) (UserProvisioning, error) {
+	return scanProvisioning(r.db.QueryRow(ctx, getProvisioningByUserID, userID))
+}
+
+const listStuckProvisioning = `-- name: ListStuckProvisioning :many
+SELECT user_id, status, team_id, attempts, last_error, created_at, updated_at FROM user_provisioning
+WHERE (status = 'failed' OR (status = 'pending' AND updated_at < $1)) AND user_id > $2
+ORDER BY user_id LIMIT $3
+`
+
+// ListStuckProvisioningParams pages by user id, pending provisioning untouched since PendingBefore counts as stuck
+type ListStuckProvisioningParams struct {
+	PendingBefore pgtype.Timestamptz `json:"pending_before"`
+	UserID        int64              `json:"user_id"`
+	Limit         int32              `json:"limit"`
+}
+
+func (r *pgProvisioningRepository) ListStuckProvisioning(
+	ctx context.Context,
+	arg ListStuckProvisioningParams,
+) ([]UserProvisioning, error) {
+	rows, err := r.db.Query(ctx, listStuckProvisioning, arg.PendingBefore, arg.UserID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []UserProvisioning{}
+	for rows.Next() {
+		var i UserProvisioning
+		if err := rows.Scan(
+			&i.UserID,
+			&i.Status,
+			&i.TeamID,
+			&i.Attempts,
+			&i.LastError,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const startProvisioning = `-- name: StartProvisioning :one
+INSERT INTO user_provisioning (user_id, attempts) VALUES ($1, 1)
+ON CONFLICT (user_id) DO UPDATE SET
+  status = CASE WHEN user_provisioning.status = 'completed' THEN 'completed' ELSE 'pending' END,
+  attempts = user_provisioning.attempts + 1,
+  updated_at = now()
+RETURNING user_id, status, team_id, attempts, last_error, created_at, updated_at
+`
+
+// StartProvisioning counts an attempt, users created before provisioning was tracked get their row here
+func (r *pgProvisioningRepository) StartProvisioning(ctx context.Context, userID int64) (UserProvisioning, error) {
+	return scanProvisioning(r.db.QueryRow(ctx, startProvisioning, userID))
+}
+
+const completeProvisioning = `-- name: CompleteProvisioning :exec
+UPDATE user_provisioning SET status = 'completed', team_id = $2, last_error = NULL, updated_at = now() WHERE user_id = $1
+`
+
+type CompleteProvisioningParams struct {
+	UserID int64 `json:"user_id"`
+	TeamID int64 `json:"team_id"`
+}
+
+func (r *pgProvisioningRepository) CompleteProvisioning(ctx context.Context, arg CompleteProvisioningParams) error {
+	_, err := r.db.Exec(ctx, completeProvisioning, arg.UserID, arg.TeamID)
+	return err
+}
+
+const failProvisioning = `-- name: FailProvisioning :exec
+UPDATE user_provisioning SET status = $2, team_id = $3, last_error = $4, updated_at = now() WHERE user_id = $1
+`
+
+// FailProvisioningParams keeps Status pending while retries are left, TeamID is set when the team got created
+type FailProvisioningParams struct {
+	UserID    int64       `json:"user_id"`
+	Status    string      `json:"status"`
+	TeamID    pgtype.Int8 `json:"team_id"`
+	LastError pgtype.Text `json:"last_error"`
+}
+
+func (r *pgProvisioningRepository) FailProvisioning(ctx context.Context, arg FailProvisioningParams) error {
+	_, err := r.db.Exec(ctx, failProvisioning, arg.UserID, arg.Status, arg.TeamID, arg.LastError)
+	return err
+}
+
+const getUsernameForUpdate = `-- name: GetUsernameForUpdate :one
+SELECT username FROM users WHERE id = $1 FOR UPDATE
+`
+
+const resetProvisioning = `-- name: ResetProvisioning :exec
+INSERT INTO user_provisioning (user_id) VALUES ($1)
+ON CONFLICT (user_id) DO UPDATE SET status = 'pending', attempts = 0, last_error = NULL, updated_at = now()
+`
+
+// RequeueProvisioningParams counts pending provisioning untouched since PendingBefore as stuck,
+// like ListStuckProvisioningParams
+type RequeueProvisioningParams struct {
+	UserID        int64              `json:"user_id"`
+	PendingBefore pgtype.Timestamptz `json:"pending_before"`
+}
+
+// RequeueProvisioning resets the attempts of a stuck provisioning and writes a fresh OutboxTopicSignUp,
+// a provisioning still on its way already has its event queued
+//
+// If user not found - ErrNotFound
+// If already completed - ErrConflict
+// If pending and not stuck - ErrNotAllowed
+func (r *pgProvisioningRepository) RequeueProvisioning(ctx context.Context, arg RequeueProvisioningParams) error {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return err
+	}
+
+	// the user row lock keeps concurrent requeues from queueing twice
+	var username string
+	if err := tx.QueryRow(ctx, getUsernameForUpdate, arg.UserID).Scan(&username); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			err = ErrNotFound
+		}
+		return postgres.Rollback(ctx, tx, err)
+	}
+
+	provisioning, err := scanProvisioning(tx.QueryRow(ctx, getProvisioningByUserID, arg.UserID))
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return postgres.Rollback(ctx, tx, err)
+	}
+	if provisioning.Status == ProvisioningCompleted {
+		return postgres.Rollback(ctx, tx, ErrConflict)
+	}
+	if provisioning.Status == ProvisioningPending && !provisioning.UpdatedAt.Time.Before(arg.PendingBefore.Time) {
+		return postgres.Rollback(ctx, tx, ErrNotAllowed)
+	}
+
+	if _, err := tx.Exec(ctx, resetProvisioning, arg.UserID); err != nil {
+		return postgres.Rollback(ctx, tx, err)
+	}
+
+	if err := insertOutboxEventWithQuerier(
+		ctx,
+		tx,
+		r.snowflakeNode,
+		OutboxTopicSignUp,
+		OutboxSignUp{UserID: arg.UserID, Username: username},
+	); err != nil {
+		return postgres.Rollback(ctx, tx, err)
+	}
+
+	return tx.Commit(ctx)
+}
+
+func scanProvisioning(row pgx.Row) (UserProvisioning, error) {
+	var i UserProvisioning
+	err := row.Scan(
+		&i.UserID,
+		&i.Status,
+		&i.TeamID,
+		&i.Attempts,
+		&i.LastError,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
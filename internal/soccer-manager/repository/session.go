@@ -0,0 +1,265 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+	"github.com/hexley21/soccer-manager/pkg/infra/postgres"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Reasons a session was revoked for
+const (
+	SessionRevokedLogout         = "logout"
+	SessionRevokedLogoutAll      = "logout_all"
+	SessionRevokedByUser         = "revoked"
+	SessionRevokedByAdmin        = "admin"
+	SessionRevokedPasswordChange = "password_change"
+	SessionRevokedReuse          = "reuse"
+	SessionRevokedRoleChange     = "role_change"
+)
+
+//go:generate mockgen -destination=mock/mock_session.go -package=mock github.com/hexley21/soccer-manager/internal/soccer-manager/repository SessionRepository
+type SessionRepository interface {
+	ListActiveSessionsByUserID(ctx context.Context, userID int64) ([]Session, error)
+	IsSessionActive(ctx context.Context, arg IsSessionActiveParams) (bool, error)
+
+	CreateSession(ctx context.Context, arg CreateSessionParams) (Session, error)
+	RotateSession(ctx context.Context, arg RotateSessionParams) (Session, error)
+	RevokeSession(ctx context.Context, arg RevokeSessionParams) error
+	RevokeUserSessions(ctx context.Context, arg RevokeUserSessionsParams) (int64, error)
+}
+
+type pgSessionRepository struct {
+	db            *pgxpool.Pool
+	snowflakeNode *snowflake.Node
+}
+
+func NewSessionRepository(db *pgxpool.Pool, snowflakeNode *snowflake.Node) *pgSessionRepository {
+	return &pgSessionRepository{
+		db:            db,
+		snowflakeNode: snowflakeNode,
+	}
+}
+
+const createSession = `-- name: CreateSession :one
+INSERT INTO sessions (id, user_id, token_id, expires_at, user_agent, ip) VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, user_id, token_id, user_agent, ip, created_at, last_used_at, expires_at, revoked_at, revoked_reason
+`
+
+type CreateSessionParams struct {
+	UserID    int64              `json:"user_id"`
+	ExpiresAt pgtype.Timestamptz `json:"expires_at"`
+	UserAgent string             `json:"user_agent"`
+	IP        string             `json:"ip"`
+}
+
+// CreateSession starts a token family, the id of its first token is generated too
+func (r *pgSessionRepository) CreateSession(ctx context.Context, arg CreateSessionParams) (Session, error) {
+	return scanSession(r.db.QueryRow(ctx, createSession,
+		r.snowflakeNode.Generate().Int64(),
+		arg.UserID,
+		r.snowflakeNode.Generate().Int64(),
+		arg.ExpiresAt,
+		arg.UserAgent,
+		arg.IP,
+	))
+}
+
+const getSessionForUpdate = `-- name: GetSessionForUpdate :one
+SELECT id, user_id, token_id, user_agent, ip, created_at, last_used_at, expires_at, revoked_at, revoked_reason FROM sessions
+WHERE id = $1 FOR UPDATE
+`
+
+const listActiveSessionsByUserID = `-- name: ListActiveSessionsByUserID :many
+SELECT id, user_id, token_id, user_agent, ip, created_at, last_used_at, expires_at, revoked_at, revoked_reason FROM sessions
+WHERE user_id = $1 AND revoked_at IS NULL AND expires_at > now()
+ORDER BY last_used_at DESC
+`
+
+// ListActiveSessionsByUserID returns sessions that can still refresh, most recently used first
+func (r *pgSessionRepository) ListActiveSessionsByUserID(ctx context.Context, userID int64) ([]Session, error) {
+	rows, err := r.db.Query(ctx, listActiveSessionsByUserID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Session{}
+	for rows.Next() {
+		i, err := scanSession(rows)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const rotateSession = `-- name: RotateSession :one
+UPDATE sessions SET token_id = $2, expires_at = $3, user_agent = $4, ip = $5, last_used_at = now() WHERE id = $1
+RETURNING id, user_id, token_id, user_agent, ip, created_at, last_used_at, expires_at, revoked_at, revoked_reason
+`
+
+// RotateSessionParams identifies the presented token by its session ID and TokenID,
+// the session is extended to ExpiresAt and takes over the client
+type RotateSessionParams struct {
+	ID        int64              `json:"id"`
+	TokenID   int64              `json:"token_id"`
+	ExpiresAt pgtype.Timestamptz `json:"expires_at"`
+	UserAgent string             `json:"user_agent"`
+	IP        string             `json:"ip"`
+}
+
+// RotateSession replaces the current token of the session with a new one.
+// A token that was already rotated away is a replay, the whole session is revoked then
+//
+// If not found, revoked or expired - ErrNotFound
+// If the token was already used - ErrConflict
+func (r *pgSessionRepository) RotateSession(ctx context.Context, arg RotateSessionParams) (Session, error) {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return Session{}, err
+	}
+
+	session, err := scanSession(tx.QueryRow(ctx, getSessionForUpdate, arg.ID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			err = ErrNotFound
+		}
+		return Session{}, postgres.Rollback(ctx, tx, err)
+	}
+
+	if session.RevokedAt.Valid || session.ExpiresAt.Time.Before(time.Now()) {
+		return Session{}, postgres.Rollback(ctx, tx, ErrNotFound)
+	}
+
+	if session.TokenID != arg.TokenID {
+		// the revocation must stick, so it's committed before reporting the replay
+		if _, err := tx.Exec(ctx, revokeSession, session.ID, session.UserID, SessionRevokedReuse); err != nil {
+			return Session{}, postgres.Rollback(ctx, tx, err)
+		}
+		if err := tx.Commit(ctx); err != nil {
+			return Session{}, err
+		}
+
+		return Session{}, ErrConflict
+	}
+
+	rotated, err := scanSession(tx.QueryRow(ctx, rotateSession,
+		arg.ID,
+		r.snowflakeNode.Generate().Int64(),
+		arg.ExpiresAt,
+		arg.UserAgent,
+		arg.IP,
+	))
+	if err != nil {
+		return Session{}, postgres.Rollback(ctx, tx, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return Session{}, err
+	}
+
+	return rotated, nil
+}
+
+const revokeSession = `-- name: RevokeSession :execrows
+UPDATE sessions SET revoked_at = now(), revoked_reason = $3 WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL
+`
+
+type RevokeSessionParams struct {
+	ID     int64  `json:"id"`
+	UserID int64  `json:"user_id"`
+	Reason string `json:"reason"`
+}
+
+// RevokeSession invalidates every token of the session
+//
+// If not found or already revoked - ErrNotFound
+func (r *pgSessionRepository) RevokeSession(ctx context.Context, arg RevokeSessionParams) error {
+	res, err := r.db.Exec(ctx, revokeSession, arg.ID, arg.UserID, arg.Reason)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+const revokeUserSessions = `-- name: RevokeUserSessions :execrows
+UPDATE sessions SET revoked_at = now(), revoked_reason = $3
+WHERE user_id = $1 AND id <> $2 AND revoked_at IS NULL AND expires_at > now()
+`
+
+// RevokeUserSessionsParams spares the session of ExceptID, zero revokes every session
+type RevokeUserSessionsParams struct {
+	UserID   int64  `json:"user_id"`
+	ExceptID int64  `json:"except_id"`
+	Reason   string `json:"reason"`
+}
+
+// RevokeUserSessions invalidates sessions of the user and returns how many were active
+func (r *pgSessionRepository) RevokeUserSessions(ctx context.Context, arg RevokeUserSessionsParams) (int64, error) {
+	return revokeUserSessionsWithQuerier(ctx, r.db, arg)
+}
+
+func revokeUserSessionsWithQuerier(
+	ctx context.Context,
+	querier postgres.Querier,
+	arg RevokeUserSessionsParams,
+) (int64, error) {
+	res, err := querier.Exec(ctx, revokeUserSessions, arg.UserID, arg.ExceptID, arg.Reason)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected(), nil
+}
+
+const isSessionActive = `-- name: IsSessionActive :one
+SELECT EXISTS (
+  SELECT 1 FROM sessions WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL AND expires_at > now()
+) AS active
+`
+
+type IsSessionActiveParams struct {
+	ID     int64 `json:"id"`
+	UserID int64 `json:"user_id"`
+}
+
+// IsSessionActive tells whether the session of the user is neither revoked nor expired
+func (r *pgSessionRepository) IsSessionActive(ctx context.Context, arg IsSessionActiveParams) (bool, error) {
+	row := r.db.QueryRow(ctx, isSessionActive, arg.ID, arg.UserID)
+	var active bool
+	err := row.Scan(&active)
+	return active, err
+}
+
+func scanSession(row pgx.Row) (Session, error) {
+	var i Session
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.TokenID,
+		&i.UserAgent,
+		&i.IP,
+		&i.CreatedAt,
+		&i.LastUsedAt,
+		&i.ExpiresAt,
+		&i.RevokedAt,
+		&i.RevokedReason,
+	)
+	return i, err
+}
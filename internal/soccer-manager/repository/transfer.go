@@ -3,12 +3,15 @@ package repository
 import (
 	"context"
 	"errors"
-	"math/rand"
 	"time"
 
 	"github.com/bwmarrin/snowflake"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/rating"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/tax"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/valuation"
 	"github.com/hexley21/soccer-manager/pkg/infra/postgres"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -25,52 +28,97 @@ type TransferRepository interface {
 	) (int64, error)
 	DeleteTransferByIDAndUserID(ctx context.Context, arg DeleteTransferByIDAndUserIDParams) error
 	UpdateTransferPriceByIDAndUserID(ctx context.Context, arg UpdateTransferPriceByIDAndUserIDParams) error
+	RenewTransferByIDAndUserID(ctx context.Context, arg RenewTransferByIDAndUserIDParams) error
 
-	BuyPlayer(ctx context.Context, transferId int64, buyerTeamId int64) error
+	ListExpiredTransferIDs(ctx context.Context, limit int32) ([]int64, error)
+	ExpireTransfer(ctx context.Context, id int64) (Transfer, error)
+
+	BuyPlayer(ctx context.Context, transferId int64, buyerTeamId int64) (TransferRecord, error)
 }
 
 type pgTransferRepository struct {
 	db            *pgxpool.Pool
 	snowflakeNode *snowflake.Node
+	tax           tax.Policy
+	valuator      valuation.PriceValuator
 }
 
-func NewTransferRepository(db *pgxpool.Pool, snowflakeNode *snowflake.Node) *pgTransferRepository {
+func NewTransferRepository(
+	db *pgxpool.Pool,
+	snowflakeNode *snowflake.Node,
+	taxPolicy tax.Policy,
+	valuator valuation.PriceValuator,
+) *pgTransferRepository {
 	return &pgTransferRepository{
 		db:            db,
 		snowflakeNode: snowflakeNode,
+		tax:           taxPolicy,
+		valuator:      valuator,
 	}
 }
 
 const insertTransferRecordByUser = `-- name: InsertTransferRecordByUser :one
 WITH team AS (SELECT id FROM teams WHERE user_id = $1 LIMIT 1)
-INSERT INTO transfers (id, player_id, seller_team_id, price)
-VALUES ($2, $3, (SELECT id FROM team), $4)
+INSERT INTO transfers (id, player_id, seller_team_id, price, auction_ends_at, reserve_price, expires_at)
+VALUES ($2, $3, (SELECT id FROM team), $4, $5, $6, $7)
 RETURNING id
 `
 
 type InsertTransferRecordByUserParams struct {
-	UserID   int64 `json:"user_id"`
-	PlayerID int64 `json:"player_id"`
-	Price    int64 `json:"price"`
+	UserID        int64              `json:"user_id"`
+	PlayerID      int64              `json:"player_id"`
+	Price         int64              `json:"price"`
+	AuctionEndsAt pgtype.Timestamptz `json:"auction_ends_at"`
+	ReservePrice  pgtype.Int8        `json:"reserve_price"`
+	ExpiresAt     pgtype.Timestamptz `json:"expires_at"`
 }
 
+// InsertTransferRecordByUser lists user's player,
+// the listing is an auction when AuctionEndsAt and ReservePrice are set,
+// a fixed price listing lapses at ExpiresAt when set. OutboxTopicTransferListed is written along
 func (r *pgTransferRepository) InsertTransferRecordByUser(
 	ctx context.Context,
 	arg InsertTransferRecordByUserParams,
 ) (int64, error) {
-	row := r.db.QueryRow(ctx, insertTransferRecordByUser,
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	row := tx.QueryRow(ctx, insertTransferRecordByUser,
 		arg.UserID,
 		r.snowflakeNode.Generate().Int64(),
 		arg.PlayerID,
 		arg.Price,
+		arg.AuctionEndsAt,
+		arg.ReservePrice,
+		arg.ExpiresAt,
 	)
 	var id int64
-	err := row.Scan(&id)
-	return id, err
+	if err := row.Scan(&id); err != nil {
+		return 0, postgres.Rollback(ctx, tx, err)
+	}
+
+	if err := insertOutboxEventWithQuerier(
+		ctx,
+		tx,
+		r.snowflakeNode,
+		OutboxTopicTransferListed,
+		OutboxRef{ID: id},
+	); err != nil {
+		return 0, postgres.Rollback(ctx, tx, err)
+	}
+
+	return id, tx.Commit(ctx)
 }
 
-const deleteTransferByIDAndUserID = `-- name: DeleteTransferByIDAndUserID :exec
-DELETE FROM transfers USING teams WHERE transfers.id = $1 AND transfers.seller_team_id = teams.id AND teams.user_id = $2
+const lockTransferByIDAndUserID = `-- name: LockTransferByIDAndUserID :one
+SELECT transfers.id, transfers.player_id, transfers.seller_team_id, transfers.price, transfers.listed_at, transfers.auction_ends_at, transfers.reserve_price, transfers.expires_at
+FROM transfers JOIN teams ON transfers.seller_team_id = teams.id
+WHERE transfers.id = $1 AND teams.user_id = $2 FOR UPDATE OF transfers
 `
 
 type DeleteTransferByIDAndUserIDParams struct {
@@ -78,16 +126,67 @@ type DeleteTransferByIDAndUserIDParams struct {
 	UserID int64 `json:"user_id"`
 }
 
+const hasOpenBidsByTransferID = `-- name: HasOpenBidsByTransferID :one
+SELECT EXISTS (SELECT 1 FROM bids WHERE transfer_id = $1 AND status IN ('PENDING', 'COUNTERED'))
+`
+
+// DeleteTransferByIDAndUserID removes the listing and cancels its pending bids.
+// The listing is locked first, so no bid can be placed between cancelling the bids and removing it.
+// Auctions can only be removed while running without bids, an ended one belongs to its highest bidder
+//
+// If not found - ErrNotFound
+// If auction has ended - ErrNotAllowed
+// If auction has open bids - ErrConflict
 func (r *pgTransferRepository) DeleteTransferByIDAndUserID(ctx context.Context, arg DeleteTransferByIDAndUserIDParams) error {
-	res, err := r.db.Exec(ctx, deleteTransferByIDAndUserID, arg.ID, arg.UserID)
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
 	if err != nil {
 		return err
 	}
-	if res.RowsAffected() == 0 {
-		return ErrNotFound
+
+	var i Transfer
+	if err := tx.QueryRow(ctx, lockTransferByIDAndUserID, arg.ID, arg.UserID).Scan(
+		&i.ID,
+		&i.PlayerID,
+		&i.SellerTeamID,
+		&i.Price,
+		&i.ListedAt,
+		&i.AuctionEndsAt,
+		&i.ReservePrice,
+		&i.ExpiresAt,
+	); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return postgres.Rollback(ctx, tx, ErrNotFound)
+		}
+
+		return postgres.Rollback(ctx, tx, err)
 	}
 
-	return nil
+	if i.AuctionEndsAt.Valid {
+		if !time.Now().Before(i.AuctionEndsAt.Time) {
+			return postgres.Rollback(ctx, tx, ErrNotAllowed)
+		}
+
+		var hasBids bool
+		if err := tx.QueryRow(ctx, hasOpenBidsByTransferID, i.ID).Scan(&hasBids); err != nil {
+			return postgres.Rollback(ctx, tx, err)
+		}
+		if hasBids {
+			return postgres.Rollback(ctx, tx, ErrConflict)
+		}
+	}
+
+	if err := releaseBidsByTransferIDWithQuerier(ctx, tx, i.ID, 0); err != nil {
+		return postgres.Rollback(ctx, tx, err)
+	}
+
+	if err := deleteTransferByIDWithQuerier(ctx, tx, i.ID); err != nil {
+		return postgres.Rollback(ctx, tx, err)
+	}
+
+	return tx.Commit(ctx)
 }
 
 const deleteTransferByID = `-- name: DeleteTransferByID :exec
@@ -107,7 +206,7 @@ func deleteTransferByIDWithQuerier(ctx context.Context, querier postgres.Querier
 }
 
 const selectTransferById = `-- name: SelectTransferById :one
-SELECT id, player_id, seller_team_id, price, listed_at FROM transfers WHERE id = $1
+SELECT id, player_id, seller_team_id, price, listed_at, auction_ends_at, reserve_price, expires_at FROM transfers WHERE id = $1
 `
 
 func (r *pgTransferRepository) SelectTransferById(ctx context.Context, id int64) (Transfer, error) {
@@ -127,19 +226,30 @@ func selectTransferByIdWithQuerier(
 		&i.SellerTeamID,
 		&i.Price,
 		&i.ListedAt,
+		&i.AuctionEndsAt,
+		&i.ReservePrice,
+		&i.ExpiresAt,
 	)
 	return i, err
 }
 
 const getTransferByPlayerID = `-- name: GetTransferByPlayerID :one
-SELECT id, player_id, seller_team_id, price, listed_at FROM transfers WHERE player_id = $1
+SELECT id, player_id, seller_team_id, price, listed_at, auction_ends_at, reserve_price, expires_at FROM transfers WHERE player_id = $1
 `
 
 func (r *pgTransferRepository) GetTransferByPlayerID(
 	ctx context.Context,
 	playerID int64,
 ) (Transfer, error) {
-	row := r.db.QueryRow(ctx, getTransferByPlayerID, playerID)
+	return getTransferByPlayerIDWithQuerier(ctx, r.db, playerID)
+}
+
+func getTransferByPlayerIDWithQuerier(
+	ctx context.Context,
+	querier postgres.Querier,
+	playerID int64,
+) (Transfer, error) {
+	row := querier.QueryRow(ctx, getTransferByPlayerID, playerID)
 	var i Transfer
 	err := row.Scan(
 		&i.ID,
@@ -147,24 +257,86 @@ func (r *pgTransferRepository) GetTransferByPlayerID(
 		&i.SellerTeamID,
 		&i.Price,
 		&i.ListedAt,
+		&i.AuctionEndsAt,
+		&i.ReservePrice,
+		&i.ExpiresAt,
 	)
 	return i, err
 }
 
 const listTransfers = `-- name: ListTransfers :many
-SELECT id, player_id, seller_team_id, price, listed_at FROM transfers WHERE id > $1 ORDER BY id LIMIT $2
+SELECT t.id, t.player_id, t.seller_team_id, t.price, t.listed_at, t.auction_ends_at, t.reserve_price, t.expires_at
+FROM transfers t JOIN players p ON p.id = t.player_id
+WHERE (t.expires_at IS NULL OR t.expires_at > now())
+  AND ($1::VARCHAR IS NULL OR p.position_code = $1)
+  AND ($2::BIGINT IS NULL OR t.price >= $2)
+  AND ($3::BIGINT IS NULL OR t.price <= $3)
+  AND ($4::INT IS NULL OR p.age >= $4)
+  AND ($5::INT IS NULL OR p.age <= $5)
+  AND ($6::VARCHAR IS NULL OR p.country_code = $6)
+  AND ($7::BIGINT IS NULL OR t.seller_team_id = $7)
+  AND ($8::TIMESTAMPTZ IS NULL OR t.listed_at >= $8)
+  AND ($9::TIMESTAMPTZ IS NULL OR t.listed_at <= $9)
+  AND ($10::BIGINT = 0 OR (
+    (CASE WHEN $11::BOOLEAN THEN t.price ELSE t.id END) * $12::BIGINT, t.id * $12::BIGINT
+  ) > ($13::BIGINT * $12::BIGINT, $10::BIGINT * $12::BIGINT))
+ORDER BY (CASE WHEN $11::BOOLEAN THEN t.price ELSE t.id END) * $12::BIGINT, t.id * $12::BIGINT
+LIMIT $14
 `
 
+// ListTransfersParams filters listings, unset (invalid) fields are ignored.
+// Listings are ordered by id or by price with id as a tie breaker, ID is the last listing of the previous page
+// and CursorPrice its price when sorted by price
 type ListTransfersParams struct {
-	ID    int64 `json:"id"`
-	Limit int32 `json:"limit"`
+	PositionCode pgtype.Text        `json:"position_code"`
+	MinPrice     pgtype.Int8        `json:"min_price"`
+	MaxPrice     pgtype.Int8        `json:"max_price"`
+	MinAge       pgtype.Int4        `json:"min_age"`
+	MaxAge       pgtype.Int4        `json:"max_age"`
+	CountryCode  pgtype.Text        `json:"country_code"`
+	SellerTeamID pgtype.Int8        `json:"seller_team_id"`
+	ListedAfter  pgtype.Timestamptz `json:"listed_after"`
+	ListedBefore pgtype.Timestamptz `json:"listed_before"`
+	SortByPrice  bool               `json:"sort_by_price"`
+	Descending   bool               `json:"descending"`
+	ID           int64              `json:"id"`
+	CursorPrice  int64              `json:"cursor_price"`
+	Limit        int32              `json:"limit"`
 }
 
+// ListTransfers returns a page of filtered listings.
+// Pages are keyset paginated on (sort key, id), descending order flips the sign of both
 func (r *pgTransferRepository) ListTransfers(
 	ctx context.Context,
 	arg ListTransfersParams,
 ) ([]Transfer, error) {
-	rows, err := r.db.Query(ctx, listTransfers, arg.ID, arg.Limit)
+	direction := int64(1)
+	if arg.Descending {
+		direction = -1
+	}
+
+	// the sort key of the cursor, ids sort by themselves
+	cursorKey := arg.ID
+	if arg.SortByPrice {
+		cursorKey = arg.CursorPrice
+	}
+
+	rows, err := r.db.Query(ctx, listTransfers,
+		arg.PositionCode,
+		arg.MinPrice,
+		arg.MaxPrice,
+		arg.MinAge,
+		arg.MaxAge,
+		arg.CountryCode,
+		arg.SellerTeamID,
+		arg.ListedAfter,
+		arg.ListedBefore,
+		arg.ID,
+		arg.SortByPrice,
+		direction,
+		cursorKey,
+		arg.Limit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -178,6 +350,9 @@ func (r *pgTransferRepository) ListTransfers(
 			&i.SellerTeamID,
 			&i.Price,
 			&i.ListedAt,
+			&i.AuctionEndsAt,
+			&i.ReservePrice,
+			&i.ExpiresAt,
 		); err != nil {
 			return nil, err
 		}
@@ -190,7 +365,7 @@ func (r *pgTransferRepository) ListTransfers(
 }
 
 const listTransfersByTeamId = `-- name: ListTransfersByTeamId :many
-SELECT id, player_id, seller_team_id, price, listed_at FROM transfers WHERE seller_team_id = $1 AND id > $2 ORDER BY id LIMIT $3
+SELECT id, player_id, seller_team_id, price, listed_at, auction_ends_at, reserve_price, expires_at FROM transfers WHERE seller_team_id = $1 AND (expires_at IS NULL OR expires_at > now()) AND id > $2 ORDER BY id LIMIT $3
 `
 
 type ListTransfersByTeamIdParams struct {
@@ -217,6 +392,9 @@ func (r *pgTransferRepository) ListTransfersByTeamId(
 			&i.SellerTeamID,
 			&i.Price,
 			&i.ListedAt,
+			&i.AuctionEndsAt,
+			&i.ReservePrice,
+			&i.ExpiresAt,
 		); err != nil {
 			return nil, err
 		}
@@ -229,7 +407,7 @@ func (r *pgTransferRepository) ListTransfersByTeamId(
 }
 
 const updateTransferPriceByIDAndUserID = `-- name: UpdateTransferPriceByIDAndUserID :exec
-UPDATE transfers SET price = $2 FROM teams WHERE transfers.id = $1 AND transfers.seller_team_id = teams.id AND teams.user_id = $3
+UPDATE transfers SET price = $2 FROM teams WHERE transfers.id = $1 AND transfers.seller_team_id = teams.id AND teams.user_id = $3 AND transfers.auction_ends_at IS NULL
 `
 
 type UpdateTransferPriceByIDAndUserIDParams struct {
@@ -238,8 +416,55 @@ type UpdateTransferPriceByIDAndUserIDParams struct {
 	UserID int64 `json:"user_id"`
 }
 
+// UpdateTransferPriceByIDAndUserID changes the price of a fixed price listing,
+// auctions are priced by their bids and are left untouched
+//
+// If not found or listing is an auction - ErrNotFound
 func (r *pgTransferRepository) UpdateTransferPriceByIDAndUserID(ctx context.Context, arg UpdateTransferPriceByIDAndUserIDParams) error {
-	res, err := r.db.Exec(ctx, updateTransferPriceByIDAndUserID, arg.ID, arg.Price, arg.UserID)
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return err
+	}
+
+	res, err := tx.Exec(ctx, updateTransferPriceByIDAndUserID, arg.ID, arg.Price, arg.UserID)
+	if err != nil {
+		return postgres.Rollback(ctx, tx, err)
+	}
+	if res.RowsAffected() == 0 {
+		return postgres.Rollback(ctx, tx, ErrNotFound)
+	}
+
+	if err := insertOutboxEventWithQuerier(
+		ctx,
+		tx,
+		r.snowflakeNode,
+		OutboxTopicTransferPriceChanged,
+		OutboxRef{ID: arg.ID},
+	); err != nil {
+		return postgres.Rollback(ctx, tx, err)
+	}
+
+	return tx.Commit(ctx)
+}
+
+const renewTransferByIDAndUserID = `-- name: RenewTransferByIDAndUserID :exec
+UPDATE transfers SET expires_at = $3 FROM teams WHERE transfers.id = $1 AND transfers.seller_team_id = teams.id AND teams.user_id = $2 AND transfers.expires_at > now()
+`
+
+type RenewTransferByIDAndUserIDParams struct {
+	ID        int64              `json:"id"`
+	UserID    int64              `json:"user_id"`
+	ExpiresAt pgtype.Timestamptz `json:"expires_at"`
+}
+
+// RenewTransferByIDAndUserID moves the expiry of a listing that hasn't lapsed yet
+//
+// If not found, never expires or already lapsed - ErrNotFound
+func (r *pgTransferRepository) RenewTransferByIDAndUserID(ctx context.Context, arg RenewTransferByIDAndUserIDParams) error {
+	res, err := r.db.Exec(ctx, renewTransferByIDAndUserID, arg.ID, arg.UserID, arg.ExpiresAt)
 	if err != nil {
 		return err
 	}
@@ -250,99 +475,314 @@ func (r *pgTransferRepository) UpdateTransferPriceByIDAndUserID(ctx context.Cont
 	return nil
 }
 
+const listExpiredTransferIDs = `-- name: ListExpiredTransferIDs :many
+SELECT id FROM transfers WHERE expires_at <= now() ORDER BY expires_at LIMIT $1
+`
+
+func (r *pgTransferRepository) ListExpiredTransferIDs(ctx context.Context, limit int32) ([]int64, error) {
+	rows, err := r.db.Query(ctx, listExpiredTransferIDs, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int64{}
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockExpiredTransferByID = `-- name: LockExpiredTransferByID :one
+SELECT id, player_id, seller_team_id, price, listed_at, auction_ends_at, reserve_price, expires_at FROM transfers WHERE id = $1 AND expires_at <= now() FOR UPDATE
+`
+
+// ExpireTransfer delists a lapsed listing, cancels its pending bids and returns the removed listing,
+// OutboxTopicTransferExpired is written along
+//
+// If not found or not lapsed - ErrNotFound
+func (r *pgTransferRepository) ExpireTransfer(ctx context.Context, id int64) (Transfer, error) {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return Transfer{}, err
+	}
+
+	var i Transfer
+	if err := tx.QueryRow(ctx, lockExpiredTransferByID, id).Scan(
+		&i.ID,
+		&i.PlayerID,
+		&i.SellerTeamID,
+		&i.Price,
+		&i.ListedAt,
+		&i.AuctionEndsAt,
+		&i.ReservePrice,
+		&i.ExpiresAt,
+	); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return Transfer{}, postgres.Rollback(ctx, tx, ErrNotFound)
+		}
+
+		return Transfer{}, postgres.Rollback(ctx, tx, err)
+	}
+
+	if err := releaseBidsByTransferIDWithQuerier(ctx, tx, i.ID, 0); err != nil {
+		return Transfer{}, postgres.Rollback(ctx, tx, err)
+	}
+
+	if err := deleteTransferByIDWithQuerier(ctx, tx, i.ID); err != nil {
+		return Transfer{}, postgres.Rollback(ctx, tx, err)
+	}
+
+	// the listing is gone, so the event carries all of it
+	if err := insertOutboxEventWithQuerier(ctx, tx, r.snowflakeNode, OutboxTopicTransferExpired, i); err != nil {
+		return Transfer{}, postgres.Rollback(ctx, tx, err)
+	}
+
+	return i, tx.Commit(ctx)
+}
+
 func (r *pgTransferRepository) BuyPlayer(
 	ctx context.Context,
 	transferId int64,
 	buyerUserId int64,
-) error {
+) (TransferRecord, error) {
 	tx, err := r.db.BeginTx(
 		ctx,
 		pgx.TxOptions{IsoLevel: pgx.Serializable, AccessMode: pgx.ReadWrite},
 	)
 	if err != nil {
-		return err
+		return TransferRecord{}, err
 	}
 
 	// 0. validation 
 	currentTransfer, err := selectTransferByIdWithQuerier(ctx, tx, transferId)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return postgres.Rollback(ctx, tx, ErrNotFound)
+			return TransferRecord{}, postgres.Rollback(ctx, tx, ErrNotFound)
 		}
 
-		return postgres.Rollback(ctx, tx, err)
+		return TransferRecord{}, postgres.Rollback(ctx, tx, err)
 	}
 
 	buyerTeam, err := getTeamByUserIDWithQuerier(ctx, tx, buyerUserId)
 	if err != nil {
-		return postgres.Rollback(ctx, tx, err)
+		return TransferRecord{}, postgres.Rollback(ctx, tx, err)
 	}
 	
+	// auctions are settled to the highest bidder only
+	if currentTransfer.AuctionEndsAt.Valid {
+		return TransferRecord{}, postgres.Rollback(ctx, tx, ErrNotAllowed)
+	}
+	// lapsed listings are gone, even if not swept yet
+	if currentTransfer.ExpiresAt.Valid && !time.Now().Before(currentTransfer.ExpiresAt.Time) {
+		return TransferRecord{}, postgres.Rollback(ctx, tx, ErrNotFound)
+	}
 	// make sure you are not buying from yourself
 	if currentTransfer.SellerTeamID == buyerTeam.ID {
-		return postgres.Rollback(ctx, tx, ErrConflict)
+		return TransferRecord{}, postgres.Rollback(ctx, tx, ErrConflict)
 	}
-	// make sure you have enough budget
-	if currentTransfer.Price > buyerTeam.Budget {
-		return postgres.Rollback(ctx, tx, ErrViolation)
+
+	// 1. cancel pending bids and give back reserved funds
+	if err := releaseBidsByTransferIDWithQuerier(ctx, tx, transferId, 0); err != nil {
+		return TransferRecord{}, postgres.Rollback(ctx, tx, err)
 	}
 
-	// 1. delete transfer
-	err = deleteTransferByIDWithQuerier(ctx, tx, transferId)
+	// make sure you have enough budget, funds reserved by your own bid on the listing are back by now
+	buyerTeam, err = getTeamByUserIDWithQuerier(ctx, tx, buyerUserId)
 	if err != nil {
-		return postgres.Rollback(ctx, tx, err)
+		return TransferRecord{}, postgres.Rollback(ctx, tx, err)
+	}
+	if currentTransfer.Price > buyerTeam.Budget {
+		return TransferRecord{}, postgres.Rollback(ctx, tx, ErrViolation)
 	}
 
-	// 2. payment
-	// 2.1 charge buyer
+	// 2. charge buyer
 	if err := addTeamBudgetWithQuerier(ctx, tx, AddTeamBudgetParams{
 		ID:     buyerTeam.ID,
 		Budget: -currentTransfer.Price,
 	}); err != nil {
-		return postgres.Rollback(ctx, tx, err)
+		return TransferRecord{}, postgres.Rollback(ctx, tx, err)
 	}
 
-	// 2.2 add money to seller
-	if err := addTeamBudgetWithQuerier(ctx, tx, AddTeamBudgetParams{
-		ID:     currentTransfer.SellerTeamID,
-		Budget: currentTransfer.Price,
+	// 3. hand over the player
+	record, err := completeTransferWithQuerier(
+		ctx,
+		tx,
+		r.snowflakeNode,
+		r.valuator,
+		currentTransfer,
+		buyerTeam.ID,
+		currentTransfer.Price,
+		r.tax.Of(currentTransfer.Price),
+	)
+	if err != nil {
+		return TransferRecord{}, postgres.Rollback(ctx, tx, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return TransferRecord{}, err
+	}
+
+	return record, nil
+}
+
+// completeTransferWithQuerier finishes an already paid transfer:
+// removes the listing and hands over the player, tax is withheld from the seller. Buyer must be charged by the caller
+func completeTransferWithQuerier(
+	ctx context.Context,
+	querier postgres.Querier,
+	snowflakeNode *snowflake.Node,
+	valuator valuation.PriceValuator,
+	transfer Transfer,
+	buyerTeamID int64,
+	price int64,
+	tax int64,
+) (TransferRecord, error) {
+	// 1. delete transfer
+	if err := deleteTransferByIDWithQuerier(ctx, querier, transfer.ID); err != nil {
+		return TransferRecord{}, err
+	}
+
+	// 2. hand over the player
+	return handOverPlayerWithQuerier(ctx, querier, snowflakeNode, valuator, HandOverPlayerParams{
+		PlayerID:     transfer.PlayerID,
+		SellerTeamID: transfer.SellerTeamID,
+		BuyerTeamID:  buyerTeamID,
+		Price:        price,
+		Tax:          tax,
+		ListedAt:     transfer.ListedAt,
+	})
+}
+
+const countRecentSalesByPosition = `-- name: CountRecentSalesByPosition :one
+SELECT count(*) FILTER (WHERE p.position_code = $1), count(*)
+FROM transfer_records tr JOIN players p ON p.id = tr.player_id
+WHERE tr.sold_at >= $2
+`
+
+type HandOverPlayerParams struct {
+	PlayerID     int64              `json:"player_id"`
+	SellerTeamID int64              `json:"seller_team_id"`
+	BuyerTeamID  int64              `json:"buyer_team_id"`
+	Price        int64              `json:"price"`
+	Tax          int64              `json:"tax"`
+	ListedAt     pgtype.Timestamptz `json:"listed_at"`
+}
+
+// handOverPlayerWithQuerier pays the seller the price minus tax, moves the player repriced by the valuator,
+// cancels pending offers for the player and writes the transfer record.
+// The tax leaves the economy, nobody is credited with it
+func handOverPlayerWithQuerier(
+	ctx context.Context,
+	querier postgres.Querier,
+	snowflakeNode *snowflake.Node,
+	valuator valuation.PriceValuator,
+	arg HandOverPlayerParams,
+) (TransferRecord, error) {
+	// 1. add money to seller
+	if err := addTeamBudgetWithQuerier(ctx, querier, AddTeamBudgetParams{
+		ID:     arg.SellerTeamID,
+		Budget: arg.Price - arg.Tax,
 	}); err != nil {
-		return postgres.Rollback(ctx, tx, err)
+		return TransferRecord{}, err
 	}
 
-	// 3. transfer player
-	// 3.1 select player
-	player, err := getPlayerByIDWithQuerier(ctx, tx, currentTransfer.PlayerID)
+	// 2. transfer player
+	// 2.1 select player
+	player, err := getPlayerByIDWithQuerier(ctx, querier, arg.PlayerID)
 	if err != nil {
-		return postgres.Rollback(ctx, tx, err)
+		return TransferRecord{}, err
 	}
 
-	// 3.2 calculate random value rise
-	rand := rand.New(rand.NewSource(time.Now().UnixNano()))
-	multiplier := (0.1 + rand.Float64()*0.9) + 1
-	newPrice := int64(float64(player.Price) * multiplier)
+	// 2.2 value the player after the sale
+	sale := valuation.Sale{
+		Price:        player.Price,
+		SoldPrice:    arg.Price,
+		PositionCode: player.PositionCode,
+		Overall: rating.Overall(player.PositionCode, rating.Attributes{
+			Pace:        player.Pace,
+			Shooting:    player.Shooting,
+			Passing:     player.Passing,
+			Defending:   player.Defending,
+			Goalkeeping: player.Goalkeeping,
+			Stamina:     player.Stamina,
+		}),
+		Age: player.Age,
+	}
+	if aware, ok := valuator.(valuation.DemandAware); ok {
+		if err := querier.QueryRow(
+			ctx,
+			countRecentSalesByPosition,
+			player.PositionCode,
+			time.Now().Add(-aware.DemandWindow()),
+		).Scan(&sale.PositionSales, &sale.MarketSales); err != nil {
+			return TransferRecord{}, err
+		}
+	}
+	newPrice := valuator.Value(sale)
 
-	// 3.3 transfer player to other team
-	if err := updatePlayerPriceAndTeamWithQuerrier(ctx, tx, UpdatePlayerPriceAndTeamParams{
+	// 2.3 transfer player to other team
+	if err := updatePlayerPriceAndTeamWithQuerrier(ctx, querier, UpdatePlayerPriceAndTeamParams{
 		ID:     player.ID,
 		Price:  newPrice,
-		TeamID: buyerTeam.ID,
+		TeamID: arg.BuyerTeamID,
 	}); err != nil {
-		return postgres.Rollback(ctx, tx, err)
+		return TransferRecord{}, err
 	}
 
-	// 4. insert into transfer_records
-	if err := insertTransferRecordWithQuerier(ctx, tx, InsertTransferRecordParams{
-		ID: r.snowflakeNode.Generate().Int64(),
+	// 2.4 record the new price
+	if err := insertPlayerPriceHistoryWithQuerier(ctx, querier, InsertPlayerPriceHistoryParams{
+		ID:       snowflakeNode.Generate().Int64(),
 		PlayerID: player.ID,
-		SellerTeamID: currentTransfer.SellerTeamID,
-		BuyerTeamID: buyerTeam.ID,
-		SoldPrice: currentTransfer.Price,
-		ListedAt: currentTransfer.ListedAt,
+		Price:    newPrice,
+		Reason:   "SALE",
 	}); err != nil {
-		return postgres.Rollback(ctx, tx, err)
+		return TransferRecord{}, err
 	}
 
-	return tx.Commit(ctx)
-}
+	// 3. offers made to the previous owner are void
+	if err := releaseOffersByPlayerIDWithQuerier(ctx, querier, player.ID); err != nil {
+		return TransferRecord{}, err
+	}
 
+	// 3.1 let both sides know
+	if err := insertSaleNotificationsWithQuerier(ctx, querier, snowflakeNode, arg); err != nil {
+		return TransferRecord{}, err
+	}
+
+	// 4. insert into transfer_records
+	record, err := insertTransferRecordWithQuerier(ctx, querier, InsertTransferRecordParams{
+		ID:           snowflakeNode.Generate().Int64(),
+		PlayerID:     player.ID,
+		SellerTeamID: arg.SellerTeamID,
+		BuyerTeamID:  arg.BuyerTeamID,
+		SoldPrice:    arg.Price,
+		Tax:          arg.Tax,
+		ListedAt:     arg.ListedAt,
+	})
+	if err != nil {
+		return TransferRecord{}, err
+	}
+
+	// 5. announce the sale
+	if err := insertOutboxEventWithQuerier(
+		ctx,
+		querier,
+		snowflakeNode,
+		OutboxTopicTransferSold,
+		OutboxRef{ID: record.ID},
+	); err != nil {
+		return TransferRecord{}, err
+	}
+
+	return record, nil
+}
@@ -27,8 +27,9 @@ func NewTransferRecordRepository(
 	return &pgTransferRecordRepository{db: db, snowflakeNode: snowflakeNode}
 }
 
-const insertTransferRecord = `-- name: InsertTransferRecord :exec
-INSERT INTO transfer_records (id, player_id, seller_team_id, buyer_team_id, sold_price, listed_at) VALUES ($1, $2, $3, $4, $5, $6)
+const insertTransferRecord = `-- name: InsertTransferRecord :one
+INSERT INTO transfer_records (id, player_id, seller_team_id, buyer_team_id, sold_price, tax, listed_at) VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, player_id, seller_team_id, buyer_team_id, sold_price, tax, listed_at, sold_at
 `
 
 type InsertTransferRecordParams struct {
@@ -37,6 +38,7 @@ type InsertTransferRecordParams struct {
 	SellerTeamID int64              `json:"seller_team_id"`
 	BuyerTeamID  int64              `json:"buyer_team_id"`
 	SoldPrice    int64              `json:"sold_price"`
+	Tax          int64              `json:"tax"`
 	ListedAt     pgtype.Timestamptz `json:"listed_at"`
 }
 
@@ -44,20 +46,32 @@ func insertTransferRecordWithQuerier(
 	ctx context.Context,
 	querier postgres.Querier,
 	arg InsertTransferRecordParams,
-) error {
-	_, err := querier.Exec(ctx, insertTransferRecord,
+) (TransferRecord, error) {
+	row := querier.QueryRow(ctx, insertTransferRecord,
 		arg.ID,
 		arg.PlayerID,
 		arg.SellerTeamID,
 		arg.BuyerTeamID,
 		arg.SoldPrice,
+		arg.Tax,
 		arg.ListedAt,
 	)
-	return err
+	var i TransferRecord
+	err := row.Scan(
+		&i.ID,
+		&i.PlayerID,
+		&i.SellerTeamID,
+		&i.BuyerTeamID,
+		&i.SoldPrice,
+		&i.Tax,
+		&i.ListedAt,
+		&i.SoldAt,
+	)
+	return i, err
 }
 
 const getTransferRecordByID = `-- name: GetTransferRecordByID :one
-SELECT id, player_id, seller_team_id, buyer_team_id, sold_price, listed_at, sold_at FROM transfer_records WHERE id = $1
+SELECT id, player_id, seller_team_id, buyer_team_id, sold_price, tax, listed_at, sold_at FROM transfer_records WHERE id = $1
 `
 
 func (r *pgTransferRecordRepository) GetTransferRecordByID(
@@ -72,6 +86,7 @@ func (r *pgTransferRecordRepository) GetTransferRecordByID(
 		&i.SellerTeamID,
 		&i.BuyerTeamID,
 		&i.SoldPrice,
+		&i.Tax,
 		&i.ListedAt,
 		&i.SoldAt,
 	)
@@ -79,19 +94,44 @@ func (r *pgTransferRecordRepository) GetTransferRecordByID(
 }
 
 const listTransferRecords = `-- name: ListTransferRecords :many
-SELECT id, player_id, seller_team_id, buyer_team_id, sold_price, listed_at, sold_at FROM transfer_records WHERE id > $1 ORDER BY id LIMIT $2
+SELECT id, player_id, seller_team_id, buyer_team_id, sold_price, tax, listed_at, sold_at FROM transfer_records
+WHERE id > $1
+  AND ($3::BIGINT IS NULL OR player_id = $3)
+  AND ($4::BIGINT IS NULL OR seller_team_id = $4)
+  AND ($5::BIGINT IS NULL OR buyer_team_id = $5)
+  AND ($6::BIGINT IS NULL OR seller_team_id = $6 OR buyer_team_id = $6)
+  AND ($7::TIMESTAMPTZ IS NULL OR sold_at >= $7)
+  AND ($8::TIMESTAMPTZ IS NULL OR sold_at < $8)
+ORDER BY id LIMIT $2
 `
 
+// ListTransferRecordsParams filters records, unset (invalid) fields are ignored.
+// TeamID matches either side of the sale, SoldTo is exclusive
 type ListTransferRecordsParams struct {
-	ID    int64 `json:"id"`
-	Limit int32 `json:"limit"`
+	ID           int64              `json:"id"`
+	Limit        int32              `json:"limit"`
+	PlayerID     pgtype.Int8        `json:"player_id"`
+	SellerTeamID pgtype.Int8        `json:"seller_team_id"`
+	BuyerTeamID  pgtype.Int8        `json:"buyer_team_id"`
+	TeamID       pgtype.Int8        `json:"team_id"`
+	SoldFrom     pgtype.Timestamptz `json:"sold_from"`
+	SoldTo       pgtype.Timestamptz `json:"sold_to"`
 }
 
 func (r *pgTransferRecordRepository) ListTransferRecords(
 	ctx context.Context,
 	arg ListTransferRecordsParams,
 ) ([]TransferRecord, error) {
-	rows, err := r.db.Query(ctx, listTransferRecords, arg.ID, arg.Limit)
+	rows, err := r.db.Query(ctx, listTransferRecords,
+		arg.ID,
+		arg.Limit,
+		arg.PlayerID,
+		arg.SellerTeamID,
+		arg.BuyerTeamID,
+		arg.TeamID,
+		arg.SoldFrom,
+		arg.SoldTo,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -105,6 +145,7 @@ func (r *pgTransferRecordRepository) ListTransferRecords(
 			&i.SellerTeamID,
 			&i.BuyerTeamID,
 			&i.SoldPrice,
+			&i.Tax,
 			&i.ListedAt,
 			&i.SoldAt,
 		); err != nil {
@@ -0,0 +1,185 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/bwmarrin/snowflake"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+//go:generate mockgen -destination=mock/mock_transfer_window.go -package=mock github.com/hexley21/soccer-manager/internal/soccer-manager/repository TransferWindowRepository
+type TransferWindowRepository interface {
+	GetTransferWindowByID(ctx context.Context, id int64) (TransferWindow, error)
+	ListTransferWindows(ctx context.Context, arg ListTransferWindowsParams) ([]TransferWindow, error)
+	GetCurrentTransferWindow(ctx context.Context) (TransferWindow, error)
+	GetNextTransferWindow(ctx context.Context) (TransferWindow, error)
+	HasTransferWindows(ctx context.Context) (bool, error)
+
+	InsertTransferWindow(ctx context.Context, arg InsertTransferWindowParams) (TransferWindow, error)
+	UpdateTransferWindow(ctx context.Context, arg UpdateTransferWindowParams) (TransferWindow, error)
+	DeleteTransferWindowByID(ctx context.Context, id int64) error
+}
+
+type pgTransferWindowRepository struct {
+	db            *pgxpool.Pool
+	snowflakeNode *snowflake.Node
+}
+
+func NewTransferWindowRepository(db *pgxpool.Pool, snowflakeNode *snowflake.Node) *pgTransferWindowRepository {
+	return &pgTransferWindowRepository{
+		db:            db,
+		snowflakeNode: snowflakeNode,
+	}
+}
+
+const getTransferWindowByID = `-- name: GetTransferWindowByID :one
+SELECT id, name, opens_at, closes_at, created_at FROM transfer_windows WHERE id = $1
+`
+
+func (r *pgTransferWindowRepository) GetTransferWindowByID(ctx context.Context, id int64) (TransferWindow, error) {
+	return scanTransferWindow(r.db.QueryRow(ctx, getTransferWindowByID, id))
+}
+
+const listTransferWindows = `-- name: ListTransferWindows :many
+SELECT id, name, opens_at, closes_at, created_at FROM transfer_windows WHERE id > $1 ORDER BY id LIMIT $2
+`
+
+type ListTransferWindowsParams struct {
+	ID    int64 `json:"id"`
+	Limit int32 `json:"limit"`
+}
+
+func (r *pgTransferWindowRepository) ListTransferWindows(
+	ctx context.Context,
+	arg ListTransferWindowsParams,
+) ([]TransferWindow, error) {
+	rows, err := r.db.Query(ctx, listTransferWindows, arg.ID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []TransferWindow{}
+	for rows.Next() {
+		var i TransferWindow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.OpensAt,
+			&i.ClosesAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCurrentTransferWindow = `-- name: GetCurrentTransferWindow :one
+SELECT id, name, opens_at, closes_at, created_at FROM transfer_windows WHERE opens_at <= now() AND closes_at > now()
+`
+
+// GetCurrentTransferWindow returns the window the market is open in, pgx.ErrNoRows when closed
+func (r *pgTransferWindowRepository) GetCurrentTransferWindow(ctx context.Context) (TransferWindow, error) {
+	return scanTransferWindow(r.db.QueryRow(ctx, getCurrentTransferWindow))
+}
+
+const getNextTransferWindow = `-- name: GetNextTransferWindow :one
+SELECT id, name, opens_at, closes_at, created_at FROM transfer_windows WHERE opens_at > now() ORDER BY opens_at LIMIT 1
+`
+
+// GetNextTransferWindow returns the closest upcoming window, pgx.ErrNoRows when none is scheduled
+func (r *pgTransferWindowRepository) GetNextTransferWindow(ctx context.Context) (TransferWindow, error) {
+	return scanTransferWindow(r.db.QueryRow(ctx, getNextTransferWindow))
+}
+
+const hasTransferWindows = `-- name: HasTransferWindows :one
+SELECT EXISTS (SELECT 1 FROM transfer_windows)
+`
+
+// HasTransferWindows tells whether any window was ever scheduled
+func (r *pgTransferWindowRepository) HasTransferWindows(ctx context.Context) (bool, error) {
+	var exists bool
+	err := r.db.QueryRow(ctx, hasTransferWindows).Scan(&exists)
+	return exists, err
+}
+
+const insertTransferWindow = `-- name: InsertTransferWindow :one
+INSERT INTO transfer_windows (id, name, opens_at, closes_at) VALUES ($1, $2, $3, $4)
+RETURNING id, name, opens_at, closes_at, created_at
+`
+
+type InsertTransferWindowParams struct {
+	Name     string             `json:"name"`
+	OpensAt  pgtype.Timestamptz `json:"opens_at"`
+	ClosesAt pgtype.Timestamptz `json:"closes_at"`
+}
+
+func (r *pgTransferWindowRepository) InsertTransferWindow(
+	ctx context.Context,
+	arg InsertTransferWindowParams,
+) (TransferWindow, error) {
+	return scanTransferWindow(r.db.QueryRow(ctx, insertTransferWindow,
+		r.snowflakeNode.Generate().Int64(),
+		arg.Name,
+		arg.OpensAt,
+		arg.ClosesAt,
+	))
+}
+
+const updateTransferWindow = `-- name: UpdateTransferWindow :one
+UPDATE transfer_windows SET name = $2, opens_at = $3, closes_at = $4 WHERE id = $1
+RETURNING id, name, opens_at, closes_at, created_at
+`
+
+type UpdateTransferWindowParams struct {
+	ID       int64              `json:"id"`
+	Name     string             `json:"name"`
+	OpensAt  pgtype.Timestamptz `json:"opens_at"`
+	ClosesAt pgtype.Timestamptz `json:"closes_at"`
+}
+
+func (r *pgTransferWindowRepository) UpdateTransferWindow(
+	ctx context.Context,
+	arg UpdateTransferWindowParams,
+) (TransferWindow, error) {
+	return scanTransferWindow(r.db.QueryRow(ctx, updateTransferWindow,
+		arg.ID,
+		arg.Name,
+		arg.OpensAt,
+		arg.ClosesAt,
+	))
+}
+
+const deleteTransferWindowByID = `-- name: DeleteTransferWindowByID :exec
+DELETE FROM transfer_windows WHERE id = $1
+`
+
+func (r *pgTransferWindowRepository) DeleteTransferWindowByID(ctx context.Context, id int64) error {
+	res, err := r.db.Exec(ctx, deleteTransferWindowByID, id)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func scanTransferWindow(row pgx.Row) (TransferWindow, error) {
+	var i TransferWindow
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.OpensAt,
+		&i.ClosesAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
@@ -2,11 +2,20 @@ package repository
 
 import (
 	"context"
+	"errors"
 
 	"github.com/bwmarrin/snowflake"
+	"github.com/hexley21/soccer-manager/pkg/infra/postgres"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// Roles as stored in the users table
+const (
+	UserRoleUser  = "USER"
+	UserRoleAdmin = "ADMIN"
+)
+
 //go:generate mockgen -destination=mock/mock_user.go -package=mock github.com/hexley21/soccer-manager/internal/soccer-manager/repository UserRepository
 type UserRepository interface {
 	CreateUser(ctx context.Context, arg CreateUserParams) (CreateUserRow, error)
@@ -17,6 +26,8 @@ type UserRepository interface {
 	GetAuth(ctx context.Context, username string) (GetAuthRow, error)
 	ListUsersCursor(ctx context.Context, arg ListUsersCursorParams) ([]ListUsersCursorRow, error)
 	UpdateUserHash(ctx context.Context, arg UpdateUserHashParams) error
+	UpdateUserRole(ctx context.Context, arg UpdateUserRoleParams) (GetUserByIDRow, error)
+	PromoteFirstAdmin(ctx context.Context, username string) (int64, error)
 	CheckUserExists(ctx context.Context, id int64) (bool, error)
 }
 
@@ -48,16 +59,43 @@ type CreateUserRow struct {
 	Role     string `json:"role"`
 }
 
+// CreateUser inserts the user with a pending provisioning and OutboxTopicSignUp,
+// so the team is provisioned even if the process dies
 func (r *pgUserRepo) CreateUser(ctx context.Context, arg CreateUserParams) (CreateUserRow, error) {
-	row := r.db.QueryRow(ctx, createUser,
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return CreateUserRow{}, err
+	}
+
+	row := tx.QueryRow(ctx, createUser,
 		r.snowflakeNode.Generate().Int64(),
 		arg.Username,
 		arg.Role,
 		arg.Hash,
 	)
 	var i CreateUserRow
-	err := row.Scan(&i.ID, &i.Username, &i.Role)
-	return i, err
+	if err := row.Scan(&i.ID, &i.Username, &i.Role); err != nil {
+		return CreateUserRow{}, postgres.Rollback(ctx, tx, err)
+	}
+
+	if err := insertProvisioningWithQuerier(ctx, tx, i.ID); err != nil {
+		return CreateUserRow{}, postgres.Rollback(ctx, tx, err)
+	}
+
+	if err := insertOutboxEventWithQuerier(
+		ctx,
+		tx,
+		r.snowflakeNode,
+		OutboxTopicSignUp,
+		OutboxSignUp{UserID: i.ID, Username: i.Username},
+	); err != nil {
+		return CreateUserRow{}, postgres.Rollback(ctx, tx, err)
+	}
+
+	return i, tx.Commit(ctx)
 }
 
 const deleteUser = `-- name: DeleteUser :exec
@@ -188,22 +226,176 @@ const updateUserHash = `-- name: UpdateUserHash :exec
 UPDATE users SET hash = $2 WHERE id = $1
 `
 
+// UpdateUserHashParams spares the session of ExceptSessionID, zero revokes every session
 type UpdateUserHashParams struct {
-	ID   int64  `json:"id"`
-	Hash string `json:"hash"`
+	ID              int64  `json:"id"`
+	Hash            string `json:"hash"`
+	ExceptSessionID int64  `json:"except_session_id"`
 }
 
+// UpdateUserHash changes the password hash and revokes other sessions of the user along,
+// so a session opened with the old password can't outlive it
+//
+// If user not found - ErrNotFound
 func (r *pgUserRepo) UpdateUserHash(ctx context.Context, arg UpdateUserHashParams) error {
-	res, err := r.db.Exec(ctx, updateUserHash, arg.ID, arg.Hash)
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
 	if err != nil {
 		return err
 	}
 
+	res, err := tx.Exec(ctx, updateUserHash, arg.ID, arg.Hash)
+	if err != nil {
+		return postgres.Rollback(ctx, tx, err)
+	}
 	if res.RowsAffected() == 0 {
-		return ErrNotFound
+		return postgres.Rollback(ctx, tx, ErrNotFound)
 	}
 
-	return nil
+	if _, err := revokeUserSessionsWithQuerier(ctx, tx, RevokeUserSessionsParams{
+		UserID:   arg.ID,
+		ExceptID: arg.ExceptSessionID,
+		Reason:   SessionRevokedPasswordChange,
+	}); err != nil {
+		return postgres.Rollback(ctx, tx, err)
+	}
+
+	return tx.Commit(ctx)
+}
+
+const lockUsersByRole = `-- name: LockUsersByRole :many
+SELECT id FROM users WHERE role = $1 FOR UPDATE
+`
+
+const getUserForUpdate = `-- name: GetUserForUpdate :one
+SELECT id, username, role FROM users WHERE id = $1 FOR UPDATE
+`
+
+const updateUserRole = `-- name: UpdateUserRole :exec
+UPDATE users SET role = $2 WHERE id = $1
+`
+
+type UpdateUserRoleParams struct {
+	ID   int64  `json:"id"`
+	Role string `json:"role"`
+}
+
+// UpdateUserRole changes the role and revokes every session of the user,
+// so tokens carrying the old role stop working. Admins are locked first, so the last one can't be demoted
+//
+// If user not found - ErrNotFound
+// If user already has the role - ErrConflict
+// If demoting the last admin - ErrNotAllowed
+func (r *pgUserRepo) UpdateUserRole(ctx context.Context, arg UpdateUserRoleParams) (GetUserByIDRow, error) {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return GetUserByIDRow{}, err
+	}
+
+	admins, err := lockUsersByRoleWithQuerier(ctx, tx, UserRoleAdmin)
+	if err != nil {
+		return GetUserByIDRow{}, postgres.Rollback(ctx, tx, err)
+	}
+
+	var i GetUserByIDRow
+	if err := tx.QueryRow(ctx, getUserForUpdate, arg.ID).Scan(&i.ID, &i.Username, &i.Role); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return GetUserByIDRow{}, postgres.Rollback(ctx, tx, ErrNotFound)
+		}
+		return GetUserByIDRow{}, postgres.Rollback(ctx, tx, err)
+	}
+
+	if i.Role == arg.Role {
+		return GetUserByIDRow{}, postgres.Rollback(ctx, tx, ErrConflict)
+	}
+	if i.Role == UserRoleAdmin && len(admins) <= 1 {
+		return GetUserByIDRow{}, postgres.Rollback(ctx, tx, ErrNotAllowed)
+	}
+
+	if _, err := tx.Exec(ctx, updateUserRole, arg.ID, arg.Role); err != nil {
+		return GetUserByIDRow{}, postgres.Rollback(ctx, tx, err)
+	}
+
+	if _, err := revokeUserSessionsWithQuerier(ctx, tx, RevokeUserSessionsParams{
+		UserID: arg.ID,
+		Reason: SessionRevokedRoleChange,
+	}); err != nil {
+		return GetUserByIDRow{}, postgres.Rollback(ctx, tx, err)
+	}
+
+	i.Role = arg.Role
+	return i, tx.Commit(ctx)
+}
+
+const promoteFirstAdmin = `-- name: PromoteFirstAdmin :one
+UPDATE users SET role = $2 WHERE username = $1 RETURNING id
+`
+
+// PromoteFirstAdmin makes the user an admin only while there is no admin at all,
+// sessions of the user are revoked like on any role change
+//
+// If an admin already exists - ErrConflict
+// If user not found - ErrNotFound
+func (r *pgUserRepo) PromoteFirstAdmin(ctx context.Context, username string) (int64, error) {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	admins, err := lockUsersByRoleWithQuerier(ctx, tx, UserRoleAdmin)
+	if err != nil {
+		return 0, postgres.Rollback(ctx, tx, err)
+	}
+	if len(admins) != 0 {
+		return 0, postgres.Rollback(ctx, tx, ErrConflict)
+	}
+
+	var id int64
+	if err := tx.QueryRow(ctx, promoteFirstAdmin, username, UserRoleAdmin).Scan(&id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, postgres.Rollback(ctx, tx, ErrNotFound)
+		}
+		return 0, postgres.Rollback(ctx, tx, err)
+	}
+
+	if _, err := revokeUserSessionsWithQuerier(ctx, tx, RevokeUserSessionsParams{
+		UserID: id,
+		Reason: SessionRevokedRoleChange,
+	}); err != nil {
+		return 0, postgres.Rollback(ctx, tx, err)
+	}
+
+	return id, tx.Commit(ctx)
+}
+
+// lockUsersByRoleWithQuerier locks users of the role until the transaction ends, new ones can't sneak in
+// since every role change locks them too
+func lockUsersByRoleWithQuerier(ctx context.Context, querier postgres.Querier, role string) ([]int64, error) {
+	rows, err := querier.Query(ctx, lockUsersByRole, role)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int64{}
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
 const checkUserExists = `-- name: CheckUserExists :one
@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+//go:generate mockgen -destination=mock/mock_watchlist.go -package=mock github.com/hexley21/soccer-manager/internal/soccer-manager/repository WatchlistRepository
+type WatchlistRepository interface {
+	ListWatchlistByUserID(ctx context.Context, arg ListWatchlistByUserIDParams) ([]WatchlistPlayer, error)
+	ListWatcherIDsByPlayerID(ctx context.Context, arg ListWatcherIDsByPlayerIDParams) ([]int64, error)
+
+	InsertWatchlist(ctx context.Context, arg InsertWatchlistParams) error
+	DeleteWatchlist(ctx context.Context, arg DeleteWatchlistParams) error
+}
+
+type pgWatchlistRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewWatchlistRepository(db *pgxpool.Pool) *pgWatchlistRepository {
+	return &pgWatchlistRepository{
+		db: db,
+	}
+}
+
+const listWatchlistByUserID = `-- name: ListWatchlistByUserID :many
+SELECT p.id, p.team_id, p.first_name, p.last_name, p.age, p.position_code, p.price, t.id, t.price, w.created_at
+FROM watchlist w
+JOIN players p ON p.id = w.player_id
+LEFT JOIN transfers t ON t.player_id = p.id AND (t.expires_at IS NULL OR t.expires_at > now())
+WHERE w.user_id = $1 AND w.player_id > $2
+ORDER BY w.player_id
+LIMIT $3
+`
+
+type ListWatchlistByUserIDParams struct {
+	UserID   int64 `json:"user_id"`
+	PlayerID int64 `json:"player_id"`
+	Limit    int32 `json:"limit"`
+}
+
+func (r *pgWatchlistRepository) ListWatchlistByUserID(
+	ctx context.Context,
+	arg ListWatchlistByUserIDParams,
+) ([]WatchlistPlayer, error) {
+	rows, err := r.db.Query(ctx, listWatchlistByUserID, arg.UserID, arg.PlayerID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []WatchlistPlayer{}
+	for rows.Next() {
+		var i WatchlistPlayer
+		if err := rows.Scan(
+			&i.PlayerID,
+			&i.TeamID,
+			&i.FirstName,
+			&i.LastName,
+			&i.Age,
+			&i.PositionCode,
+			&i.Price,
+			&i.TransferID,
+			&i.TransferPrice,
+			&i.WatchedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWatcherIDsByPlayerID = `-- name: ListWatcherIDsByPlayerID :many
+SELECT w.user_id FROM watchlist w
+WHERE w.player_id = $1
+  AND NOT EXISTS (SELECT 1 FROM teams t WHERE t.id = $2 AND t.user_id = w.user_id)
+`
+
+type ListWatcherIDsByPlayerIDParams struct {
+	PlayerID int64 `json:"player_id"`
+	// watchers owning this team are left out
+	TeamID int64 `json:"team_id"`
+}
+
+func (r *pgWatchlistRepository) ListWatcherIDsByPlayerID(
+	ctx context.Context,
+	arg ListWatcherIDsByPlayerIDParams,
+) ([]int64, error) {
+	rows, err := r.db.Query(ctx, listWatcherIDsByPlayerID, arg.PlayerID, arg.TeamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int64{}
+	for rows.Next() {
+		var userID int64
+		if err := rows.Scan(&userID); err != nil {
+			return nil, err
+		}
+		items = append(items, userID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const insertWatchlist = `-- name: InsertWatchlist :exec
+INSERT INTO watchlist (user_id, player_id) VALUES ($1, $2)
+`
+
+type InsertWatchlistParams struct {
+	UserID   int64 `json:"user_id"`
+	PlayerID int64 `json:"player_id"`
+}
+
+func (r *pgWatchlistRepository) InsertWatchlist(ctx context.Context, arg InsertWatchlistParams) error {
+	_, err := r.db.Exec(ctx, insertWatchlist, arg.UserID, arg.PlayerID)
+	return err
+}
+
+const deleteWatchlist = `-- name: DeleteWatchlist :exec
+DELETE FROM watchlist WHERE user_id = $1 AND player_id = $2
+`
+
+type DeleteWatchlistParams struct {
+	UserID   int64 `json:"user_id"`
+	PlayerID int64 `json:"player_id"`
+}
+
+func (r *pgWatchlistRepository) DeleteWatchlist(ctx context.Context, arg DeleteWatchlistParams) error {
+	res, err := r.db.Exec(ctx, deleteWatchlist, arg.UserID, arg.PlayerID)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
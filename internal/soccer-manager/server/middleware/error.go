@@ -5,6 +5,7 @@ import "github.com/labstack/echo/v4"
 const (
 	ErrAuthHeaderRequired = "authorization header is required"
 	ErrInvalidToken       = "invalid token"
+	ErrSessionRevoked     = "session is no longer active"
 	ErrInsufficientRights = "insufficient rights"
 )
 
@@ -24,7 +24,7 @@ func Test_JWTAuth(t *testing.T) {
 	t.Run("valid token", func(t *testing.T) {
 		mockManager.EXPECT().
 			ParseTokenString("validToken").
-			Return(access.NewData(123, domain.UserRoleADMIN), nil)
+			Return(access.NewData(123, 1, domain.UserRoleADMIN), nil)
 
 		req := httptest.NewRequest(http.MethodPost, "/", nil)
 		req.Header.Set("Authorization", "Bearer validToken")
@@ -37,7 +37,7 @@ func Test_JWTAuth(t *testing.T) {
 
 		assert.Equal(t, http.StatusOK, rec.Code)
 		assert.Equal(t, "test", rec.Body.String())
-		assert.Equal(t, access.NewData(123, domain.UserRoleADMIN), ctx.Get(access.CtxKey))
+		assert.Equal(t, access.NewData(123, 1, domain.UserRoleADMIN), ctx.Get(access.CtxKey))
 	})
 
 	t.Run("invalid token", func(t *testing.T) {
@@ -0,0 +1,35 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/jwt/access"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/service"
+	"github.com/labstack/echo/v4"
+)
+
+// ActiveSession rejects access tokens of revoked or expired sessions, so logging out
+// or changing the role takes effect before the token expires. Place it after JWTAuth
+func ActiveSession(sessionService service.SessionService) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			userData, ok := c.Get(access.CtxKey).(access.Data)
+			if !ok {
+				return JSONErr(c, http.StatusUnauthorized, ErrInvalidToken)
+			}
+
+			err := sessionService.CheckSession(c.Request().Context(), userData.UserID, userData.SessionID)
+			if err != nil {
+				if errors.Is(err, service.ErrSessionNotFound) {
+					return JSONErr(c, http.StatusUnauthorized, ErrSessionRevoked)
+				}
+
+				c.Logger().Errorf("failed to check session %d: %v", userData.SessionID, err)
+				return err
+			}
+
+			return next(c)
+		}
+	}
+}
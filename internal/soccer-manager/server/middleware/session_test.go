@@ -0,0 +1,71 @@
+package middleware_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/jwt/access"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/server/middleware"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/service"
+	mock_service "github.com/hexley21/soccer-manager/internal/soccer-manager/service/mock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_ActiveSession(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_service.NewMockSessionService(ctrl)
+	mw := middleware.ActiveSession(mockService)
+
+	serve := func(userData any) (*httptest.ResponseRecorder, error) {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		ctx := echo.New().NewContext(req, rec)
+		if userData != nil {
+			ctx.Set(access.CtxKey, userData)
+		}
+
+		err := mw(func(c echo.Context) error {
+			return c.String(http.StatusOK, "test")
+		})(ctx)
+
+		return rec, err
+	}
+
+	t.Run("active session", func(t *testing.T) {
+		mockService.EXPECT().CheckSession(context.Background(), int64(123), int64(1)).Return(nil)
+
+		rec, err := serve(access.NewData(123, 1, domain.UserRoleUSER))
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "test", rec.Body.String())
+	})
+
+	t.Run("revoked session", func(t *testing.T) {
+		mockService.EXPECT().CheckSession(context.Background(), int64(123), int64(2)).Return(service.ErrSessionNotFound)
+
+		rec, err := serve(access.NewData(123, 2, domain.UserRoleADMIN))
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("failed check", func(t *testing.T) {
+		mockService.EXPECT().CheckSession(context.Background(), int64(123), int64(3)).Return(errors.New("db down"))
+
+		_, err := serve(access.NewData(123, 3, domain.UserRoleUSER))
+		assert.ErrorContains(t, err, "db down")
+	})
+
+	t.Run("no user data", func(t *testing.T) {
+		rec, err := serve(nil)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+}
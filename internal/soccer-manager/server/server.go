@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	"os"
 	"sync"
@@ -12,12 +13,20 @@ import (
 	"github.com/bwmarrin/snowflake"
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/delivery"
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/delivery/http/v1"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/delivery/http/well_known"
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/event"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/jwt"
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/jwt/access"
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/jwt/refresh"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/outbox"
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/repository"
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/server/middleware"
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/service"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/stream"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/tax"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/throttle"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/valuation"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/worker"
 	"github.com/hexley21/soccer-manager/pkg/config"
 	"github.com/hexley21/soccer-manager/pkg/hasher"
 	"github.com/hexley21/soccer-manager/pkg/json/jsoniter_json"
@@ -34,6 +43,8 @@ type Server struct {
 	mux           *http.Server
 	metricsMux    *http.Server
 
+	workers []*worker.Worker
+
 	*delivery.Components
 }
 
@@ -49,6 +60,11 @@ func NewServer(
 
 	router := echo.New()
 	router.Debug = !cfg.IsProd
+	ipExtractor, err := realIPExtractor(cfg.HTTP.TrustedProxies)
+	if err != nil {
+		logger.Fatalf("failed to parse trusted proxies: %v", err)
+	}
+	router.IPExtractor = ipExtractor
 	mux := http.Server{
 		Addr:         fmt.Sprintf(":%d", cfg.HTTP.Port),
 		Handler:      router,
@@ -75,6 +91,8 @@ func NewServer(
 	metricsRouter.JSONSerializer = jsoniter_json.NewEcho(jsonProcessor)
 
 	userRepo := repository.NewUserRepo(dbPool, snowflakeNode)
+	provisioningRepo := repository.NewProvisioningRepository(dbPool, snowflakeNode)
+	sessionRepo := repository.NewSessionRepository(dbPool, snowflakeNode)
 	globeRepo := repository.NewGlobeRepo(dbPool, cfg.Globe.TTL)
 
 	teamRepo := repository.NewTeamRepository(dbPool, snowflakeNode)
@@ -82,28 +100,92 @@ func NewServer(
 
 	playerPosRepo := repository.NewPlayerPositionRepo(dbPool)
 	playerRepo := repository.NewPlayerRepository(dbPool, snowflakeNode)
-
-	transferRepo := repository.NewTransferRepository(dbPool, snowflakeNode)
+	playerPriceHistoryRepo := repository.NewPlayerPriceHistoryRepository(dbPool)
+
+	transferTax := tax.Policy{Percent: cfg.Transfers.TaxPercent, Flat: cfg.Transfers.TaxFlat}
+	valuator, err := valuation.New(
+		cfg.Transfers.Valuation.Strategy,
+		cfg.Events.UserSignUp.PlayerBudgetParsed,
+		cfg.Transfers.Valuation.DemandWindow,
+	)
+	if err != nil {
+		logger.Fatalf("failed to create price valuator: %v", err)
+	}
+	transferRepo := repository.NewTransferRepository(dbPool, snowflakeNode, transferTax, valuator)
 	transferRecordRepo := repository.NewTransferRecordRepository(dbPool, snowflakeNode)
+	bidRepo := repository.NewBidRepository(dbPool, snowflakeNode, transferTax, valuator)
+	auctionRepo := repository.NewAuctionRepository(dbPool, snowflakeNode, transferTax, valuator)
+	offerRepo := repository.NewOfferRepository(dbPool, snowflakeNode, transferTax, valuator)
+	transferWindowRepo := repository.NewTransferWindowRepository(dbPool, snowflakeNode)
+	marketStatsRepo := repository.NewMarketStatsRepository(dbPool)
+	watchlistRepo := repository.NewWatchlistRepository(dbPool)
+	notificationRepo := repository.NewNotificationRepository(dbPool, snowflakeNode)
+	outboxRepo := repository.NewOutboxRepository(dbPool)
+
+	matchRepo := repository.NewMatchRepository(dbPool, snowflakeNode)
+	leagueRepo := repository.NewLeagueRepository(dbPool, snowflakeNode)
 
 	services := delivery.Services{
 		GlobeService: service.NewGlobeService(globeRepo),
 
-		AuthService: service.NewAuthService(userRepo, hasher),
-		UserService: service.NewUserService(userRepo, hasher),
+		AuthService:    service.NewAuthService(userRepo, hasher),
+		SessionService: service.NewSessionService(sessionRepo, cfg.JWT.Refresh.TTL),
+		UserService:    service.NewUserService(userRepo, hasher),
+		ProvisioningService: service.NewProvisioningService(
+			provisioningRepo,
+			cfg.Events.UserSignUp.MaxAttempts,
+			cfg.Events.UserSignUp.StuckAfter,
+		),
+
+		NotificationService: service.NewNotificationService(notificationRepo),
+		OutboxService:       service.NewOutboxService(outboxRepo),
 
 		TeamService: service.NewTeamService(teamRepo, teamTranslationRepo),
 
 		PlayerPosService: service.NewPlayerPositionService(playerPosRepo),
-		PlayerService:    service.NewPlayerService(playerRepo),
+		PlayerService:    service.NewPlayerService(playerRepo, playerPriceHistoryRepo),
+		WatchlistService: service.NewWatchlistService(watchlistRepo),
 
-		TransferService:       service.NewTransferService(transferRepo),
+		TransferService:       service.NewTransferService(transferRepo, transferWindowRepo, cfg.Transfers.ListingTTL, cfg.Transfers.BatchSize),
 		TransferRecordService: service.NewTransferRecordService(transferRecordRepo),
+		BidService:            service.NewBidService(bidRepo, transferWindowRepo),
+		AuctionService:        service.NewAuctionService(auctionRepo, cfg.Auctions.BatchSize),
+		OfferService:          service.NewOfferService(offerRepo, transferWindowRepo),
+		TransferWindowService: service.NewTransferWindowService(transferWindowRepo),
+		MarketStatsService:    service.NewMarketStatsService(marketStatsRepo, cfg.Market.StatsTTL, cfg.Market.StatsTopLimit, cfg.Market.StatsMaxDays),
+
+		MatchService:  service.NewMatchService(matchRepo, teamRepo, playerRepo, cfg.Match.RosterLimit),
+		LeagueService: service.NewLeagueService(leagueRepo, playerRepo, cfg.Match.RosterLimit),
+	}
+
+	accessKeys, err := jwt.LoadKeySet(cfg.JWT.Access)
+	if err != nil {
+		logger.Fatalf("failed to load access token keys: %v", err)
+	}
+	refreshKeys, err := jwt.LoadKeySet(cfg.JWT.Refresh)
+	if err != nil {
+		logger.Fatalf("failed to load refresh token keys: %v", err)
 	}
 
+	var loginTracker throttle.Tracker
+	switch cfg.Login.Tracker {
+	case throttle.TrackerMEMORY, "":
+		loginTracker = throttle.NewMemoryTracker(cfg.Login.Window)
+	case throttle.TrackerPOSTGRES:
+		loginTracker = repository.NewLoginFailureRepository(dbPool, cfg.Login.Window)
+	default:
+		logger.Fatalf("unknown login failure tracker: %q", cfg.Login.Tracker)
+	}
+	loginGuard := throttle.NewGuard(
+		loginTracker,
+		throttle.Policy(cfg.Login.Username),
+		throttle.Policy(cfg.Login.IP),
+	)
+
 	jwtManagers := delivery.JWTManagers{
-		Access:  access.NewManager(cfg.JWT.Access),
-		Refresh: refresh.NewManager(cfg.JWT.Refresh),
+		Access:     access.NewManager(accessKeys, cfg.JWT.Access.TTL),
+		Refresh:    refresh.NewManager(refreshKeys, cfg.JWT.Refresh.TTL),
+		AccessKeys: accessKeys,
 	}
 
 	return &Server{
@@ -117,6 +199,9 @@ func NewServer(
 			JWTManagers: &jwtManagers,
 			Services:    &services,
 			EventBus:    evbus.New(),
+			Broker:      stream.NewBroker(cfg.Stream.Buffer),
+			Outbox:      outbox.NewDispatcher(services.OutboxService, cfg.Events.Outbox, logger),
+			LoginGuard:  loginGuard,
 		},
 
 		mux:           &mux,
@@ -143,8 +228,13 @@ func (s *Server) Run() error {
 	}))
 	s.router.Use(echo_middleware.Recover())
 
+	jwtAuth := middleware.JWTAuth(s.JWTManagers.Access)
+	activeSession := middleware.ActiveSession(s.Services.SessionService)
+
 	middlewares := delivery.Middlewares{
-		JWTMiddleware:  middleware.JWTAuth(s.JWTManagers.Access),
+		JWTMiddleware: func(next echo.HandlerFunc) echo.HandlerFunc {
+			return jwtAuth(activeSession(next))
+		},
 		IsAdmin:        middleware.IsAdmin(),
 		AcceptLanguage: middleware.AcceptLanguage(),
 	}
@@ -153,11 +243,24 @@ func (s *Server) Run() error {
 
 	v1Group := apiGroup.Group("/v1")
 
+	s.bootstrapAdmin()
+
 	// register event handlers
 	event.RegisterEventHandlers(s.EventBus, s.Components)
 
+	// start background workers
+	workers, err := worker.RegisterWorkers(s.Components)
+	if err != nil {
+		return err
+	}
+	s.workers = workers
+	for _, w := range s.workers {
+		w.Start()
+	}
+
 	// register api handlers
 	v1.RegisterRoutes(v1Group, s.Components, &middlewares)
+	well_known.RegisterRoutes(s.router.Group("/.well-known"), s.Components)
 
 	// register metric handling
 	s.metricsRouter.GET("/metrics", echoprometheus.NewHandler())
@@ -175,6 +278,51 @@ func (s *Server) Run() error {
 	return httpErrs
 }
 
+// realIPExtractor takes the client address from X-Real-IP set by nginx, which overwrites the one sent by the client.
+// Headers of untrusted peers are ignored, otherwise anyone could pick the address throttling and sessions see
+func realIPExtractor(trustedProxies []string) (echo.IPExtractor, error) {
+	if len(trustedProxies) == 0 {
+		return echo.ExtractIPFromRealIPHeader(), nil
+	}
+
+	options := []echo.TrustOption{
+		echo.TrustLoopback(false),
+		echo.TrustLinkLocal(false),
+		echo.TrustPrivateNet(false),
+	}
+	for _, proxy := range trustedProxies {
+		_, ipNet, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, echo.TrustIPRange(ipNet))
+	}
+
+	return echo.ExtractIPFromRealIPHeader(options...), nil
+}
+
+// bootstrapAdmin promotes the configured user while there is no admin yet, the user has to register first
+func (s *Server) bootstrapAdmin() {
+	if s.Cfg.BootstrapAdmin == "" {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.Cfg.Server.ShutdownTimeout)
+	defer cancel()
+
+	id, err := s.Services.UserService.BootstrapAdmin(ctx, s.Cfg.BootstrapAdmin)
+	switch {
+	case err == nil:
+		s.Logger.Infof("promoted %s (%d) to the first admin", s.Cfg.BootstrapAdmin, id)
+	case errors.Is(err, service.ErrAdminExists):
+		s.Logger.Debugf("admin bootstrap skipped, an admin already exists")
+	case errors.Is(err, service.ErrUserNotFound):
+		s.Logger.Warnf("admin bootstrap skipped, user %s is not registered", s.Cfg.BootstrapAdmin)
+	default:
+		s.Logger.Errorf("failed to bootstrap admin: %v", err)
+	}
+}
+
 func (s *Server) Close() error {
 	var closeErrs error
 	var mu sync.Mutex
@@ -182,6 +330,16 @@ func (s *Server) Close() error {
 	ctx, cancel := context.WithTimeout(context.Background(), s.Cfg.Server.ShutdownTimeout)
 	var wg sync.WaitGroup
 
+	// workers still use the database, stop them first
+	for _, w := range s.workers {
+		if err := w.Stop(ctx); err != nil {
+			closeErrs = errors.Join(closeErrs, fmt.Errorf("worker stop: %w", err))
+		}
+	}
+
+	// live streams never go idle, end them so the server can shut down
+	s.Broker.Close()
+
 	wg.Add(3)
 
 	go shutdownServer(ctx, &wg, &mu, s.mux, "main", &closeErrs)
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/repository"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockgen -destination=mock/mock_auction.go -package=mock github.com/hexley21/soccer-manager/internal/soccer-manager/service AuctionService
+type AuctionService interface {
+	SettleExpiredAuctions(ctx context.Context) ([]domain.AuctionResult, error)
+
+	GetAuctionResultByID(ctx context.Context, id int64) (domain.AuctionResult, error)
+	ListAuctionResults(ctx context.Context, cursor int64, limit int32) ([]domain.AuctionResult, error)
+}
+
+type auctionServiceImpl struct {
+	auctionRepo repository.AuctionRepository
+	batchSize   int32
+}
+
+func NewAuctionService(auctionRepo repository.AuctionRepository, batchSize int32) *auctionServiceImpl {
+	return &auctionServiceImpl{
+		auctionRepo: auctionRepo,
+		batchSize:   batchSize,
+	}
+}
+
+// SettleExpiredAuctions settles up to a batch of expired auctions and returns their results,
+// failed auctions are reported in the joined error and picked up again on the next run
+func (s *auctionServiceImpl) SettleExpiredAuctions(ctx context.Context) ([]domain.AuctionResult, error) {
+	ids, err := s.auctionRepo.ListExpiredAuctionIDs(ctx, s.batchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	var errs error
+	settled := make([]domain.AuctionResult, 0, len(ids))
+	for _, id := range ids {
+		result, err := s.auctionRepo.SettleAuction(ctx, id)
+		if err != nil {
+			// already settled or delisted by the seller meanwhile
+			if errors.Is(err, repository.ErrNotFound) || errors.Is(err, repository.ErrConflict) {
+				continue
+			}
+
+			errs = errors.Join(errs, fmt.Errorf("settle auction %d: %w", id, err))
+			continue
+		}
+		settled = append(settled, domain.AuctionResultAdapter(result))
+	}
+
+	return settled, errs
+}
+
+// GetAuctionResultByID returns the outcome of a settled auction
+//
+// If not found - ErrAuctionResultNotFound
+func (s *auctionServiceImpl) GetAuctionResultByID(ctx context.Context, id int64) (domain.AuctionResult, error) {
+	result, err := s.auctionRepo.GetAuctionResultByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.AuctionResult{}, ErrAuctionResultNotFound
+		}
+
+		return domain.AuctionResult{}, err
+	}
+
+	return domain.AuctionResultAdapter(result), nil
+}
+
+func (s *auctionServiceImpl) ListAuctionResults(
+	ctx context.Context,
+	cursor int64,
+	limit int32,
+) ([]domain.AuctionResult, error) {
+	results, err := s.auctionRepo.ListAuctionResults(ctx, repository.ListAuctionResultsParams{
+		ID:    cursor,
+		Limit: limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]domain.AuctionResult, len(results))
+	for i, r := range results {
+		res[i] = domain.AuctionResultAdapter(r)
+	}
+
+	return res, nil
+}
@@ -19,7 +19,6 @@ type AuthService interface {
 		ctx context.Context,
 		username string,
 		password string,
-		role string,
 	) (domain.User, error)
 	GetUserById(ctx context.Context, userID int64) (domain.User, error)
 }
@@ -61,14 +60,13 @@ func (s *authServiceImpl) Authenticate(
 	return domain.NewUser(auth.ID, username, auth.Role), nil
 }
 
-// CreateUser by generating password hash & inserting into db
+// CreateUser by generating password hash & inserting into db, new users are always USER
 //
 // If username is taken: ErrUsernameTaken
 func (s *authServiceImpl) CreateUser(
 	ctx context.Context,
 	username string,
 	password string,
-	role string,
 ) (domain.User, error) {
 	hash, err := s.hasher.HashPassword(password)
 	if err != nil {
@@ -77,7 +75,7 @@ func (s *authServiceImpl) CreateUser(
 
 	user, err := s.userRepo.CreateUser(ctx, repository.CreateUserParams{
 		Username: username,
-		Role:     role,
+		Role:     string(domain.UserRoleUSER),
 		Hash:     hash,
 	})
 	if err != nil {
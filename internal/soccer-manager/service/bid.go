@@ -0,0 +1,274 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/repository"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+//go:generate mockgen -destination=mock/mock_bid.go -package=mock github.com/hexley21/soccer-manager/internal/soccer-manager/service BidService
+type BidService interface {
+	GetBidByID(ctx context.Context, id int64, userID int64) (domain.Bid, error)
+	ListBidsByTransferID(
+		ctx context.Context,
+		transferID int64,
+		userID int64,
+		cursor int64,
+		limit int32,
+	) ([]domain.Bid, error)
+	ListBidsByUserID(
+		ctx context.Context,
+		userID int64,
+		cursor int64,
+		limit int32,
+	) ([]domain.Bid, error)
+
+	PlaceBid(
+		ctx context.Context,
+		transferID int64,
+		userID int64,
+		amount int64,
+	) (domain.Bid, error)
+	AcceptBid(ctx context.Context, id int64, userID int64) (domain.TransferRecord, error)
+	RejectBid(ctx context.Context, id int64, userID int64) error
+	CounterBid(ctx context.Context, id int64, userID int64, amount int64) error
+	WithdrawBid(ctx context.Context, id int64, userID int64) error
+}
+
+type bidServiceImpl struct {
+	bidRepo            repository.BidRepository
+	transferWindowRepo repository.TransferWindowRepository
+}
+
+func NewBidService(
+	bidRepo repository.BidRepository,
+	transferWindowRepo repository.TransferWindowRepository,
+) *bidServiceImpl {
+	return &bidServiceImpl{
+		bidRepo:            bidRepo,
+		transferWindowRepo: transferWindowRepo,
+	}
+}
+
+// GetBidByID returns a bid visible to the seller or the bidder
+//
+// If not found - ErrBidNotFound
+func (s *bidServiceImpl) GetBidByID(ctx context.Context, id int64, userID int64) (domain.Bid, error) {
+	bid, err := s.bidRepo.GetBidByIDAndUserID(ctx, repository.GetBidByIDAndUserIDParams{
+		ID:     id,
+		UserID: userID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Bid{}, ErrBidNotFound
+		}
+
+		return domain.Bid{}, err
+	}
+
+	return domain.BidAdapter(bid), nil
+}
+
+// ListBidsByTransferID returns bids of the transfer, empty unless the user is the seller
+func (s *bidServiceImpl) ListBidsByTransferID(
+	ctx context.Context,
+	transferID int64,
+	userID int64,
+	cursor int64,
+	limit int32,
+) ([]domain.Bid, error) {
+	bids, err := s.bidRepo.ListBidsByTransferID(ctx, repository.ListBidsByTransferIDParams{
+		TransferID: transferID,
+		UserID:     userID,
+		ID:         cursor,
+		Limit:      limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]domain.Bid, len(bids))
+	for i, b := range bids {
+		res[i] = domain.BidAdapter(b)
+	}
+
+	return res, nil
+}
+
+// ListBidsByUserID returns bids placed by user's team
+func (s *bidServiceImpl) ListBidsByUserID(
+	ctx context.Context,
+	userID int64,
+	cursor int64,
+	limit int32,
+) ([]domain.Bid, error) {
+	bids, err := s.bidRepo.ListBidsByUserID(ctx, repository.ListBidsByUserIDParams{
+		UserID: userID,
+		ID:     cursor,
+		Limit:  limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]domain.Bid, len(bids))
+	for i, b := range bids {
+		res[i] = domain.BidAdapter(b)
+	}
+
+	return res, nil
+}
+
+// PlaceBid submits a bid on the transfer, the amount is reserved from bidder's budget
+// until the bid is accepted, rejected, withdrawn or the listing is gone
+//
+// If transfer not found - ErrTransferNotFound
+// If bid on your own transfer - ErrCantBuyFromYourself
+// If amount exceeds the budget - ErrNotEnoughFunds
+// If team has an open bid on the transfer - ErrBidAlreadyPlaced
+// If auction has ended - ErrAuctionEnded
+// If auction bid is below the opening price or the highest bid - ErrBidTooLow
+// If market is closed - ErrTransferWindowClosed
+func (s *bidServiceImpl) PlaceBid(
+	ctx context.Context,
+	transferID int64,
+	userID int64,
+	amount int64,
+) (domain.Bid, error) {
+	if _, err := openTransferWindow(ctx, s.transferWindowRepo); err != nil {
+		return domain.Bid{}, err
+	}
+
+	bid, err := s.bidRepo.PlaceBid(ctx, repository.PlaceBidParams{
+		TransferID: transferID,
+		UserID:     userID,
+		Amount:     amount,
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return domain.Bid{}, ErrTransferNotFound
+		}
+		if errors.Is(err, repository.ErrConflict) {
+			return domain.Bid{}, ErrCantBuyFromYourself
+		}
+		if errors.Is(err, repository.ErrViolation) {
+			return domain.Bid{}, ErrNotEnoughFunds
+		}
+		if errors.Is(err, repository.ErrNotAllowed) {
+			return domain.Bid{}, ErrAuctionEnded
+		}
+		if errors.Is(err, repository.ErrTooLow) {
+			return domain.Bid{}, ErrBidTooLow
+		}
+
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.Code {
+			case pgerrcode.UniqueViolation:
+				return domain.Bid{}, ErrBidAlreadyPlaced
+			case pgerrcode.CheckViolation:
+				return domain.Bid{}, ErrInvalidArguments
+			}
+		}
+
+		return domain.Bid{}, err
+	}
+
+	return domain.BidAdapter(bid), nil
+}
+
+// AcceptBid executes the same swap as BuyPlayer at the agreed price and returns the sale,
+// seller accepts a pending bid, bidder accepts seller's counter offer
+//
+// If bid not found - ErrBidNotFound
+// If bid is not awaiting user's decision - ErrBidNotAwaiting
+// If bidder can't cover the counter offer - ErrNotEnoughFunds
+// If the bid is on an auction - ErrTransferIsAuction
+// If market is closed - ErrTransferWindowClosed
+func (s *bidServiceImpl) AcceptBid(ctx context.Context, id int64, userID int64) (domain.TransferRecord, error) {
+	if _, err := openTransferWindow(ctx, s.transferWindowRepo); err != nil {
+		return domain.TransferRecord{}, err
+	}
+
+	record, err := s.bidRepo.AcceptBid(ctx, id, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrViolation) {
+			return domain.TransferRecord{}, ErrNotEnoughFunds
+		}
+
+		return domain.TransferRecord{}, mapBidError(err)
+	}
+
+	return domain.TransferRecordAdapter(record), nil
+}
+
+// RejectBid closes the bid by the seller, reserved funds go back to the bidder
+//
+// If bid not found - ErrBidNotFound
+// If bid is already closed - ErrBidNotAwaiting
+// If the bid is on an auction - ErrTransferIsAuction
+func (s *bidServiceImpl) RejectBid(ctx context.Context, id int64, userID int64) error {
+	if err := s.bidRepo.RejectBid(ctx, id, userID); err != nil {
+		return mapBidError(err)
+	}
+
+	return nil
+}
+
+// CounterBid makes seller's counter offer on the bid
+//
+// If bid not found - ErrBidNotFound
+// If bid is already closed - ErrBidNotAwaiting
+// If amount doesn't exceed the bid - ErrCounterTooLow
+// If the bid is on an auction - ErrTransferIsAuction
+func (s *bidServiceImpl) CounterBid(ctx context.Context, id int64, userID int64, amount int64) error {
+	if err := s.bidRepo.CounterBid(ctx, repository.CounterBidParams{
+		ID:     id,
+		UserID: userID,
+		Amount: amount,
+	}); err != nil {
+		if errors.Is(err, repository.ErrViolation) {
+			return ErrCounterTooLow
+		}
+
+		return mapBidError(err)
+	}
+
+	return nil
+}
+
+// WithdrawBid closes the bid by the bidder, reserved funds go back to the bidder
+//
+// If bid not found - ErrBidNotFound
+// If bid is already closed - ErrBidNotAwaiting
+// If the bid is on an ended auction - ErrAuctionEnded
+func (s *bidServiceImpl) WithdrawBid(ctx context.Context, id int64, userID int64) error {
+	if err := s.bidRepo.WithdrawBid(ctx, id, userID); err != nil {
+		if errors.Is(err, repository.ErrNotAllowed) {
+			return ErrAuctionEnded
+		}
+
+		return mapBidError(err)
+	}
+
+	return nil
+}
+
+func mapBidError(err error) error {
+	if errors.Is(err, repository.ErrNotFound) {
+		return ErrBidNotFound
+	}
+	if errors.Is(err, repository.ErrConflict) {
+		return ErrBidNotAwaiting
+	}
+	if errors.Is(err, repository.ErrNotAllowed) {
+		return ErrTransferIsAuction
+	}
+
+	return err
+}
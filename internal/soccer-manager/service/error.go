@@ -6,18 +6,64 @@ var (
 	ErrUserNotFound = errors.New("user not found")
 	ErrIncorrectPassword = errors.New("incorrect user password")
 	ErrUsernameTaken = errors.New("username is taken")
+	ErrRoleUnchanged = errors.New("user already has the role")
+	ErrLastAdmin = errors.New("can't demote the last admin")
+	ErrAdminExists = errors.New("an admin already exists")
+	ErrProvisioningNotFound = errors.New("provisioning not found")
+	ErrProvisioningCompleted = errors.New("provisioning is already completed")
+	ErrProvisioningInProgress = errors.New("provisioning is still in progress")
+
+	ErrSessionNotFound = errors.New("session not found")
+	ErrRefreshTokenReused = errors.New("refresh token was already used")
 
 	ErrTeamNotFound = errors.New("team not found")
+	ErrTeamHasPlayers = errors.New("team already has players")
 	
 	ErrPlayerNotFound = errors.New("player not found")
+	ErrPlayerAlreadyWatched = errors.New("player is already on the watchlist")
+	ErrPlayerNotWatched = errors.New("player is not on the watchlist")
 
 	ErrTransferNotFound = errors.New("transfer not found")
+	ErrInvalidCursor = errors.New("cursor is missing the sort key")
 	ErrPlayerAlreadyInTransfers = errors.New("player is already in transfers")
 	ErrCantBuyFromYourself = errors.New("can't buy from yourself")
 	ErrNotEnoughFunds = errors.New("not enough funds")
+	ErrTransferIsAuction = errors.New("transfer is an auction")
+	ErrAuctionEnded = errors.New("auction has ended")
+	ErrAuctionResultNotFound = errors.New("auction result not found")
+
+	ErrTransferWindowClosed = errors.New("transfer window is closed")
+	ErrTransferWindowNotFound = errors.New("transfer window not found")
+	ErrTransferWindowOverlaps = errors.New("transfer window overlaps another one")
+
+	ErrBidNotFound = errors.New("bid not found")
+	ErrBidAlreadyPlaced = errors.New("team already has an open bid on this transfer")
+	ErrBidNotAwaiting = errors.New("bid is not awaiting your decision")
+	ErrCounterTooLow = errors.New("counter offer must exceed the bid")
+	ErrBidTooLow = errors.New("bid must reach the opening price and exceed the highest bid")
+
+	ErrOfferNotFound = errors.New("offer not found")
+	ErrOfferAlreadyPlaced = errors.New("team already has a pending offer for this player")
+	ErrOfferNotAwaiting = errors.New("offer is not awaiting your decision")
+	ErrPlayerIsListed = errors.New("player is listed, bid on the transfer instead")
 
 	ErrTransferRecordNotFound = errors.New("transfer record not found")
 
+	ErrNotificationNotFound = errors.New("notification not found")
+	ErrOutboxEventNotFound = errors.New("dead outbox event not found")
+
+	ErrMatchNotFound = errors.New("match not found")
+	ErrNotEnoughPlayers = errors.New("not enough players for a match")
+	ErrCantPlayYourself = errors.New("can't play against yourself")
+
+	ErrLeagueNotFound = errors.New("league not found")
+	ErrLeagueStarted = errors.New("league has already started")
+	ErrTeamAlreadyEnrolled = errors.New("team is already enrolled")
+	ErrTeamNotEnrolled = errors.New("team is not enrolled")
+	ErrNotEnoughTeams = errors.New("not enough teams for a league")
+	ErrFixtureNotFound = errors.New("fixture not found")
+	ErrFixtureAlreadyPlayed = errors.New("fixture has already been played")
+
 	ErrNonexistentCode = errors.New("nonexistent code or key")
 	
 	ErrTranslationNotFound = errors.New("translation not found")
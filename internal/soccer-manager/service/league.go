@@ -0,0 +1,361 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/repository"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/tournament"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+//go:generate mockgen -destination=mock/mock_league.go -package=mock github.com/hexley21/soccer-manager/internal/soccer-manager/service LeagueService
+type LeagueService interface {
+	CreateLeague(ctx context.Context, name string) (domain.League, error)
+	GetLeagueByID(ctx context.Context, id int64) (domain.League, error)
+	ListLeagues(ctx context.Context, cursor int64, limit int32) ([]domain.League, error)
+
+	EnrollTeam(ctx context.Context, leagueID int64, teamID int64) error
+	UnenrollTeam(ctx context.Context, leagueID int64, teamID int64) error
+	ListLeagueTeams(ctx context.Context, leagueID int64) ([]domain.Team, error)
+
+	GenerateFixtures(ctx context.Context, leagueID int64) ([]domain.Fixture, error)
+	ListFixtures(ctx context.Context, leagueID int64) ([]domain.Fixture, error)
+	PlayFixture(
+		ctx context.Context,
+		leagueID int64,
+		fixtureID int64,
+		seed int64,
+	) (domain.Fixture, error)
+
+	GetStandings(ctx context.Context, leagueID int64) ([]domain.Standing, error)
+}
+
+type leagueServiceImpl struct {
+	leagueRepo  repository.LeagueRepository
+	playerRepo  repository.PlayerRepository
+	rosterLimit int32
+}
+
+func NewLeagueService(
+	leagueRepo repository.LeagueRepository,
+	playerRepo repository.PlayerRepository,
+	rosterLimit int32,
+) *leagueServiceImpl {
+	return &leagueServiceImpl{
+		leagueRepo:  leagueRepo,
+		playerRepo:  playerRepo,
+		rosterLimit: rosterLimit,
+	}
+}
+
+// CreateLeague creates a new league open for enrollment
+func (s *leagueServiceImpl) CreateLeague(ctx context.Context, name string) (domain.League, error) {
+	league, err := s.leagueRepo.InsertLeague(ctx, name)
+	if err != nil {
+		return domain.League{}, err
+	}
+
+	return domain.LeagueAdapter(league), nil
+}
+
+// GetLeagueByID returns league by id
+//
+// If not found - ErrLeagueNotFound
+func (s *leagueServiceImpl) GetLeagueByID(ctx context.Context, id int64) (domain.League, error) {
+	league, err := s.leagueRepo.GetLeagueByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.League{}, ErrLeagueNotFound
+		}
+
+		return domain.League{}, err
+	}
+
+	return domain.LeagueAdapter(league), nil
+}
+
+// ListLeagues returns a list of leagues
+func (s *leagueServiceImpl) ListLeagues(
+	ctx context.Context,
+	cursor int64,
+	limit int32,
+) ([]domain.League, error) {
+	leagues, err := s.leagueRepo.ListLeaguesCursor(ctx, repository.ListLeaguesCursorParams{
+		ID:    cursor,
+		Limit: limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]domain.League, len(leagues))
+	for i, l := range leagues {
+		res[i] = domain.LeagueAdapter(l)
+	}
+
+	return res, nil
+}
+
+// EnrollTeam adds team to the league, enrollment is only possible before fixtures are generated
+//
+// If league not found - ErrLeagueNotFound
+// If league has started - ErrLeagueStarted
+// If team not found - ErrTeamNotFound
+// If team is already enrolled - ErrTeamAlreadyEnrolled
+func (s *leagueServiceImpl) EnrollTeam(ctx context.Context, leagueID int64, teamID int64) error {
+	if err := s.ensureEnrolling(ctx, leagueID); err != nil {
+		return err
+	}
+
+	err := s.leagueRepo.InsertLeagueTeam(ctx, repository.InsertLeagueTeamParams{
+		LeagueID: leagueID,
+		TeamID:   teamID,
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrLeagueNotFound
+		}
+		if errors.Is(err, repository.ErrConflict) {
+			return ErrLeagueStarted
+		}
+
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.Code {
+			case pgerrcode.UniqueViolation:
+				return ErrTeamAlreadyEnrolled
+			case pgerrcode.ForeignKeyViolation:
+				return ErrTeamNotFound
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// UnenrollTeam removes team from the league, only possible before fixtures are generated
+//
+// If league not found - ErrLeagueNotFound
+// If league has started - ErrLeagueStarted
+// If team is not enrolled - ErrTeamNotEnrolled
+func (s *leagueServiceImpl) UnenrollTeam(ctx context.Context, leagueID int64, teamID int64) error {
+	if err := s.ensureEnrolling(ctx, leagueID); err != nil {
+		return err
+	}
+
+	err := s.leagueRepo.DeleteLeagueTeam(ctx, repository.DeleteLeagueTeamParams{
+		LeagueID: leagueID,
+		TeamID:   teamID,
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrTeamNotEnrolled
+		}
+		if errors.Is(err, repository.ErrConflict) {
+			return ErrLeagueStarted
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// ListLeagueTeams returns teams enrolled in the league
+//
+// If league not found - ErrLeagueNotFound
+func (s *leagueServiceImpl) ListLeagueTeams(ctx context.Context, leagueID int64) ([]domain.Team, error) {
+	if _, err := s.GetLeagueByID(ctx, leagueID); err != nil {
+		return nil, err
+	}
+
+	teams, err := s.leagueRepo.ListLeagueTeams(ctx, leagueID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]domain.Team, len(teams))
+	for i, t := range teams {
+		res[i] = domain.TeamAdapter(t)
+	}
+
+	return res, nil
+}
+
+// GenerateFixtures closes the enrollment and schedules a double round-robin,
+// every team meets each other twice, once at home and once away
+//
+// If league not found - ErrLeagueNotFound
+// If league has started - ErrLeagueStarted
+// If less than two teams are enrolled - ErrNotEnoughTeams
+func (s *leagueServiceImpl) GenerateFixtures(ctx context.Context, leagueID int64) ([]domain.Fixture, error) {
+	if err := s.ensureEnrolling(ctx, leagueID); err != nil {
+		return nil, err
+	}
+
+	// the teams are listed by the repository under the league lock
+	schedule := func(teamIDs []int64) []repository.InsertFixtureParams {
+		pairings := tournament.DoubleRoundRobin(teamIDs)
+		fixtures := make([]repository.InsertFixtureParams, len(pairings))
+		for i, p := range pairings {
+			fixtures[i] = repository.InsertFixtureParams{
+				Round:      p.Round,
+				HomeTeamID: p.HomeTeamID,
+				AwayTeamID: p.AwayTeamID,
+			}
+		}
+
+		return fixtures
+	}
+
+	if err := s.leagueRepo.StartLeague(ctx, leagueID, schedule); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrLeagueNotFound
+		}
+		if errors.Is(err, repository.ErrConflict) {
+			return nil, ErrLeagueStarted
+		}
+		if errors.Is(err, repository.ErrNotAllowed) {
+			return nil, ErrNotEnoughTeams
+		}
+
+		return nil, err
+	}
+
+	return s.ListFixtures(ctx, leagueID)
+}
+
+// ListFixtures returns all fixtures of the league ordered by round
+//
+// If league not found - ErrLeagueNotFound
+func (s *leagueServiceImpl) ListFixtures(ctx context.Context, leagueID int64) ([]domain.Fixture, error) {
+	if _, err := s.GetLeagueByID(ctx, leagueID); err != nil {
+		return nil, err
+	}
+
+	fixtures, err := s.leagueRepo.ListFixturesByLeagueID(ctx, leagueID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]domain.Fixture, len(fixtures))
+	for i, f := range fixtures {
+		res[i] = domain.FixtureAdapter(f)
+	}
+
+	return res, nil
+}
+
+// PlayFixture simulates the fixture's match from the seed and attaches the result to it
+//
+// If fixture not found - ErrFixtureNotFound
+// If fixture was already played - ErrFixtureAlreadyPlayed
+// If any roster can't field a lineup - ErrNotEnoughPlayers
+func (s *leagueServiceImpl) PlayFixture(
+	ctx context.Context,
+	leagueID int64,
+	fixtureID int64,
+	seed int64,
+) (domain.Fixture, error) {
+	fixture, err := s.getFixture(ctx, leagueID, fixtureID)
+	if err != nil {
+		return domain.Fixture{}, err
+	}
+	if fixture.MatchID.Valid {
+		return domain.Fixture{}, ErrFixtureAlreadyPlayed
+	}
+
+	params, err := simulateMatch(
+		ctx,
+		s.playerRepo,
+		s.rosterLimit,
+		fixture.HomeTeamID,
+		fixture.AwayTeamID,
+		seed,
+	)
+	if err != nil {
+		return domain.Fixture{}, err
+	}
+
+	if _, err := s.leagueRepo.InsertFixtureMatch(ctx, fixtureID, params); err != nil {
+		if errors.Is(err, repository.ErrConflict) {
+			return domain.Fixture{}, ErrFixtureAlreadyPlayed
+		}
+
+		return domain.Fixture{}, err
+	}
+
+	fixture, err = s.getFixture(ctx, leagueID, fixtureID)
+	if err != nil {
+		return domain.Fixture{}, err
+	}
+
+	return domain.FixtureAdapter(fixture), nil
+}
+
+// GetStandings builds the league table from played fixtures
+//
+// If league not found - ErrLeagueNotFound
+func (s *leagueServiceImpl) GetStandings(ctx context.Context, leagueID int64) ([]domain.Standing, error) {
+	fixtures, err := s.ListFixtures(ctx, leagueID)
+	if err != nil {
+		return nil, err
+	}
+
+	teams, err := s.leagueRepo.ListLeagueTeams(ctx, leagueID)
+	if err != nil {
+		return nil, err
+	}
+
+	teamIDs := make([]int64, len(teams))
+	for i, t := range teams {
+		teamIDs[i] = t.ID
+	}
+
+	// form depends on the order in which fixtures were actually played
+	played := slices.DeleteFunc(fixtures, func(f domain.Fixture) bool { return !f.Played() })
+	slices.SortStableFunc(played, func(a, b domain.Fixture) int {
+		return a.PlayedAt.Compare(b.PlayedAt)
+	})
+
+	return tournament.Standings(teamIDs, played), nil
+}
+
+func (s *leagueServiceImpl) ensureEnrolling(ctx context.Context, leagueID int64) error {
+	league, err := s.GetLeagueByID(ctx, leagueID)
+	if err != nil {
+		return err
+	}
+	if league.Status != domain.LeagueStatusENROLLING {
+		return ErrLeagueStarted
+	}
+
+	return nil
+}
+
+func (s *leagueServiceImpl) getFixture(
+	ctx context.Context,
+	leagueID int64,
+	fixtureID int64,
+) (repository.Fixture, error) {
+	fixture, err := s.leagueRepo.GetFixtureByID(ctx, repository.GetFixtureByIDParams{
+		LeagueID: leagueID,
+		ID:       fixtureID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return repository.Fixture{}, ErrFixtureNotFound
+		}
+
+		return repository.Fixture{}, err
+	}
+
+	return fixture, nil
+}
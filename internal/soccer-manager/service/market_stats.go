@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/repository"
+	"github.com/hexley21/soccer-manager/pkg/cache"
+	"github.com/hexley21/soccer-manager/pkg/cache/mem"
+	"github.com/hexley21/soccer-manager/pkg/cache/ttl"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+//go:generate mockgen -destination=mock/mock_market_stats.go -package=mock github.com/hexley21/soccer-manager/internal/soccer-manager/service MarketStatsService
+type MarketStatsService interface {
+	GetMarketStats(ctx context.Context, days int32) (domain.MarketStats, error)
+}
+
+type marketStatsServiceImpl struct {
+	marketStatsRepo repository.MarketStatsRepository
+	cache           cache.Cache[int32, ttl.ExpirableItem[domain.MarketStats]]
+	cacheTTL        time.Duration
+	topLimit        int32
+	maxDays         int32
+}
+
+func NewMarketStatsService(
+	marketStatsRepo repository.MarketStatsRepository,
+	cacheTTL time.Duration,
+	topLimit int32,
+	maxDays int32,
+) *marketStatsServiceImpl {
+	return &marketStatsServiceImpl{
+		marketStatsRepo: marketStatsRepo,
+		cache:           ttl.New(mem.NewInMemoryCache[int32, ttl.ExpirableItem[domain.MarketStats]]()),
+		cacheTTL:        cacheTTL,
+		topLimit:        topLimit,
+		maxDays:         maxDays,
+	}
+}
+
+// GetMarketStats aggregates sales of the last days, results are cached per days for the cache ttl
+//
+// If days is not within 1 and max days - ErrInvalidArguments
+func (s *marketStatsServiceImpl) GetMarketStats(ctx context.Context, days int32) (domain.MarketStats, error) {
+	if days < 1 || days > s.maxDays {
+		return domain.MarketStats{}, ErrInvalidArguments
+	}
+
+	if cached, ok := s.cache.Get(days); ok {
+		return cached.Value, nil
+	}
+
+	now := time.Now()
+	stats := domain.MarketStats{
+		Since:       now.AddDate(0, 0, -int(days)),
+		GeneratedAt: now,
+	}
+	since := pgtype.Timestamptz{Time: stats.Since, Valid: true}
+	top := repository.MarketStatsParams{Since: since, Limit: s.topLimit}
+
+	volume, err := s.marketStatsRepo.ListDailyVolume(ctx, since)
+	if err != nil {
+		return domain.MarketStats{}, err
+	}
+	stats.DailyVolume = make([]domain.MarketDayVolume, len(volume))
+	for i, v := range volume {
+		stats.DailyVolume[i] = domain.MarketDayVolumeAdapter(v)
+	}
+
+	prices, err := s.marketStatsRepo.ListAveragePriceByPosition(ctx, since)
+	if err != nil {
+		return domain.MarketStats{}, err
+	}
+	stats.AveragePriceByPosition = make([]domain.MarketPositionPrice, len(prices))
+	for i, p := range prices {
+		stats.AveragePriceByPosition[i] = domain.MarketPositionPriceAdapter(p)
+	}
+
+	records, err := s.marketStatsRepo.ListTopTransferRecords(ctx, top)
+	if err != nil {
+		return domain.MarketStats{}, err
+	}
+	stats.TopTransfers = make([]domain.TransferRecord, len(records))
+	for i, r := range records {
+		stats.TopTransfers[i] = domain.TransferRecordAdapter(r)
+	}
+
+	if stats.TopSpenders, err = s.listTeamTotals(ctx, s.marketStatsRepo.ListTopSpenders, top); err != nil {
+		return domain.MarketStats{}, err
+	}
+	if stats.TopSellers, err = s.listTeamTotals(ctx, s.marketStatsRepo.ListTopSellers, top); err != nil {
+		return domain.MarketStats{}, err
+	}
+
+	seconds, err := s.marketStatsRepo.GetAverageTimeToSell(ctx, since)
+	if err != nil {
+		return domain.MarketStats{}, err
+	}
+	stats.AverageTimeToSell = time.Duration(seconds) * time.Second
+
+	s.cache.Put(days, ttl.NewItem(stats, s.cacheTTL))
+	return stats, nil
+}
+
+func (s *marketStatsServiceImpl) listTeamTotals(
+	ctx context.Context,
+	list func(context.Context, repository.MarketStatsParams) ([]repository.MarketTeamTotal, error),
+	arg repository.MarketStatsParams,
+) ([]domain.MarketTeamTotal, error) {
+	totals, err := list(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]domain.MarketTeamTotal, len(totals))
+	for i, t := range totals {
+		res[i] = domain.MarketTeamTotalAdapter(t)
+	}
+
+	return res, nil
+}
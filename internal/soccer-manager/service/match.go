@@ -0,0 +1,244 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/repository"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/simulation"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+//go:generate mockgen -destination=mock/mock_match.go -package=mock github.com/hexley21/soccer-manager/internal/soccer-manager/service MatchService
+type MatchService interface {
+	PlayMatch(
+		ctx context.Context,
+		homeTeamID int64,
+		awayTeamID int64,
+		seed int64,
+	) (domain.Match, error)
+	GetMatchByID(ctx context.Context, id int64) (domain.Match, error)
+	ListMatches(ctx context.Context, cursor int64, limit int32) ([]domain.Match, error)
+	ListMatchesByTeamID(
+		ctx context.Context,
+		teamID int64,
+		cursor int64,
+		limit int32,
+	) ([]domain.Match, error)
+}
+
+type matchServiceImpl struct {
+	matchRepo   repository.MatchRepository
+	teamRepo    repository.TeamRepository
+	playerRepo  repository.PlayerRepository
+	rosterLimit int32
+}
+
+func NewMatchService(
+	matchRepo repository.MatchRepository,
+	teamRepo repository.TeamRepository,
+	playerRepo repository.PlayerRepository,
+	rosterLimit int32,
+) *matchServiceImpl {
+	return &matchServiceImpl{
+		matchRepo:   matchRepo,
+		teamRepo:    teamRepo,
+		playerRepo:  playerRepo,
+		rosterLimit: rosterLimit,
+	}
+}
+
+// PlayMatch simulates a match between two teams from the seed and persists the result
+// replaying with the same seed and rosters produces the identical match
+//
+// If home and away are the same team - ErrCantPlayYourself
+// If team not found - ErrTeamNotFound
+// If any roster can't field a lineup - ErrNotEnoughPlayers
+func (s *matchServiceImpl) PlayMatch(
+	ctx context.Context,
+	homeTeamID int64,
+	awayTeamID int64,
+	seed int64,
+) (domain.Match, error) {
+	if homeTeamID == awayTeamID {
+		return domain.Match{}, ErrCantPlayYourself
+	}
+
+	// an unknown team has an empty roster, it must be told apart from a team short of players
+	for _, teamID := range []int64{homeTeamID, awayTeamID} {
+		if _, err := s.teamRepo.GetTeamByID(ctx, teamID); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return domain.Match{}, ErrTeamNotFound
+			}
+
+			return domain.Match{}, err
+		}
+	}
+
+	params, err := simulateMatch(ctx, s.playerRepo, s.rosterLimit, homeTeamID, awayTeamID, seed)
+	if err != nil {
+		return domain.Match{}, err
+	}
+
+	matchID, err := s.matchRepo.InsertMatch(ctx, params)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.ForeignKeyViolation {
+			return domain.Match{}, ErrTeamNotFound
+		}
+
+		return domain.Match{}, err
+	}
+
+	return s.GetMatchByID(ctx, matchID)
+}
+
+// simulateMatch loads both rosters and runs the simulation,
+// the result is ready to be stored by the repository
+//
+// If any roster can't field a lineup - ErrNotEnoughPlayers
+func simulateMatch(
+	ctx context.Context,
+	playerRepo repository.PlayerRepository,
+	rosterLimit int32,
+	homeTeamID int64,
+	awayTeamID int64,
+	seed int64,
+) (repository.InsertMatchParams, error) {
+	home, err := roster(ctx, playerRepo, rosterLimit, homeTeamID)
+	if err != nil {
+		return repository.InsertMatchParams{}, err
+	}
+	away, err := roster(ctx, playerRepo, rosterLimit, awayTeamID)
+	if err != nil {
+		return repository.InsertMatchParams{}, err
+	}
+
+	result, err := simulation.Simulate(seed, home, away)
+	if err != nil {
+		if errors.Is(err, simulation.ErrNotEnoughPlayers) {
+			return repository.InsertMatchParams{}, ErrNotEnoughPlayers
+		}
+
+		return repository.InsertMatchParams{}, err
+	}
+
+	events := make([]repository.InsertMatchEventParams, len(result.Events))
+	for i, e := range result.Events {
+		events[i] = repository.InsertMatchEventParams{
+			Minute:          e.Minute,
+			EventType:       string(e.Type),
+			TeamID:          e.TeamID,
+			PlayerID:        e.PlayerID,
+			RelatedPlayerID: e.RelatedPlayerID,
+		}
+	}
+
+	return repository.InsertMatchParams{
+		HomeTeamID: homeTeamID,
+		AwayTeamID: awayTeamID,
+		Seed:       seed,
+		HomeScore:  result.HomeScore,
+		AwayScore:  result.AwayScore,
+		Events:     events,
+	}, nil
+}
+
+func roster(
+	ctx context.Context,
+	playerRepo repository.PlayerRepository,
+	rosterLimit int32,
+	teamID int64,
+) (simulation.Team, error) {
+	players, err := playerRepo.ListPlayersByTeamID(ctx, repository.ListPlayersByTeamIDParams{
+		TeamID: teamID,
+		ID:     0,
+		Limit:  rosterLimit,
+	})
+	if err != nil {
+		return simulation.Team{}, err
+	}
+
+	team := simulation.Team{ID: teamID, Players: make([]domain.Player, len(players))}
+	for i, p := range players {
+		team.Players[i] = domain.PlayerAdapter(p)
+	}
+
+	return team, nil
+}
+
+// GetMatchByID returns a match together with its timeline
+//
+// If not found - ErrMatchNotFound
+func (s *matchServiceImpl) GetMatchByID(ctx context.Context, id int64) (domain.Match, error) {
+	match, err := s.matchRepo.GetMatchByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Match{}, ErrMatchNotFound
+		}
+
+		return domain.Match{}, err
+	}
+
+	events, err := s.matchRepo.ListMatchEventsByMatchID(ctx, id)
+	if err != nil {
+		return domain.Match{}, err
+	}
+
+	res := domain.MatchAdapter(match)
+	res.Events = make([]domain.MatchEvent, len(events))
+	for i, e := range events {
+		res.Events[i] = domain.MatchEventAdapter(e)
+	}
+
+	return res, nil
+}
+
+// ListMatches returns a list of matches without timelines
+func (s *matchServiceImpl) ListMatches(
+	ctx context.Context,
+	cursor int64,
+	limit int32,
+) ([]domain.Match, error) {
+	matches, err := s.matchRepo.ListMatchesCursor(ctx, repository.ListMatchesCursorParams{
+		ID:    cursor,
+		Limit: limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]domain.Match, len(matches))
+	for i, m := range matches {
+		res[i] = domain.MatchAdapter(m)
+	}
+
+	return res, nil
+}
+
+// ListMatchesByTeamID returns a list of home and away matches of the team without timelines
+func (s *matchServiceImpl) ListMatchesByTeamID(
+	ctx context.Context,
+	teamID int64,
+	cursor int64,
+	limit int32,
+) ([]domain.Match, error) {
+	matches, err := s.matchRepo.ListMatchesByTeamID(ctx, repository.ListMatchesByTeamIDParams{
+		TeamID: teamID,
+		ID:     cursor,
+		Limit:  limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]domain.Match, len(matches))
+	for i, m := range matches {
+		res[i] = domain.MatchAdapter(m)
+	}
+
+	return res, nil
+}
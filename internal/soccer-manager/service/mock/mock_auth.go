@@ -57,18 +57,18 @@ func (mr *MockAuthServiceMockRecorder) Authenticate(ctx, username, password any)
 }
 
 // CreateUser mocks base method.
-func (m *MockAuthService) CreateUser(ctx context.Context, username, password, role string) (domain.User, error) {
+func (m *MockAuthService) CreateUser(ctx context.Context, username, password string) (domain.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateUser", ctx, username, password, role)
+	ret := m.ctrl.Call(m, "CreateUser", ctx, username, password)
 	ret0, _ := ret[0].(domain.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateUser indicates an expected call of CreateUser.
-func (mr *MockAuthServiceMockRecorder) CreateUser(ctx, username, password, role any) *gomock.Call {
+func (mr *MockAuthServiceMockRecorder) CreateUser(ctx, username, password any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockAuthService)(nil).CreateUser), ctx, username, password, role)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockAuthService)(nil).CreateUser), ctx, username, password)
 }
 
 // GetUserById mocks base method.
@@ -12,6 +12,7 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	domain "github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
 	service "github.com/hexley21/soccer-manager/internal/soccer-manager/service"
@@ -56,18 +57,18 @@ func (mr *MockPlayerServiceMockRecorder) CreatePlayer(ctx, arg any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePlayer", reflect.TypeOf((*MockPlayerService)(nil).CreatePlayer), ctx, arg)
 }
 
-// CreatePlayersBatch mocks base method.
-func (m *MockPlayerService) CreatePlayersBatch(ctx context.Context, args []service.CreatePlayerArgs) error {
+// CreateRoster mocks base method.
+func (m *MockPlayerService) CreateRoster(ctx context.Context, teamID int64, args []service.CreatePlayerArgs) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreatePlayersBatch", ctx, args)
+	ret := m.ctrl.Call(m, "CreateRoster", ctx, teamID, args)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// CreatePlayersBatch indicates an expected call of CreatePlayersBatch.
-func (mr *MockPlayerServiceMockRecorder) CreatePlayersBatch(ctx, args any) *gomock.Call {
+// CreateRoster indicates an expected call of CreateRoster.
+func (mr *MockPlayerServiceMockRecorder) CreateRoster(ctx, teamID, args any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePlayersBatch", reflect.TypeOf((*MockPlayerService)(nil).CreatePlayersBatch), ctx, args)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRoster", reflect.TypeOf((*MockPlayerService)(nil).CreateRoster), ctx, teamID, args)
 }
 
 // GetAllPlayers mocks base method.
@@ -100,6 +101,21 @@ func (mr *MockPlayerServiceMockRecorder) GetPlayerById(ctx, playerID any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlayerById", reflect.TypeOf((*MockPlayerService)(nil).GetPlayerById), ctx, playerID)
 }
 
+// GetPlayerPriceHistory mocks base method.
+func (m *MockPlayerService) GetPlayerPriceHistory(ctx context.Context, playerID int64, from, to time.Time, bucket domain.PriceHistoryBucket) ([]domain.PlayerPricePoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlayerPriceHistory", ctx, playerID, from, to, bucket)
+	ret0, _ := ret[0].([]domain.PlayerPricePoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPlayerPriceHistory indicates an expected call of GetPlayerPriceHistory.
+func (mr *MockPlayerServiceMockRecorder) GetPlayerPriceHistory(ctx, playerID, from, to, bucket any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlayerPriceHistory", reflect.TypeOf((*MockPlayerService)(nil).GetPlayerPriceHistory), ctx, playerID, from, to, bucket)
+}
+
 // GetPlayersByTeamId mocks base method.
 func (m *MockPlayerService) GetPlayersByTeamId(ctx context.Context, teamId, cursor int64, limit int32) ([]domain.Player, error) {
 	m.ctrl.T.Helper()
@@ -12,6 +12,7 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	domain "github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
 	gomock "go.uber.org/mock/gomock"
@@ -42,11 +43,12 @@ func (m *MockTransferService) EXPECT() *MockTransferServiceMockRecorder {
 }
 
 // BuyPlayer mocks base method.
-func (m *MockTransferService) BuyPlayer(ctx context.Context, transferId, buyerTeamId int64) error {
+func (m *MockTransferService) BuyPlayer(ctx context.Context, transferId, buyerTeamId int64) (domain.TransferRecord, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "BuyPlayer", ctx, transferId, buyerTeamId)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(domain.TransferRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // BuyPlayer indicates an expected call of BuyPlayer.
@@ -56,18 +58,18 @@ func (mr *MockTransferServiceMockRecorder) BuyPlayer(ctx, transferId, buyerTeamI
 }
 
 // CreateTransfer mocks base method.
-func (m *MockTransferService) CreateTransfer(ctx context.Context, userID, playerID, price int64) (int64, error) {
+func (m *MockTransferService) CreateTransfer(ctx context.Context, userID, playerID, price int64, auctionEndsAt time.Time, reservePrice int64, expiresAt time.Time) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateTransfer", ctx, userID, playerID, price)
+	ret := m.ctrl.Call(m, "CreateTransfer", ctx, userID, playerID, price, auctionEndsAt, reservePrice, expiresAt)
 	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateTransfer indicates an expected call of CreateTransfer.
-func (mr *MockTransferServiceMockRecorder) CreateTransfer(ctx, userID, playerID, price any) *gomock.Call {
+func (mr *MockTransferServiceMockRecorder) CreateTransfer(ctx, userID, playerID, price, auctionEndsAt, reservePrice, expiresAt any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransfer", reflect.TypeOf((*MockTransferService)(nil).CreateTransfer), ctx, userID, playerID, price)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransfer", reflect.TypeOf((*MockTransferService)(nil).CreateTransfer), ctx, userID, playerID, price, auctionEndsAt, reservePrice, expiresAt)
 }
 
 // DeleteTransfer mocks base method.
@@ -84,6 +86,21 @@ func (mr *MockTransferServiceMockRecorder) DeleteTransfer(ctx, id, userId any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTransfer", reflect.TypeOf((*MockTransferService)(nil).DeleteTransfer), ctx, id, userId)
 }
 
+// ExpireTransfers mocks base method.
+func (m *MockTransferService) ExpireTransfers(ctx context.Context) ([]domain.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireTransfers", ctx)
+	ret0, _ := ret[0].([]domain.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireTransfers indicates an expected call of ExpireTransfers.
+func (mr *MockTransferServiceMockRecorder) ExpireTransfers(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireTransfers", reflect.TypeOf((*MockTransferService)(nil).ExpireTransfers), ctx)
+}
+
 // GetTransferByID mocks base method.
 func (m *MockTransferService) GetTransferByID(ctx context.Context, transferID int64) (domain.Transfer, error) {
 	m.ctrl.T.Helper()
@@ -115,18 +132,18 @@ func (mr *MockTransferServiceMockRecorder) GetTransferByPlayerID(ctx, playerID a
 }
 
 // ListTransfers mocks base method.
-func (m *MockTransferService) ListTransfers(ctx context.Context, id int64, limit int32) ([]domain.Transfer, error) {
+func (m *MockTransferService) ListTransfers(ctx context.Context, filter domain.TransferFilter, cursor domain.TransferCursor, limit int32) ([]domain.Transfer, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListTransfers", ctx, id, limit)
+	ret := m.ctrl.Call(m, "ListTransfers", ctx, filter, cursor, limit)
 	ret0, _ := ret[0].([]domain.Transfer)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListTransfers indicates an expected call of ListTransfers.
-func (mr *MockTransferServiceMockRecorder) ListTransfers(ctx, id, limit any) *gomock.Call {
+func (mr *MockTransferServiceMockRecorder) ListTransfers(ctx, filter, cursor, limit any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransfers", reflect.TypeOf((*MockTransferService)(nil).ListTransfers), ctx, id, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransfers", reflect.TypeOf((*MockTransferService)(nil).ListTransfers), ctx, filter, cursor, limit)
 }
 
 // ListTransfersByTeamId mocks base method.
@@ -144,6 +161,21 @@ func (mr *MockTransferServiceMockRecorder) ListTransfersByTeamId(ctx, sellerTeam
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransfersByTeamId", reflect.TypeOf((*MockTransferService)(nil).ListTransfersByTeamId), ctx, sellerTeamID, id, limit)
 }
 
+// RenewTransfer mocks base method.
+func (m *MockTransferService) RenewTransfer(ctx context.Context, ID, userId int64, expiresAt time.Time) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenewTransfer", ctx, ID, userId, expiresAt)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenewTransfer indicates an expected call of RenewTransfer.
+func (mr *MockTransferServiceMockRecorder) RenewTransfer(ctx, ID, userId, expiresAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenewTransfer", reflect.TypeOf((*MockTransferService)(nil).RenewTransfer), ctx, ID, userId, expiresAt)
+}
+
 // UpdateTransferPrice mocks base method.
 func (m *MockTransferService) UpdateTransferPrice(ctx context.Context, ID, userId, price int64) error {
 	m.ctrl.T.Helper()
@@ -57,16 +57,16 @@ func (mr *MockTransferRecordServiceMockRecorder) GetTransferRecordByID(ctx, id a
 }
 
 // ListTransferRecords mocks base method.
-func (m *MockTransferRecordService) ListTransferRecords(ctx context.Context, id int64, limit int32) ([]domain.TransferRecord, error) {
+func (m *MockTransferRecordService) ListTransferRecords(ctx context.Context, filter domain.TransferRecordFilter, id int64, limit int32) ([]domain.TransferRecord, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListTransferRecords", ctx, id, limit)
+	ret := m.ctrl.Call(m, "ListTransferRecords", ctx, filter, id, limit)
 	ret0, _ := ret[0].([]domain.TransferRecord)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListTransferRecords indicates an expected call of ListTransferRecords.
-func (mr *MockTransferRecordServiceMockRecorder) ListTransferRecords(ctx, id, limit any) *gomock.Call {
+func (mr *MockTransferRecordServiceMockRecorder) ListTransferRecords(ctx, filter, id, limit any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransferRecords", reflect.TypeOf((*MockTransferRecordService)(nil).ListTransferRecords), ctx, id, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransferRecords", reflect.TypeOf((*MockTransferRecordService)(nil).ListTransferRecords), ctx, filter, id, limit)
 }
@@ -41,6 +41,36 @@ func (m *MockUserService) EXPECT() *MockUserServiceMockRecorder {
 	return m.recorder
 }
 
+// BootstrapAdmin mocks base method.
+func (m *MockUserService) BootstrapAdmin(ctx context.Context, username string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BootstrapAdmin", ctx, username)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BootstrapAdmin indicates an expected call of BootstrapAdmin.
+func (mr *MockUserServiceMockRecorder) BootstrapAdmin(ctx, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BootstrapAdmin", reflect.TypeOf((*MockUserService)(nil).BootstrapAdmin), ctx, username)
+}
+
+// ChangeRole mocks base method.
+func (m *MockUserService) ChangeRole(ctx context.Context, userId int64, role domain.UserRole) (domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeRole", ctx, userId, role)
+	ret0, _ := ret[0].(domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeRole indicates an expected call of ChangeRole.
+func (mr *MockUserServiceMockRecorder) ChangeRole(ctx, userId, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeRole", reflect.TypeOf((*MockUserService)(nil).ChangeRole), ctx, userId, role)
+}
+
 // Delete mocks base method.
 func (m *MockUserService) Delete(ctx context.Context, userId int64) error {
 	m.ctrl.T.Helper()
@@ -86,15 +116,15 @@ func (mr *MockUserServiceMockRecorder) List(ctx, cursor, limit any) *gomock.Call
 }
 
 // UpdatePassword mocks base method.
-func (m *MockUserService) UpdatePassword(ctx context.Context, id int64, oldPassowrd, newPassword string) error {
+func (m *MockUserService) UpdatePassword(ctx context.Context, id, sessionID int64, oldPassowrd, newPassword string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdatePassword", ctx, id, oldPassowrd, newPassword)
+	ret := m.ctrl.Call(m, "UpdatePassword", ctx, id, sessionID, oldPassowrd, newPassword)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdatePassword indicates an expected call of UpdatePassword.
-func (mr *MockUserServiceMockRecorder) UpdatePassword(ctx, id, oldPassowrd, newPassword any) *gomock.Call {
+func (mr *MockUserServiceMockRecorder) UpdatePassword(ctx, id, sessionID, oldPassowrd, newPassword any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePassword", reflect.TypeOf((*MockUserService)(nil).UpdatePassword), ctx, id, oldPassowrd, newPassword)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePassword", reflect.TypeOf((*MockUserService)(nil).UpdatePassword), ctx, id, sessionID, oldPassowrd, newPassword)
 }
@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/repository"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+//go:generate mockgen -destination=mock/mock_notification.go -package=mock github.com/hexley21/soccer-manager/internal/soccer-manager/service NotificationService
+type NotificationService interface {
+	GetInbox(
+		ctx context.Context,
+		userID int64,
+		unreadOnly bool,
+		cursor int64,
+		limit int32,
+	) (domain.NotificationInbox, error)
+
+	NotifyUser(
+		ctx context.Context,
+		userID int64,
+		kind domain.NotificationKind,
+		payload any,
+	) (domain.Notification, error)
+	NotifyTeam(ctx context.Context, notice domain.TeamNotice) (domain.Notification, error)
+	MarkRead(ctx context.Context, userID int64, id int64) error
+	MarkAllRead(ctx context.Context, userID int64) (int64, error)
+}
+
+type notificationServiceImpl struct {
+	notificationRepo repository.NotificationRepository
+}
+
+func NewNotificationService(notificationRepo repository.NotificationRepository) *notificationServiceImpl {
+	return &notificationServiceImpl{
+		notificationRepo: notificationRepo,
+	}
+}
+
+// GetInbox returns a page of the user's notifications, newest first, and the unread count,
+// the cursor is the last notification id of the previous page
+func (s *notificationServiceImpl) GetInbox(
+	ctx context.Context,
+	userID int64,
+	unreadOnly bool,
+	cursor int64,
+	limit int32,
+) (domain.NotificationInbox, error) {
+	unread, err := s.notificationRepo.CountUnreadNotificationsByUserID(ctx, userID)
+	if err != nil {
+		return domain.NotificationInbox{}, err
+	}
+
+	notifications, err := s.notificationRepo.ListNotificationsByUserID(ctx, repository.ListNotificationsByUserIDParams{
+		UserID:     userID,
+		ID:         cursor,
+		UnreadOnly: unreadOnly,
+		Limit:      limit,
+	})
+	if err != nil {
+		return domain.NotificationInbox{}, err
+	}
+
+	res := domain.NotificationInbox{
+		Unread:        unread,
+		Notifications: make([]domain.Notification, len(notifications)),
+	}
+	for i, n := range notifications {
+		res.Notifications[i] = domain.NotificationAdapter(n)
+	}
+
+	return res, nil
+}
+
+// NotifyUser puts a notification in the user's inbox, payload is marshalled to JSON
+//
+// If user not found - ErrUserNotFound
+func (s *notificationServiceImpl) NotifyUser(
+	ctx context.Context,
+	userID int64,
+	kind domain.NotificationKind,
+	payload any,
+) (domain.Notification, error) {
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return domain.Notification{}, err
+	}
+
+	notification, err := s.notificationRepo.InsertNotification(ctx, repository.InsertNotificationParams{
+		UserID:  userID,
+		Kind:    string(kind),
+		Payload: raw,
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.ForeignKeyViolation {
+			return domain.Notification{}, ErrUserNotFound
+		}
+
+		return domain.Notification{}, err
+	}
+
+	return domain.NotificationAdapter(notification), nil
+}
+
+// NotifyTeam puts a notification in the inbox of the team owner
+//
+// If team not found - ErrTeamNotFound
+func (s *notificationServiceImpl) NotifyTeam(
+	ctx context.Context,
+	notice domain.TeamNotice,
+) (domain.Notification, error) {
+	raw, err := json.Marshal(notice.Payload)
+	if err != nil {
+		return domain.Notification{}, err
+	}
+
+	notification, err := s.notificationRepo.InsertTeamNotification(ctx, repository.InsertTeamNotificationParams{
+		TeamID:  notice.TeamID,
+		Kind:    string(notice.Kind),
+		Payload: raw,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Notification{}, ErrTeamNotFound
+		}
+
+		return domain.Notification{}, err
+	}
+
+	return domain.NotificationAdapter(notification), nil
+}
+
+// MarkRead marks a notification of the user as read, marking it again keeps the first read time
+//
+// If not found - ErrNotificationNotFound
+func (s *notificationServiceImpl) MarkRead(ctx context.Context, userID int64, id int64) error {
+	if err := s.notificationRepo.MarkNotificationRead(ctx, repository.MarkNotificationReadParams{
+		ID:     id,
+		UserID: userID,
+	}); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrNotificationNotFound
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// MarkAllRead marks every unread notification of the user as read and returns how many there were
+func (s *notificationServiceImpl) MarkAllRead(ctx context.Context, userID int64) (int64, error) {
+	return s.notificationRepo.MarkAllNotificationsRead(ctx, userID)
+}
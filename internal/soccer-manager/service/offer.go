@@ -0,0 +1,255 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/repository"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+//go:generate mockgen -destination=mock/mock_offer.go -package=mock github.com/hexley21/soccer-manager/internal/soccer-manager/service OfferService
+type OfferService interface {
+	GetOfferByID(ctx context.Context, id int64, userID int64) (domain.Offer, error)
+	ListOffersByPlayerID(
+		ctx context.Context,
+		playerID int64,
+		userID int64,
+		cursor int64,
+		limit int32,
+	) ([]domain.Offer, error)
+	ListSentOffers(
+		ctx context.Context,
+		userID int64,
+		cursor int64,
+		limit int32,
+	) ([]domain.Offer, error)
+	ListReceivedOffers(
+		ctx context.Context,
+		userID int64,
+		cursor int64,
+		limit int32,
+	) ([]domain.Offer, error)
+
+	PlaceOffer(
+		ctx context.Context,
+		playerID int64,
+		userID int64,
+		amount int64,
+	) (domain.Offer, error)
+	AcceptOffer(ctx context.Context, id int64, userID int64) (domain.TransferRecord, error)
+	DeclineOffer(ctx context.Context, id int64, userID int64) error
+	WithdrawOffer(ctx context.Context, id int64, userID int64) error
+}
+
+type offerServiceImpl struct {
+	offerRepo          repository.OfferRepository
+	transferWindowRepo repository.TransferWindowRepository
+}
+
+func NewOfferService(
+	offerRepo repository.OfferRepository,
+	transferWindowRepo repository.TransferWindowRepository,
+) *offerServiceImpl {
+	return &offerServiceImpl{
+		offerRepo:          offerRepo,
+		transferWindowRepo: transferWindowRepo,
+	}
+}
+
+// GetOfferByID returns an offer visible to the seller or the buyer
+//
+// If not found - ErrOfferNotFound
+func (s *offerServiceImpl) GetOfferByID(ctx context.Context, id int64, userID int64) (domain.Offer, error) {
+	offer, err := s.offerRepo.GetOfferByIDAndUserID(ctx, repository.GetOfferByIDAndUserIDParams{
+		ID:     id,
+		UserID: userID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Offer{}, ErrOfferNotFound
+		}
+
+		return domain.Offer{}, err
+	}
+
+	return domain.OfferAdapter(offer), nil
+}
+
+// ListOffersByPlayerID returns offers for the player, empty unless the user owns the player
+func (s *offerServiceImpl) ListOffersByPlayerID(
+	ctx context.Context,
+	playerID int64,
+	userID int64,
+	cursor int64,
+	limit int32,
+) ([]domain.Offer, error) {
+	offers, err := s.offerRepo.ListOffersByPlayerID(ctx, repository.ListOffersByPlayerIDParams{
+		PlayerID: playerID,
+		UserID:   userID,
+		ID:       cursor,
+		Limit:    limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return offersAdapter(offers), nil
+}
+
+// ListSentOffers returns offers made by user's team
+func (s *offerServiceImpl) ListSentOffers(
+	ctx context.Context,
+	userID int64,
+	cursor int64,
+	limit int32,
+) ([]domain.Offer, error) {
+	offers, err := s.offerRepo.ListSentOffersByUserID(ctx, repository.ListOffersByUserIDParams{
+		UserID: userID,
+		ID:     cursor,
+		Limit:  limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return offersAdapter(offers), nil
+}
+
+// ListReceivedOffers returns offers made for players of user's team
+func (s *offerServiceImpl) ListReceivedOffers(
+	ctx context.Context,
+	userID int64,
+	cursor int64,
+	limit int32,
+) ([]domain.Offer, error) {
+	offers, err := s.offerRepo.ListReceivedOffersByUserID(ctx, repository.ListOffersByUserIDParams{
+		UserID: userID,
+		ID:     cursor,
+		Limit:  limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return offersAdapter(offers), nil
+}
+
+// PlaceOffer makes a direct offer for a player that is not listed,
+// the amount is reserved from buyer's budget until the offer is closed
+//
+// If player not found - ErrPlayerNotFound
+// If offer for your own player - ErrCantBuyFromYourself
+// If player is listed - ErrPlayerIsListed
+// If amount exceeds the budget - ErrNotEnoughFunds
+// If team has a pending offer for the player - ErrOfferAlreadyPlaced
+// If market is closed - ErrTransferWindowClosed
+func (s *offerServiceImpl) PlaceOffer(
+	ctx context.Context,
+	playerID int64,
+	userID int64,
+	amount int64,
+) (domain.Offer, error) {
+	if _, err := openTransferWindow(ctx, s.transferWindowRepo); err != nil {
+		return domain.Offer{}, err
+	}
+
+	offer, err := s.offerRepo.PlaceOffer(ctx, repository.PlaceOfferParams{
+		PlayerID: playerID,
+		UserID:   userID,
+		Amount:   amount,
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return domain.Offer{}, ErrPlayerNotFound
+		}
+		if errors.Is(err, repository.ErrConflict) {
+			return domain.Offer{}, ErrCantBuyFromYourself
+		}
+		if errors.Is(err, repository.ErrNotAllowed) {
+			return domain.Offer{}, ErrPlayerIsListed
+		}
+		if errors.Is(err, repository.ErrViolation) {
+			return domain.Offer{}, ErrNotEnoughFunds
+		}
+
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.Code {
+			case pgerrcode.UniqueViolation:
+				return domain.Offer{}, ErrOfferAlreadyPlaced
+			case pgerrcode.CheckViolation:
+				return domain.Offer{}, ErrInvalidArguments
+			}
+		}
+
+		return domain.Offer{}, err
+	}
+
+	return domain.OfferAdapter(offer), nil
+}
+
+// AcceptOffer sells the player to the buyer at the offered amount and returns the sale
+//
+// If offer not found - ErrOfferNotFound
+// If offer is not pending or the player changed team - ErrOfferNotAwaiting
+// If market is closed - ErrTransferWindowClosed
+func (s *offerServiceImpl) AcceptOffer(ctx context.Context, id int64, userID int64) (domain.TransferRecord, error) {
+	if _, err := openTransferWindow(ctx, s.transferWindowRepo); err != nil {
+		return domain.TransferRecord{}, err
+	}
+
+	record, err := s.offerRepo.AcceptOffer(ctx, id, userID)
+	if err != nil {
+		return domain.TransferRecord{}, mapOfferError(err)
+	}
+
+	return domain.TransferRecordAdapter(record), nil
+}
+
+// DeclineOffer closes the offer by the seller, reserved funds go back to the buyer
+//
+// If offer not found - ErrOfferNotFound
+// If offer is already closed - ErrOfferNotAwaiting
+func (s *offerServiceImpl) DeclineOffer(ctx context.Context, id int64, userID int64) error {
+	if err := s.offerRepo.DeclineOffer(ctx, id, userID); err != nil {
+		return mapOfferError(err)
+	}
+
+	return nil
+}
+
+// WithdrawOffer closes the offer by the buyer, reserved funds go back to the buyer
+//
+// If offer not found - ErrOfferNotFound
+// If offer is already closed - ErrOfferNotAwaiting
+func (s *offerServiceImpl) WithdrawOffer(ctx context.Context, id int64, userID int64) error {
+	if err := s.offerRepo.WithdrawOffer(ctx, id, userID); err != nil {
+		return mapOfferError(err)
+	}
+
+	return nil
+}
+
+func offersAdapter(offers []repository.Offer) []domain.Offer {
+	res := make([]domain.Offer, len(offers))
+	for i, o := range offers {
+		res[i] = domain.OfferAdapter(o)
+	}
+
+	return res
+}
+
+func mapOfferError(err error) error {
+	if errors.Is(err, repository.ErrNotFound) {
+		return ErrOfferNotFound
+	}
+	if errors.Is(err, repository.ErrConflict) {
+		return ErrOfferNotAwaiting
+	}
+
+	return err
+}
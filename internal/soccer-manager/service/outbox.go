@@ -0,0 +1,137 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/repository"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+//go:generate mockgen -destination=mock/mock_outbox.go -package=mock github.com/hexley21/soccer-manager/internal/soccer-manager/service OutboxService
+type OutboxService interface {
+	ClaimEvents(ctx context.Context, limit int32, lease time.Duration) ([]domain.OutboxEvent, error)
+	CompleteEvent(ctx context.Context, id int64) error
+	RetryEvent(
+		ctx context.Context,
+		id int64,
+		deliveredTo []string,
+		cause error,
+		at time.Time,
+	) error
+	BuryEvent(ctx context.Context, id int64, deliveredTo []string, cause error) error
+
+	ListDeadEvents(ctx context.Context, cursor int64, limit int32) ([]domain.OutboxEvent, error)
+	RequeueEvent(ctx context.Context, id int64) error
+}
+
+type outboxServiceImpl struct {
+	outboxRepo repository.OutboxRepository
+}
+
+func NewOutboxService(outboxRepo repository.OutboxRepository) *outboxServiceImpl {
+	return &outboxServiceImpl{
+		outboxRepo: outboxRepo,
+	}
+}
+
+// ClaimEvents leases up to limit due events, oldest first.
+// Events that are neither completed nor failed within the lease are claimed again
+func (s *outboxServiceImpl) ClaimEvents(
+	ctx context.Context,
+	limit int32,
+	lease time.Duration,
+) ([]domain.OutboxEvent, error) {
+	events, err := s.outboxRepo.ClaimOutboxEvents(ctx, repository.ClaimOutboxEventsParams{
+		Limit:      limit,
+		LeaseUntil: pgtype.Timestamptz{Time: time.Now().Add(lease), Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]domain.OutboxEvent, len(events))
+	for i, e := range events {
+		res[i] = domain.OutboxEventAdapter(e)
+	}
+	// claimed rows come back in no particular order
+	slices.SortFunc(res, func(a, b domain.OutboxEvent) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	return res, nil
+}
+
+// CompleteEvent drops an event every handler got
+func (s *outboxServiceImpl) CompleteEvent(ctx context.Context, id int64) error {
+	return s.outboxRepo.DeleteOutboxEvent(ctx, id)
+}
+
+// RetryEvent schedules the next attempt at the given time, handlers in deliveredTo won't get the event again
+func (s *outboxServiceImpl) RetryEvent(
+	ctx context.Context,
+	id int64,
+	deliveredTo []string,
+	cause error,
+	at time.Time,
+) error {
+	return s.outboxRepo.FailOutboxEvent(ctx, repository.FailOutboxEventParams{
+		ID:          id,
+		DeliveredTo: deliveredTo,
+		LastError:   pgtype.Text{String: cause.Error(), Valid: true},
+		AvailableAt: pgtype.Timestamptz{Time: at, Valid: true},
+	})
+}
+
+// BuryEvent dead-letters an event, it stays until requeued
+func (s *outboxServiceImpl) BuryEvent(ctx context.Context, id int64, deliveredTo []string, cause error) error {
+	now := time.Now()
+	return s.outboxRepo.FailOutboxEvent(ctx, repository.FailOutboxEventParams{
+		ID:          id,
+		DeliveredTo: deliveredTo,
+		LastError:   pgtype.Text{String: cause.Error(), Valid: true},
+		AvailableAt: pgtype.Timestamptz{Time: now, Valid: true},
+		DeadAt:      pgtype.Timestamptz{Time: now, Valid: true},
+	})
+}
+
+// ListDeadEvents returns dead-lettered events, the cursor is the last event id of the previous page
+func (s *outboxServiceImpl) ListDeadEvents(
+	ctx context.Context,
+	cursor int64,
+	limit int32,
+) ([]domain.OutboxEvent, error) {
+	events, err := s.outboxRepo.ListDeadOutboxEvents(ctx, repository.ListDeadOutboxEventsParams{
+		ID:    cursor,
+		Limit: limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]domain.OutboxEvent, len(events))
+	for i, e := range events {
+		res[i] = domain.OutboxEventAdapter(e)
+	}
+
+	return res, nil
+}
+
+// RequeueEvent gives a dead event a fresh set of attempts
+//
+// If not found or not dead - ErrOutboxEventNotFound
+func (s *outboxServiceImpl) RequeueEvent(ctx context.Context, id int64) error {
+	if err := s.outboxRepo.RequeueOutboxEvent(ctx, id); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrOutboxEventNotFound
+		}
+
+		return err
+	}
+
+	return nil
+}
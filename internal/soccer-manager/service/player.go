@@ -3,10 +3,13 @@ package service
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/rating"
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/repository"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 //go:generate mockgen -destination=mock/mock_player.go -package=mock github.com/hexley21/soccer-manager/internal/soccer-manager/service PlayerService
@@ -40,17 +43,29 @@ type PlayerService interface {
 		cursor int64,
 		limit int32,
 	) ([]domain.Player, error)
+	GetPlayerPriceHistory(
+		ctx context.Context,
+		playerID int64,
+		from time.Time,
+		to time.Time,
+		bucket domain.PriceHistoryBucket,
+	) ([]domain.PlayerPricePoint, error)
 	CreatePlayer(ctx context.Context, arg CreatePlayerArgs) error
-	CreatePlayersBatch(ctx context.Context, args []CreatePlayerArgs) error
+	CreateRoster(ctx context.Context, teamID int64, args []CreatePlayerArgs) error
 }
 
 type playerServiceImpl struct {
-	playerRepo repository.PlayerRepository
+	playerRepo             repository.PlayerRepository
+	playerPriceHistoryRepo repository.PlayerPriceHistoryRepository
 }
 
-func NewPlayerService(playerRepo repository.PlayerRepository) *playerServiceImpl {
+func NewPlayerService(
+	playerRepo repository.PlayerRepository,
+	playerPriceHistoryRepo repository.PlayerPriceHistoryRepository,
+) *playerServiceImpl {
 	return &playerServiceImpl{
-		playerRepo: playerRepo,
+		playerRepo:             playerRepo,
+		playerPriceHistoryRepo: playerPriceHistoryRepo,
 	}
 }
 
@@ -94,6 +109,72 @@ func (s *playerServiceImpl) GetPlayerById(
 	return domain.PlayerAdapter(players), nil
 }
 
+// GetPlayerPriceHistory returns player's prices in chronological order, optionally bucketed by day or week.
+// Zero from or to leave the range open
+//
+// If not found - ErrPlayerNotFound,
+// if bucket is unknown or from is not before to - ErrInvalidArguments
+func (s *playerServiceImpl) GetPlayerPriceHistory(
+	ctx context.Context,
+	playerID int64,
+	from time.Time,
+	to time.Time,
+	bucket domain.PriceHistoryBucket,
+) ([]domain.PlayerPricePoint, error) {
+	if !bucket.Valid() {
+		return nil, ErrInvalidArguments
+	}
+	if !from.IsZero() && !to.IsZero() && !from.Before(to) {
+		return nil, ErrInvalidArguments
+	}
+
+	if _, err := s.playerRepo.GetPlayerByID(ctx, playerID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrPlayerNotFound
+		}
+
+		return nil, err
+	}
+
+	var fromTs, toTs pgtype.Timestamptz
+	if !from.IsZero() {
+		fromTs = pgtype.Timestamptz{Time: from, Valid: true}
+	}
+	if !to.IsZero() {
+		toTs = pgtype.Timestamptz{Time: to, Valid: true}
+	}
+
+	var points []repository.PlayerPricePoint
+	var err error
+	if bucket == domain.PriceHistoryBucketNONE {
+		points, err = s.playerPriceHistoryRepo.ListPlayerPriceHistory(ctx, repository.ListPlayerPriceHistoryParams{
+			PlayerID: playerID,
+			From:     fromTs,
+			To:       toTs,
+		})
+	} else {
+		points, err = s.playerPriceHistoryRepo.ListPlayerPriceHistoryBuckets(
+			ctx,
+			repository.ListPlayerPriceHistoryBucketsParams{
+				PlayerID: playerID,
+				From:     fromTs,
+				To:       toTs,
+				Bucket:   string(bucket),
+			},
+		)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]domain.PlayerPricePoint, len(points))
+	for i, p := range points {
+		res[i] = domain.PlayerPricePointAdapter(p)
+	}
+
+	return res, nil
+}
+
 // UpdatePlayerData updates firstname, lastname & countrycode of a team
 //
 // If not found - ErrPlayerNotFound
@@ -179,6 +260,7 @@ type CreatePlayerArgs struct {
 	Age          int32
 	PositionCode domain.PlayerPositionCode
 	Price        int64
+	Attributes   rating.Attributes
 }
 
 func NewCreatePlayerArgs(
@@ -189,6 +271,7 @@ func NewCreatePlayerArgs(
 	age int32,
 	positionCode domain.PlayerPositionCode,
 	price int64,
+	attributes rating.Attributes,
 ) CreatePlayerArgs {
 	return CreatePlayerArgs{
 		TeamID:       teamID,
@@ -198,6 +281,7 @@ func NewCreatePlayerArgs(
 		Age:          age,
 		PositionCode: positionCode,
 		Price:        price,
+		Attributes:   attributes,
 	}
 }
 
@@ -211,11 +295,20 @@ func (s *playerServiceImpl) CreatePlayer(ctx context.Context, arg CreatePlayerAr
 		Age:          arg.Age,
 		PositionCode: string(arg.PositionCode),
 		Price:        arg.Price,
+		Pace:         arg.Attributes.Pace,
+		Shooting:     arg.Attributes.Shooting,
+		Passing:      arg.Attributes.Passing,
+		Defending:    arg.Attributes.Defending,
+		Goalkeeping:  arg.Attributes.Goalkeeping,
+		Stamina:      arg.Attributes.Stamina,
 	})
 }
 
-// CreatePlayersBatch - inserts many players at once
-func (s *playerServiceImpl) CreatePlayersBatch(ctx context.Context, args []CreatePlayerArgs) error {
+// CreateRoster - inserts many players at once into an empty team
+//
+// If team not found - ErrTeamNotFound
+// If team already has players - ErrTeamHasPlayers
+func (s *playerServiceImpl) CreateRoster(ctx context.Context, teamID int64, args []CreatePlayerArgs) error {
 	repoParams := make([]repository.InsertPlayerParams, len(args))
 	for i, a := range args {
 		repoParams[i] = repository.InsertPlayerParams{
@@ -226,8 +319,25 @@ func (s *playerServiceImpl) CreatePlayersBatch(ctx context.Context, args []Creat
 			Age:          a.Age,
 			PositionCode: string(a.PositionCode),
 			Price:        a.Price,
+			Pace:         a.Attributes.Pace,
+			Shooting:     a.Attributes.Shooting,
+			Passing:      a.Attributes.Passing,
+			Defending:    a.Attributes.Defending,
+			Goalkeeping:  a.Attributes.Goalkeeping,
+			Stamina:      a.Attributes.Stamina,
 		}
 	}
 
-	return s.playerRepo.InsertPlayersBatch(ctx, repoParams)
+	if err := s.playerRepo.InsertRoster(ctx, teamID, repoParams); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrTeamNotFound
+		}
+		if errors.Is(err, repository.ErrConflict) {
+			return ErrTeamHasPlayers
+		}
+
+		return err
+	}
+
+	return nil
 }
@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/repository"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+//go:generate mockgen -destination=mock/mock_provisioning.go -package=mock github.com/hexley21/soccer-manager/internal/soccer-manager/service ProvisioningService
+type ProvisioningService interface {
+	GetProvisioning(ctx context.Context, userID int64) (domain.Provisioning, error)
+	ListStuckProvisioning(ctx context.Context, cursor int64, limit int32) ([]domain.Provisioning, error)
+
+	StartAttempt(ctx context.Context, userID int64) (domain.Provisioning, error)
+	CompleteProvisioning(ctx context.Context, userID int64, teamID int64) error
+	FailAttempt(
+		ctx context.Context,
+		attempt domain.Provisioning,
+		teamID int64,
+		cause error,
+	) (domain.ProvisioningStatus, error)
+	RequeueProvisioning(ctx context.Context, userID int64) error
+}
+
+type provisioningServiceImpl struct {
+	provisioningRepo repository.ProvisioningRepository
+	maxAttempts      int32
+	stuckAfter       time.Duration
+}
+
+// NewProvisioningService creates the service, provisioning gives up after maxAttempts
+// and counts as stuck when pending for stuckAfter
+func NewProvisioningService(
+	provisioningRepo repository.ProvisioningRepository,
+	maxAttempts int32,
+	stuckAfter time.Duration,
+) *provisioningServiceImpl {
+	return &provisioningServiceImpl{
+		provisioningRepo: provisioningRepo,
+		maxAttempts:      maxAttempts,
+		stuckAfter:       stuckAfter,
+	}
+}
+
+// GetProvisioning returns the provisioning status of the user
+//
+// If not found - ErrProvisioningNotFound
+func (s *provisioningServiceImpl) GetProvisioning(ctx context.Context, userID int64) (domain.Provisioning, error) {
+	provisioning, err := s.provisioningRepo.GetProvisioningByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Provisioning{}, ErrProvisioningNotFound
+		}
+
+		return domain.Provisioning{}, err
+	}
+
+	return domain.ProvisioningAdapter(provisioning), nil
+}
+
+// ListStuckProvisioning returns failed provisioning and pending one that hasn't moved for a while,
+// the cursor is the last user id of the previous page
+func (s *provisioningServiceImpl) ListStuckProvisioning(
+	ctx context.Context,
+	cursor int64,
+	limit int32,
+) ([]domain.Provisioning, error) {
+	stuck, err := s.provisioningRepo.ListStuckProvisioning(ctx, repository.ListStuckProvisioningParams{
+		PendingBefore: pgtype.Timestamptz{Time: time.Now().Add(-s.stuckAfter), Valid: true},
+		UserID:        cursor,
+		Limit:         limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]domain.Provisioning, len(stuck))
+	for i, p := range stuck {
+		res[i] = domain.ProvisioningAdapter(p)
+	}
+
+	return res, nil
+}
+
+// StartAttempt counts a provisioning attempt and returns the state it starts from
+//
+// If user not found - ErrUserNotFound
+func (s *provisioningServiceImpl) StartAttempt(ctx context.Context, userID int64) (domain.Provisioning, error) {
+	provisioning, err := s.provisioningRepo.StartProvisioning(ctx, userID)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.ForeignKeyViolation {
+			return domain.Provisioning{}, ErrUserNotFound
+		}
+
+		return domain.Provisioning{}, err
+	}
+
+	return domain.ProvisioningAdapter(provisioning), nil
+}
+
+func (s *provisioningServiceImpl) CompleteProvisioning(ctx context.Context, userID int64, teamID int64) error {
+	return s.provisioningRepo.CompleteProvisioning(ctx, repository.CompleteProvisioningParams{
+		UserID: userID,
+		TeamID: teamID,
+	})
+}
+
+// FailAttempt records the cause of a failed attempt and returns the resulting status,
+// the provisioning stays pending while attempts are left and fails afterwards. Zero teamID means no team yet
+func (s *provisioningServiceImpl) FailAttempt(
+	ctx context.Context,
+	attempt domain.Provisioning,
+	teamID int64,
+	cause error,
+) (domain.ProvisioningStatus, error) {
+	status := domain.ProvisioningPending
+	if attempt.Attempts >= s.maxAttempts {
+		status = domain.ProvisioningFailed
+	}
+
+	err := s.provisioningRepo.FailProvisioning(ctx, repository.FailProvisioningParams{
+		UserID:    attempt.UserID,
+		Status:    string(status),
+		TeamID:    pgtype.Int8{Int64: teamID, Valid: teamID != 0},
+		LastError: pgtype.Text{String: cause.Error(), Valid: true},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return status, nil
+}
+
+// RequeueProvisioning runs a failed or stuck provisioning of the user again with a fresh set of attempts
+//
+// If user not found - ErrUserNotFound
+// If already completed - ErrProvisioningCompleted
+// If pending and not stuck yet - ErrProvisioningInProgress
+func (s *provisioningServiceImpl) RequeueProvisioning(ctx context.Context, userID int64) error {
+	if err := s.provisioningRepo.RequeueProvisioning(ctx, repository.RequeueProvisioningParams{
+		UserID:        userID,
+		PendingBefore: pgtype.Timestamptz{Time: time.Now().Add(-s.stuckAfter), Valid: true},
+	}); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrUserNotFound
+		}
+		if errors.Is(err, repository.ErrConflict) {
+			return ErrProvisioningCompleted
+		}
+		if errors.Is(err, repository.ErrNotAllowed) {
+			return ErrProvisioningInProgress
+		}
+
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/repository"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+//go:generate mockgen -destination=mock/mock_session.go -package=mock github.com/hexley21/soccer-manager/internal/soccer-manager/service SessionService
+type SessionService interface {
+	ListSessions(ctx context.Context, userID int64) ([]domain.Session, error)
+	CheckSession(ctx context.Context, userID int64, sessionID int64) error
+
+	StartSession(ctx context.Context, userID int64, client domain.SessionClient) (domain.Session, error)
+	RotateSession(
+		ctx context.Context,
+		sessionID int64,
+		tokenID int64,
+		client domain.SessionClient,
+	) (domain.Session, error)
+	RevokeSession(
+		ctx context.Context,
+		userID int64,
+		sessionID int64,
+		reason domain.SessionRevokeReason,
+	) error
+	RevokeSessions(
+		ctx context.Context,
+		userID int64,
+		exceptSessionID int64,
+		reason domain.SessionRevokeReason,
+	) (int64, error)
+}
+
+type sessionServiceImpl struct {
+	sessionRepo repository.SessionRepository
+	ttl         time.Duration
+}
+
+// NewSessionService creates the service, sessions expire when unused for ttl
+func NewSessionService(sessionRepo repository.SessionRepository, ttl time.Duration) *sessionServiceImpl {
+	return &sessionServiceImpl{
+		sessionRepo: sessionRepo,
+		ttl:         ttl,
+	}
+}
+
+// ListSessions returns the active sessions of the user, most recently used first
+func (s *sessionServiceImpl) ListSessions(ctx context.Context, userID int64) ([]domain.Session, error) {
+	sessions, err := s.sessionRepo.ListActiveSessionsByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]domain.Session, len(sessions))
+	for i, session := range sessions {
+		res[i] = domain.SessionAdapter(session)
+	}
+
+	return res, nil
+}
+
+// CheckSession verifies the session an access token was issued for is still active
+//
+// If not found, revoked or expired - ErrSessionNotFound
+func (s *sessionServiceImpl) CheckSession(ctx context.Context, userID int64, sessionID int64) error {
+	active, err := s.sessionRepo.IsSessionActive(ctx, repository.IsSessionActiveParams{
+		ID:     sessionID,
+		UserID: userID,
+	})
+	if err != nil {
+		return err
+	}
+	if !active {
+		return ErrSessionNotFound
+	}
+
+	return nil
+}
+
+// StartSession opens a session at login, the refresh token handed out carries its ID and TokenID
+func (s *sessionServiceImpl) StartSession(
+	ctx context.Context,
+	userID int64,
+	client domain.SessionClient,
+) (domain.Session, error) {
+	session, err := s.sessionRepo.CreateSession(ctx, repository.CreateSessionParams{
+		UserID:    userID,
+		ExpiresAt: pgtype.Timestamptz{Time: time.Now().Add(s.ttl), Valid: true},
+		UserAgent: client.UserAgent,
+		IP:        client.IP,
+	})
+	if err != nil {
+		return domain.Session{}, err
+	}
+
+	return domain.SessionAdapter(session), nil
+}
+
+// RotateSession trades the presented token for a new one and extends the session,
+// presenting a token that was already traded revokes the session
+//
+// If not found, revoked or expired - ErrSessionNotFound
+// If the token was already used - ErrRefreshTokenReused
+func (s *sessionServiceImpl) RotateSession(
+	ctx context.Context,
+	sessionID int64,
+	tokenID int64,
+	client domain.SessionClient,
+) (domain.Session, error) {
+	session, err := s.sessionRepo.RotateSession(ctx, repository.RotateSessionParams{
+		ID:        sessionID,
+		TokenID:   tokenID,
+		ExpiresAt: pgtype.Timestamptz{Time: time.Now().Add(s.ttl), Valid: true},
+		UserAgent: client.UserAgent,
+		IP:        client.IP,
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return domain.Session{}, ErrSessionNotFound
+		}
+		if errors.Is(err, repository.ErrConflict) {
+			return domain.Session{}, ErrRefreshTokenReused
+		}
+
+		return domain.Session{}, err
+	}
+
+	return domain.SessionAdapter(session), nil
+}
+
+// RevokeSession ends one of the user's sessions
+//
+// If not found or already revoked - ErrSessionNotFound
+func (s *sessionServiceImpl) RevokeSession(
+	ctx context.Context,
+	userID int64,
+	sessionID int64,
+	reason domain.SessionRevokeReason,
+) error {
+	err := s.sessionRepo.RevokeSession(ctx, repository.RevokeSessionParams{
+		ID:     sessionID,
+		UserID: userID,
+		Reason: string(reason),
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrSessionNotFound
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// RevokeSessions ends every active session of the user but exceptSessionID, zero ends all of them.
+// Returns how many sessions were ended
+func (s *sessionServiceImpl) RevokeSessions(
+	ctx context.Context,
+	userID int64,
+	exceptSessionID int64,
+	reason domain.SessionRevokeReason,
+) (int64, error) {
+	return s.sessionRepo.RevokeUserSessions(ctx, repository.RevokeUserSessionsParams{
+		UserID:   userID,
+		ExceptID: exceptSessionID,
+		Reason:   string(reason),
+	})
+}
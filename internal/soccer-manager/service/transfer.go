@@ -3,19 +3,23 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/repository"
 	"github.com/jackc/pgerrcode"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 //go:generate mockgen -destination=mock/mock_transfer.go -package=mock github.com/hexley21/soccer-manager/internal/soccer-manager/service TransferService
 type TransferService interface {
 	ListTransfers(
 		ctx context.Context,
-		id int64,
+		filter domain.TransferFilter,
+		cursor domain.TransferCursor,
 		limit int32,
 	) ([]domain.Transfer, error)
 	GetTransferByID(
@@ -36,6 +40,9 @@ type TransferService interface {
 		userID int64,
 		playerID int64,
 		price int64,
+		auctionEndsAt time.Time,
+		reservePrice int64,
+		expiresAt time.Time,
 	) (int64, error)
 	DeleteTransfer(ctx context.Context, id int64, userId int64) error
 	UpdateTransferPrice(
@@ -44,32 +51,100 @@ type TransferService interface {
 		userId int64,
 		price int64,
 	) error
+	RenewTransfer(
+		ctx context.Context,
+		ID int64,
+		userId int64,
+		expiresAt time.Time,
+	) (time.Time, error)
+	ExpireTransfers(ctx context.Context) ([]domain.Transfer, error)
 	BuyPlayer(
 		ctx context.Context,
 		transferId int64,
 		buyerTeamId int64,
-	) error
+	) (domain.TransferRecord, error)
 }
 
 type transferServiceImpl struct {
-	transferRepo repository.TransferRepository
+	transferRepo       repository.TransferRepository
+	transferWindowRepo repository.TransferWindowRepository
+	listingTTL         time.Duration
+	batchSize          int32
 }
 
-func NewTransferService(transferRepo repository.TransferRepository) *transferServiceImpl {
+func NewTransferService(
+	transferRepo repository.TransferRepository,
+	transferWindowRepo repository.TransferWindowRepository,
+	listingTTL time.Duration,
+	batchSize int32,
+) *transferServiceImpl {
 	return &transferServiceImpl{
-		transferRepo: transferRepo,
+		transferRepo:       transferRepo,
+		transferWindowRepo: transferWindowRepo,
+		listingTTL:         listingTTL,
+		batchSize:          batchSize,
 	}
 }
 
+// ListTransfers lists the market page after the cursor, sorted by price the cursor carries
+// the price of the last listing too, so the page doesn't depend on that listing still being listed
+//
+// If filter sort is unknown - ErrInvalidArguments,
+// if sorted by price and the cursor has no price - ErrInvalidCursor
 func (s *transferServiceImpl) ListTransfers(
 	ctx context.Context,
-	id int64,
+	filter domain.TransferFilter,
+	cursor domain.TransferCursor,
 	limit int32,
 ) ([]domain.Transfer, error) {
-	transfers, err := s.transferRepo.ListTransfers(
-		ctx,
-		repository.ListTransfersParams{ID: id, Limit: limit},
-	)
+	if !filter.Sort.Valid() {
+		return nil, ErrInvalidArguments
+	}
+
+	sortByPrice := filter.Sort == domain.TransferSortPrice
+	if sortByPrice && cursor.ID != 0 && cursor.Price <= 0 {
+		return nil, ErrInvalidCursor
+	}
+
+	arg := repository.ListTransfersParams{
+		SortByPrice: sortByPrice,
+		Descending:  filter.Descending,
+		ID:          cursor.ID,
+		CursorPrice: cursor.Price,
+		Limit:       limit,
+	}
+	if filter.PositionCode != "" {
+		arg.PositionCode = pgtype.Text{String: filter.PositionCode, Valid: true}
+	}
+	if filter.MinPrice > 0 {
+		arg.MinPrice = pgtype.Int8{Int64: filter.MinPrice, Valid: true}
+	}
+	if filter.MaxPrice > 0 {
+		arg.MaxPrice = pgtype.Int8{Int64: filter.MaxPrice, Valid: true}
+	}
+	if filter.MinAge > 0 {
+		arg.MinAge = pgtype.Int4{Int32: filter.MinAge, Valid: true}
+	}
+	if filter.MaxAge > 0 {
+		arg.MaxAge = pgtype.Int4{Int32: filter.MaxAge, Valid: true}
+	}
+	if filter.CountryCode != "" {
+		arg.CountryCode = pgtype.Text{String: filter.CountryCode, Valid: true}
+	}
+	if filter.SellerTeamID > 0 {
+		arg.SellerTeamID = pgtype.Int8{Int64: filter.SellerTeamID, Valid: true}
+	}
+
+	// older listings have earlier timestamps, so the age bounds swap sides
+	now := time.Now()
+	if filter.MaxListingAge > 0 {
+		arg.ListedAfter = pgtype.Timestamptz{Time: now.Add(-filter.MaxListingAge), Valid: true}
+	}
+	if filter.MinListingAge > 0 {
+		arg.ListedBefore = pgtype.Timestamptz{Time: now.Add(-filter.MinListingAge), Valid: true}
+	}
+
+	transfers, err := s.transferRepo.ListTransfers(ctx, arg)
 	if err != nil {
 		return nil, err
 	}
@@ -91,6 +166,8 @@ func (s *transferServiceImpl) GetTransferByID(
 		if errors.Is(err, pgx.ErrNoRows) {
 			return domain.Transfer{}, ErrTransferNotFound
 		}
+
+		return domain.Transfer{}, err
 	}
 	return domain.TransferAdapter(transfer), nil
 }
@@ -129,20 +206,71 @@ func (s *transferServiceImpl) GetTransferByPlayerID(
 	return domain.TransferAdapter(transfer), nil
 }
 
+// CreateTransfer lists user's player for a fixed price,
+// or as an auction when auctionEndsAt is set, then price is the opening price
+// and reservePrice the minimum to sell at, defaulting to the opening price
+//
+// If player not found - ErrNonexistentCode
+// If player is already listed - ErrPlayerAlreadyInTransfers
+// If auction ends in the past or after the window closes,
+// or reserve is below the opening price - ErrInvalidArguments
+// If market is closed - ErrTransferWindowClosed
 func (s *transferServiceImpl) CreateTransfer(
 	ctx context.Context,
 	userID int64,
 	playerID int64,
 	price int64,
+	auctionEndsAt time.Time,
+	reservePrice int64,
+	expiresAt time.Time,
 ) (int64, error) {
-	transferId, err := s.transferRepo.InsertTransferRecordByUser(
-		ctx,
-		repository.InsertTransferRecordByUserParams{
-			UserID:   userID,
-			PlayerID: playerID,
-			Price:    price,
-		},
-	)
+	window, err := openTransferWindow(ctx, s.transferWindowRepo)
+	if err != nil {
+		return 0, err
+	}
+
+	arg := repository.InsertTransferRecordByUserParams{
+		UserID:   userID,
+		PlayerID: playerID,
+		Price:    price,
+	}
+
+	if !auctionEndsAt.IsZero() {
+		// auctions end within the window, unless no window was ever scheduled
+		if !auctionEndsAt.After(time.Now()) || (!window.ClosesAt.IsZero() && auctionEndsAt.After(window.ClosesAt)) {
+			return 0, ErrInvalidArguments
+		}
+		if reservePrice == 0 {
+			reservePrice = price
+		}
+		if reservePrice < price {
+			return 0, ErrInvalidArguments
+		}
+
+		// auctions end on their own
+		if !expiresAt.IsZero() {
+			return 0, ErrInvalidArguments
+		}
+
+		arg.AuctionEndsAt = pgtype.Timestamptz{Time: auctionEndsAt, Valid: true}
+		arg.ReservePrice = pgtype.Int8{Int64: reservePrice, Valid: true}
+	} else {
+		if reservePrice != 0 {
+			return 0, ErrInvalidArguments
+		}
+
+		if expiresAt.IsZero() && s.listingTTL > 0 {
+			expiresAt = time.Now().Add(s.listingTTL)
+		}
+		if !expiresAt.IsZero() {
+			if !expiresAt.After(time.Now()) {
+				return 0, ErrInvalidArguments
+			}
+			arg.ExpiresAt = pgtype.Timestamptz{Time: expiresAt, Valid: true}
+		}
+	}
+
+	transferId, err := s.transferRepo.InsertTransferRecordByUser(ctx, arg)
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
@@ -162,6 +290,11 @@ func (s *transferServiceImpl) CreateTransfer(
 	return transferId, nil
 }
 
+// DeleteTransfer removes the listing of the user and cancels its pending bids
+//
+// If not found - ErrTransferNotFound
+// If auction has ended - ErrAuctionEnded
+// If auction has open bids - ErrTransferIsAuction
 func (s *transferServiceImpl) DeleteTransfer(
 	ctx context.Context,
 	id int64,
@@ -174,6 +307,12 @@ func (s *transferServiceImpl) DeleteTransfer(
 		if errors.Is(err, repository.ErrNotFound) {
 			return ErrTransferNotFound
 		}
+		if errors.Is(err, repository.ErrNotAllowed) {
+			return ErrAuctionEnded
+		}
+		if errors.Is(err, repository.ErrConflict) {
+			return ErrTransferIsAuction
+		}
 
 		return err
 	}
@@ -181,12 +320,20 @@ func (s *transferServiceImpl) DeleteTransfer(
 	return nil
 }
 
+// UpdateTransferPrice changes the price of a fixed price listing
+//
+// If not found or listing is an auction - ErrTransferNotFound
+// If market is closed - ErrTransferWindowClosed
 func (s *transferServiceImpl) UpdateTransferPrice(
 	ctx context.Context,
 	ID int64,
 	userId int64,
 	price int64,
 ) error {
+	if _, err := openTransferWindow(ctx, s.transferWindowRepo); err != nil {
+		return err
+	}
+
 	if err := s.transferRepo.UpdateTransferPriceByIDAndUserID(
 		ctx,
 		repository.UpdateTransferPriceByIDAndUserIDParams{ID: ID, UserID: userId, Price: price},
@@ -209,31 +356,107 @@ func (s *transferServiceImpl) UpdateTransferPrice(
 	return nil
 }
 
+// RenewTransfer moves the expiry of a listing before it lapses and returns the new expiry,
+// zero expiresAt renews by the configured listing ttl
+//
+// If not found, never expires or already lapsed - ErrTransferNotFound,
+// if expiresAt is not in the future or there's no ttl to renew by - ErrInvalidArguments
+func (s *transferServiceImpl) RenewTransfer(
+	ctx context.Context,
+	ID int64,
+	userId int64,
+	expiresAt time.Time,
+) (time.Time, error) {
+	if expiresAt.IsZero() {
+		if s.listingTTL <= 0 {
+			return time.Time{}, ErrInvalidArguments
+		}
+		expiresAt = time.Now().Add(s.listingTTL)
+	}
+	if !expiresAt.After(time.Now()) {
+		return time.Time{}, ErrInvalidArguments
+	}
+
+	if err := s.transferRepo.RenewTransferByIDAndUserID(
+		ctx,
+		repository.RenewTransferByIDAndUserIDParams{
+			ID:        ID,
+			UserID:    userId,
+			ExpiresAt: pgtype.Timestamptz{Time: expiresAt, Valid: true},
+		},
+	); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return time.Time{}, ErrTransferNotFound
+		}
+
+		return time.Time{}, err
+	}
+
+	return expiresAt, nil
+}
+
+// ExpireTransfers delists up to a batch of lapsed listings and returns them,
+// failed listings are reported in the joined error and picked up again on the next run
+func (s *transferServiceImpl) ExpireTransfers(ctx context.Context) ([]domain.Transfer, error) {
+	ids, err := s.transferRepo.ListExpiredTransferIDs(ctx, s.batchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	var errs error
+	expired := make([]domain.Transfer, 0, len(ids))
+	for _, id := range ids {
+		transfer, err := s.transferRepo.ExpireTransfer(ctx, id)
+		if err != nil {
+			// sold, renewed or delisted by the seller meanwhile
+			if errors.Is(err, repository.ErrNotFound) {
+				continue
+			}
+
+			errs = errors.Join(errs, fmt.Errorf("expire transfer %d: %w", id, err))
+			continue
+		}
+		expired = append(expired, domain.TransferAdapter(transfer))
+	}
+
+	return expired, errs
+}
+
 // BuyPlayer establishes a transaction between seller and buyer
 // the player is being transfered to buyer's team
-// the transfer record is being inserted
+// the transfer record is being inserted and returned
 //
 // If transfer not found - ErrTransferNotFound
 // If buy attempt from yourself - ErrCantBuyFromYourself
 // If buy attempt without money - ErrNotEnoughFunds
+// If transfer is an auction - ErrTransferIsAuction
+// If market is closed - ErrTransferWindowClosed
 func (s *transferServiceImpl) BuyPlayer(
 	ctx context.Context,
 	transferId int64,
 	buyerTeamId int64,
-) error {
-	if err := s.transferRepo.BuyPlayer(ctx, transferId, buyerTeamId); err != nil {
+) (domain.TransferRecord, error) {
+	if _, err := openTransferWindow(ctx, s.transferWindowRepo); err != nil {
+		return domain.TransferRecord{}, err
+	}
+
+	record, err := s.transferRepo.BuyPlayer(ctx, transferId, buyerTeamId)
+	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
-			return ErrTransferNotFound
+			return domain.TransferRecord{}, ErrTransferNotFound
 		}
 		if errors.Is(err, repository.ErrConflict) {
-			return ErrCantBuyFromYourself
+			return domain.TransferRecord{}, ErrCantBuyFromYourself
 		}
 		if errors.Is(err, repository.ErrViolation) {
-			return ErrNotEnoughFunds
+			return domain.TransferRecord{}, ErrNotEnoughFunds
+		}
+		if errors.Is(err, repository.ErrNotAllowed) {
+			return domain.TransferRecord{}, ErrTransferIsAuction
 		}
 
-		return err
+		return domain.TransferRecord{}, err
 	}
 
-	return nil
+	return domain.TransferRecordAdapter(record), nil
 }
@@ -7,11 +7,17 @@ import (
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
 	"github.com/hexley21/soccer-manager/internal/soccer-manager/repository"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 //go:generate mockgen -destination=mock/mock_transfer_record.go -package=mock github.com/hexley21/soccer-manager/internal/soccer-manager/service TransferRecordService
 type TransferRecordService interface {
-	ListTransferRecords(ctx context.Context, id int64, limit int32) ([]domain.TransferRecord, error)
+	ListTransferRecords(
+		ctx context.Context,
+		filter domain.TransferRecordFilter,
+		id int64,
+		limit int32,
+	) ([]domain.TransferRecord, error)
 	GetTransferRecordByID(ctx context.Context, id int64) (domain.TransferRecord, error)
 }
 
@@ -27,19 +33,43 @@ func NewTransferRecordService(
 	}
 }
 
-// ListTransferRecords returns a list of transfers from db
+// ListTransferRecords returns a filtered list of transfers from db
+//
+// If sold range is empty - ErrInvalidArguments
 func (s *transferRecordServiceImpl) ListTransferRecords(
 	ctx context.Context,
+	filter domain.TransferRecordFilter,
 	id int64,
 	limit int32,
 ) ([]domain.TransferRecord, error) {
-	records, err := s.transferRecordRepo.ListTransferRecords(
-		ctx,
-		repository.ListTransferRecordsParams{
-			ID:    id,
-			Limit: limit,
-		},
-	)
+	if !filter.SoldFrom.IsZero() && !filter.SoldTo.IsZero() && !filter.SoldFrom.Before(filter.SoldTo) {
+		return nil, ErrInvalidArguments
+	}
+
+	arg := repository.ListTransferRecordsParams{
+		ID:    id,
+		Limit: limit,
+	}
+	if filter.PlayerID > 0 {
+		arg.PlayerID = pgtype.Int8{Int64: filter.PlayerID, Valid: true}
+	}
+	if filter.SellerTeamID > 0 {
+		arg.SellerTeamID = pgtype.Int8{Int64: filter.SellerTeamID, Valid: true}
+	}
+	if filter.BuyerTeamID > 0 {
+		arg.BuyerTeamID = pgtype.Int8{Int64: filter.BuyerTeamID, Valid: true}
+	}
+	if filter.TeamID > 0 {
+		arg.TeamID = pgtype.Int8{Int64: filter.TeamID, Valid: true}
+	}
+	if !filter.SoldFrom.IsZero() {
+		arg.SoldFrom = pgtype.Timestamptz{Time: filter.SoldFrom, Valid: true}
+	}
+	if !filter.SoldTo.IsZero() {
+		arg.SoldTo = pgtype.Timestamptz{Time: filter.SoldTo, Valid: true}
+	}
+
+	records, err := s.transferRecordRepo.ListTransferRecords(ctx, arg)
 	if err != nil {
 		return nil, err
 	}
@@ -64,6 +94,8 @@ func (s *transferRecordServiceImpl) GetTransferRecordByID(
 		if errors.Is(err, pgx.ErrNoRows) {
 			return domain.TransferRecord{}, ErrTransferRecordNotFound
 		}
+
+		return domain.TransferRecord{}, err
 	}
 
 	return domain.TransferRecordAdapter(record), nil
@@ -0,0 +1,228 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/repository"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+//go:generate mockgen -destination=mock/mock_transfer_window.go -package=mock github.com/hexley21/soccer-manager/internal/soccer-manager/service TransferWindowService
+type TransferWindowService interface {
+	GetTransferWindowByID(ctx context.Context, id int64) (domain.TransferWindow, error)
+	ListTransferWindows(ctx context.Context, cursor int64, limit int32) ([]domain.TransferWindow, error)
+	GetTransferWindowStatus(ctx context.Context) (domain.TransferWindowStatus, error)
+
+	CreateTransferWindow(
+		ctx context.Context,
+		name string,
+		opensAt time.Time,
+		closesAt time.Time,
+	) (domain.TransferWindow, error)
+	UpdateTransferWindow(
+		ctx context.Context,
+		id int64,
+		name string,
+		opensAt time.Time,
+		closesAt time.Time,
+	) (domain.TransferWindow, error)
+	DeleteTransferWindow(ctx context.Context, id int64) error
+}
+
+type transferWindowServiceImpl struct {
+	transferWindowRepo repository.TransferWindowRepository
+}
+
+func NewTransferWindowService(transferWindowRepo repository.TransferWindowRepository) *transferWindowServiceImpl {
+	return &transferWindowServiceImpl{
+		transferWindowRepo: transferWindowRepo,
+	}
+}
+
+// GetTransferWindowByID
+//
+// If not found - ErrTransferWindowNotFound
+func (s *transferWindowServiceImpl) GetTransferWindowByID(ctx context.Context, id int64) (domain.TransferWindow, error) {
+	window, err := s.transferWindowRepo.GetTransferWindowByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.TransferWindow{}, ErrTransferWindowNotFound
+		}
+
+		return domain.TransferWindow{}, err
+	}
+
+	return domain.TransferWindowAdapter(window), nil
+}
+
+func (s *transferWindowServiceImpl) ListTransferWindows(
+	ctx context.Context,
+	cursor int64,
+	limit int32,
+) ([]domain.TransferWindow, error) {
+	windows, err := s.transferWindowRepo.ListTransferWindows(ctx, repository.ListTransferWindowsParams{
+		ID:    cursor,
+		Limit: limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]domain.TransferWindow, len(windows))
+	for i, w := range windows {
+		res[i] = domain.TransferWindowAdapter(w)
+	}
+
+	return res, nil
+}
+
+// GetTransferWindowStatus returns the window the market is open in, if any, and the next scheduled one.
+// The market is open without a current window until the first window is scheduled
+func (s *transferWindowServiceImpl) GetTransferWindowStatus(ctx context.Context) (domain.TransferWindowStatus, error) {
+	var status domain.TransferWindowStatus
+
+	current, err := openTransferWindow(ctx, s.transferWindowRepo)
+	switch {
+	case err == nil:
+		status.IsOpen = true
+		if !current.ClosesAt.IsZero() {
+			status.Current = &current
+		}
+	case !errors.Is(err, ErrTransferWindowClosed):
+		return domain.TransferWindowStatus{}, err
+	}
+
+	next, err := s.transferWindowRepo.GetNextTransferWindow(ctx)
+	switch {
+	case err == nil:
+		window := domain.TransferWindowAdapter(next)
+		status.Next = &window
+	case !errors.Is(err, pgx.ErrNoRows):
+		return domain.TransferWindowStatus{}, err
+	}
+
+	return status, nil
+}
+
+// CreateTransferWindow schedules a period when the market is open
+//
+// If closes before it opens - ErrInvalidArguments
+// If overlaps another window - ErrTransferWindowOverlaps
+func (s *transferWindowServiceImpl) CreateTransferWindow(
+	ctx context.Context,
+	name string,
+	opensAt time.Time,
+	closesAt time.Time,
+) (domain.TransferWindow, error) {
+	if !closesAt.After(opensAt) {
+		return domain.TransferWindow{}, ErrInvalidArguments
+	}
+
+	window, err := s.transferWindowRepo.InsertTransferWindow(ctx, repository.InsertTransferWindowParams{
+		Name:     name,
+		OpensAt:  pgtype.Timestamptz{Time: opensAt, Valid: true},
+		ClosesAt: pgtype.Timestamptz{Time: closesAt, Valid: true},
+	})
+	if err != nil {
+		return domain.TransferWindow{}, mapTransferWindowError(err)
+	}
+
+	return domain.TransferWindowAdapter(window), nil
+}
+
+// UpdateTransferWindow reschedules or renames a window
+//
+// If not found - ErrTransferWindowNotFound
+// If closes before it opens - ErrInvalidArguments
+// If overlaps another window - ErrTransferWindowOverlaps
+func (s *transferWindowServiceImpl) UpdateTransferWindow(
+	ctx context.Context,
+	id int64,
+	name string,
+	opensAt time.Time,
+	closesAt time.Time,
+) (domain.TransferWindow, error) {
+	if !closesAt.After(opensAt) {
+		return domain.TransferWindow{}, ErrInvalidArguments
+	}
+
+	window, err := s.transferWindowRepo.UpdateTransferWindow(ctx, repository.UpdateTransferWindowParams{
+		ID:       id,
+		Name:     name,
+		OpensAt:  pgtype.Timestamptz{Time: opensAt, Valid: true},
+		ClosesAt: pgtype.Timestamptz{Time: closesAt, Valid: true},
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.TransferWindow{}, ErrTransferWindowNotFound
+		}
+
+		return domain.TransferWindow{}, mapTransferWindowError(err)
+	}
+
+	return domain.TransferWindowAdapter(window), nil
+}
+
+// DeleteTransferWindow
+//
+// If not found - ErrTransferWindowNotFound
+func (s *transferWindowServiceImpl) DeleteTransferWindow(ctx context.Context, id int64) error {
+	if err := s.transferWindowRepo.DeleteTransferWindowByID(ctx, id); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrTransferWindowNotFound
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func mapTransferWindowError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case pgerrcode.ExclusionViolation:
+			return ErrTransferWindowOverlaps
+		case pgerrcode.CheckViolation:
+			return ErrInvalidArguments
+		}
+	}
+
+	return err
+}
+
+// openTransferWindow returns the window the market is open in,
+// market operations must call it before touching listings, bids or offers.
+// Until the first window is scheduled the market is always open and the zero window is returned
+//
+// If market is closed - ErrTransferWindowClosed
+func openTransferWindow(
+	ctx context.Context,
+	transferWindowRepo repository.TransferWindowRepository,
+) (domain.TransferWindow, error) {
+	window, err := transferWindowRepo.GetCurrentTransferWindow(ctx)
+	if err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return domain.TransferWindow{}, err
+		}
+
+		scheduled, err := transferWindowRepo.HasTransferWindows(ctx)
+		if err != nil {
+			return domain.TransferWindow{}, err
+		}
+		if scheduled {
+			return domain.TransferWindow{}, ErrTransferWindowClosed
+		}
+
+		return domain.TransferWindow{}, nil
+	}
+
+	return domain.TransferWindowAdapter(window), nil
+}
@@ -14,7 +14,9 @@ import (
 type UserService interface {
 	Get(ctx context.Context, userId int64) (domain.User, error)
 	List(ctx context.Context, cursor int64, limit int32) ([]domain.User, error)
-	UpdatePassword(ctx context.Context, id int64, oldPassowrd string, newPassword string) error
+	UpdatePassword(ctx context.Context, id int64, sessionID int64, oldPassowrd string, newPassword string) error
+	ChangeRole(ctx context.Context, userId int64, role domain.UserRole) (domain.User, error)
+	BootstrapAdmin(ctx context.Context, username string) (int64, error)
 	Delete(ctx context.Context, userId int64) error
 }
 
@@ -71,13 +73,15 @@ func (s *userServiceImpl) List(
 	return res, nil
 }
 
-// UpdatePassword updates password by validating old one first and updating after
+// UpdatePassword updates password by validating old one first and updating after,
+// every session of the user but sessionID is revoked along
 //
 // If user not found - ErrUserNotFound
 // If incorrect password - ErrIncorrectPassword
 func (s *userServiceImpl) UpdatePassword(
 	ctx context.Context,
 	id int64,
+	sessionID int64,
 	oldPassowrd string,
 	newPassword string,
 ) error {
@@ -103,8 +107,9 @@ func (s *userServiceImpl) UpdatePassword(
 	}
 
 	err = s.userRepo.UpdateUserHash(ctx, repository.UpdateUserHashParams{
-		ID:   id,
-		Hash: newHash,
+		ID:              id,
+		Hash:            newHash,
+		ExceptSessionID: sessionID,
 	})
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
@@ -117,6 +122,58 @@ func (s *userServiceImpl) UpdatePassword(
 	return nil
 }
 
+// ChangeRole promotes or demotes the user, every session of the user is revoked
+// so tokens with the old role stop working
+//
+// If user not found - ErrUserNotFound
+// If user already has the role - ErrRoleUnchanged
+// If demoting the last admin - ErrLastAdmin
+func (s *userServiceImpl) ChangeRole(
+	ctx context.Context,
+	userId int64,
+	role domain.UserRole,
+) (domain.User, error) {
+	user, err := s.userRepo.UpdateUserRole(ctx, repository.UpdateUserRoleParams{
+		ID:   userId,
+		Role: string(role),
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return domain.User{}, ErrUserNotFound
+		}
+		if errors.Is(err, repository.ErrConflict) {
+			return domain.User{}, ErrRoleUnchanged
+		}
+		if errors.Is(err, repository.ErrNotAllowed) {
+			return domain.User{}, ErrLastAdmin
+		}
+
+		return domain.User{}, err
+	}
+
+	return domain.NewUser(user.ID, user.Username, user.Role), nil
+}
+
+// BootstrapAdmin promotes the user with the username to the first admin and returns its id
+//
+// If an admin already exists - ErrAdminExists
+// If user not found - ErrUserNotFound
+func (s *userServiceImpl) BootstrapAdmin(ctx context.Context, username string) (int64, error) {
+	id, err := s.userRepo.PromoteFirstAdmin(ctx, username)
+	if err != nil {
+		if errors.Is(err, repository.ErrConflict) {
+			return 0, ErrAdminExists
+		}
+		if errors.Is(err, repository.ErrNotFound) {
+			return 0, ErrUserNotFound
+		}
+
+		return 0, err
+	}
+
+	return id, nil
+}
+
 // Delete removes user form db
 //
 // If user not found - ErrUserNotFound
@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/repository"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+//go:generate mockgen -destination=mock/mock_watchlist.go -package=mock github.com/hexley21/soccer-manager/internal/soccer-manager/service WatchlistService
+type WatchlistService interface {
+	ListWatchlist(ctx context.Context, userID int64, cursor int64, limit int32) ([]domain.WatchlistPlayer, error)
+	ListWatchers(ctx context.Context, transfer domain.Transfer) ([]int64, error)
+
+	WatchPlayer(ctx context.Context, userID int64, playerID int64) error
+	UnwatchPlayer(ctx context.Context, userID int64, playerID int64) error
+}
+
+type watchlistServiceImpl struct {
+	watchlistRepo repository.WatchlistRepository
+}
+
+func NewWatchlistService(watchlistRepo repository.WatchlistRepository) *watchlistServiceImpl {
+	return &watchlistServiceImpl{
+		watchlistRepo: watchlistRepo,
+	}
+}
+
+// ListWatchlist returns watched players of the user with their live listings, the cursor is a player id
+func (s *watchlistServiceImpl) ListWatchlist(
+	ctx context.Context,
+	userID int64,
+	cursor int64,
+	limit int32,
+) ([]domain.WatchlistPlayer, error) {
+	players, err := s.watchlistRepo.ListWatchlistByUserID(ctx, repository.ListWatchlistByUserIDParams{
+		UserID:   userID,
+		PlayerID: cursor,
+		Limit:    limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]domain.WatchlistPlayer, len(players))
+	for i, p := range players {
+		res[i] = domain.WatchlistPlayerAdapter(p)
+	}
+
+	return res, nil
+}
+
+// ListWatchers returns ids of users watching the listed player, the seller is never among them
+func (s *watchlistServiceImpl) ListWatchers(ctx context.Context, transfer domain.Transfer) ([]int64, error) {
+	return s.watchlistRepo.ListWatcherIDsByPlayerID(ctx, repository.ListWatcherIDsByPlayerIDParams{
+		PlayerID: transfer.PlayerID,
+		TeamID:   transfer.SellerTeamID,
+	})
+}
+
+// WatchPlayer adds the player to the user's watchlist
+//
+// If player not found - ErrPlayerNotFound,
+// if already watched - ErrPlayerAlreadyWatched
+func (s *watchlistServiceImpl) WatchPlayer(ctx context.Context, userID int64, playerID int64) error {
+	if err := s.watchlistRepo.InsertWatchlist(ctx, repository.InsertWatchlistParams{
+		UserID:   userID,
+		PlayerID: playerID,
+	}); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.Code {
+			case pgerrcode.ForeignKeyViolation:
+				return ErrPlayerNotFound
+			case pgerrcode.UniqueViolation:
+				return ErrPlayerAlreadyWatched
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// UnwatchPlayer removes the player from the user's watchlist
+//
+// If not watched - ErrPlayerNotWatched
+func (s *watchlistServiceImpl) UnwatchPlayer(ctx context.Context, userID int64, playerID int64) error {
+	if err := s.watchlistRepo.DeleteWatchlist(ctx, repository.DeleteWatchlistParams{
+		UserID:   userID,
+		PlayerID: playerID,
+	}); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrPlayerNotWatched
+		}
+
+		return err
+	}
+
+	return nil
+}
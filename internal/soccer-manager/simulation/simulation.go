@@ -0,0 +1,312 @@
+package simulation
+
+import (
+	"errors"
+	"math/rand"
+	"slices"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
+)
+
+var ErrNotEnoughPlayers = errors.New("not enough players for a lineup")
+
+const (
+	LineupSize       = 11
+	MatchMinutes     = 90
+	MaxSubstitutions = 3
+
+	// minimum amount of players on the pitch, sending off stops below it
+	minOnPitch = 7
+
+	baseGoalChance   = 0.015
+	homeAdvantage    = 1.1
+	yellowCardChance = 0.02
+	redCardChance    = 0.0015
+)
+
+// formation is the 1-4-4-2 lineup filled in order of positions
+var formation = []struct {
+	position domain.PlayerPositionCode
+	amount   int
+}{
+	{domain.PlayerPositionCodeGLK, 1},
+	{domain.PlayerPositionCodeDEF, 4},
+	{domain.PlayerPositionCodeMID, 4},
+	{domain.PlayerPositionCodeATK, 2},
+}
+
+// substitution windows in minutes
+var substitutionMinutes = []int32{60, 70, 80}
+
+type Team struct {
+	ID      int64
+	Players []domain.Player
+}
+
+type Result struct {
+	HomeScore int32
+	AwayScore int32
+	Events    []domain.MatchEvent
+}
+
+type side struct {
+	teamID   int64
+	onPitch  []domain.Player
+	bench    []domain.Player
+	booked   map[int64]struct{}
+	subsLeft int
+	score    int32
+}
+
+// Simulate plays a match between home and away teams minute by minute.
+// All randomness is derived from the seed, so the same seed and rosters
+// always produce the same score and timeline.
+//
+// If any team has less than LineupSize players - ErrNotEnoughPlayers
+func Simulate(seed int64, home Team, away Team) (Result, error) {
+	homeSide, err := newSide(home)
+	if err != nil {
+		return Result{}, err
+	}
+	awaySide, err := newSide(away)
+	if err != nil {
+		return Result{}, err
+	}
+
+	rnd := rand.New(rand.NewSource(seed))
+	var events []domain.MatchEvent
+
+	for minute := int32(1); minute <= MatchMinutes; minute++ {
+		for _, pair := range [2]struct {
+			attacker, defender *side
+			advantage          float64
+		}{
+			{homeSide, awaySide, homeAdvantage},
+			{awaySide, homeSide, 1},
+		} {
+			if event, ok := pair.attacker.attack(rnd, pair.defender, pair.advantage, minute); ok {
+				events = append(events, event)
+			}
+			if event, ok := pair.attacker.foul(rnd, minute); ok {
+				events = append(events, event)
+			}
+		}
+
+		if slices.Contains(substitutionMinutes, minute) {
+			for _, s := range [2]*side{homeSide, awaySide} {
+				if event, ok := s.substitute(rnd, minute); ok {
+					events = append(events, event)
+				}
+			}
+		}
+	}
+
+	return Result{
+		HomeScore: homeSide.score,
+		AwayScore: awaySide.score,
+		Events:    events,
+	}, nil
+}
+
+// newSide picks the starting lineup according to formation,
+// filling missing positions with whoever is left, the rest goes to the bench
+func newSide(team Team) (*side, error) {
+	if len(team.Players) < LineupSize {
+		return nil, ErrNotEnoughPlayers
+	}
+
+	players := slices.Clone(team.Players)
+	slices.SortFunc(players, func(a, b domain.Player) int {
+		switch {
+		case a.ID < b.ID:
+			return -1
+		case a.ID > b.ID:
+			return 1
+		}
+		return 0
+	})
+
+	picked := make([]bool, len(players))
+	lineup := make([]domain.Player, 0, LineupSize)
+
+	for _, slot := range formation {
+		taken := 0
+		for i, p := range players {
+			if taken == slot.amount {
+				break
+			}
+			if !picked[i] && p.PositionCode == slot.position {
+				picked[i] = true
+				lineup = append(lineup, p)
+				taken++
+			}
+		}
+	}
+
+	bench := make([]domain.Player, 0, len(players)-LineupSize)
+	for i, p := range players {
+		if picked[i] {
+			continue
+		}
+		if len(lineup) < LineupSize {
+			lineup = append(lineup, p)
+			continue
+		}
+		bench = append(bench, p)
+	}
+
+	return &side{
+		teamID:   team.ID,
+		onPitch:  lineup,
+		bench:    bench,
+		booked:   make(map[int64]struct{}),
+		subsLeft: MaxSubstitutions,
+	}, nil
+}
+
+func (s *side) attack(
+	rnd *rand.Rand,
+	opponent *side,
+	advantage float64,
+	minute int32,
+) (domain.MatchEvent, bool) {
+	attack := s.strength(attackWeights)
+	defense := opponent.strength(defenseWeights)
+
+	chance := baseGoalChance * advantage
+	if attack+defense > 0 {
+		chance *= 2 * attack / (attack + defense)
+	}
+
+	if rnd.Float64() >= chance {
+		return domain.MatchEvent{}, false
+	}
+
+	scorer := s.pickWeighted(rnd, scorerWeights)
+	s.score++
+
+	return domain.MatchEvent{
+		Minute:   minute,
+		Type:     domain.MatchEventTypeGOAL,
+		TeamID:   s.teamID,
+		PlayerID: scorer.ID,
+	}, true
+}
+
+func (s *side) foul(rnd *rand.Rand, minute int32) (domain.MatchEvent, bool) {
+	roll := rnd.Float64()
+	if roll >= yellowCardChance+redCardChance || len(s.onPitch) <= minOnPitch {
+		return domain.MatchEvent{}, false
+	}
+
+	idx := rnd.Intn(len(s.onPitch))
+	player := s.onPitch[idx]
+
+	eventType := domain.MatchEventTypeYELLOWCARD
+	if _, ok := s.booked[player.ID]; ok || roll < redCardChance {
+		eventType = domain.MatchEventTypeREDCARD
+		s.onPitch = slices.Delete(s.onPitch, idx, idx+1)
+	} else {
+		s.booked[player.ID] = struct{}{}
+	}
+
+	return domain.MatchEvent{
+		Minute:   minute,
+		Type:     eventType,
+		TeamID:   s.teamID,
+		PlayerID: player.ID,
+	}, true
+}
+
+// substitute replaces a random outfield player with a bench player,
+// preferring someone of the same position
+func (s *side) substitute(rnd *rand.Rand, minute int32) (domain.MatchEvent, bool) {
+	if s.subsLeft == 0 || len(s.bench) == 0 {
+		return domain.MatchEvent{}, false
+	}
+
+	var outfield []int
+	for i, p := range s.onPitch {
+		if p.PositionCode != domain.PlayerPositionCodeGLK {
+			outfield = append(outfield, i)
+		}
+	}
+	if len(outfield) == 0 {
+		return domain.MatchEvent{}, false
+	}
+
+	outIdx := outfield[rnd.Intn(len(outfield))]
+	out := s.onPitch[outIdx]
+
+	inIdx := slices.IndexFunc(s.bench, func(p domain.Player) bool {
+		return p.PositionCode == out.PositionCode
+	})
+	if inIdx == -1 {
+		inIdx = 0
+	}
+	in := s.bench[inIdx]
+
+	s.onPitch[outIdx] = in
+	s.bench = slices.Delete(s.bench, inIdx, inIdx+1)
+	s.subsLeft--
+
+	return domain.MatchEvent{
+		Minute:          minute,
+		Type:            domain.MatchEventTypeSUBSTITUTION,
+		TeamID:          s.teamID,
+		PlayerID:        out.ID,
+		RelatedPlayerID: in.ID,
+	}, true
+}
+
+type positionWeights map[domain.PlayerPositionCode]float64
+
+var (
+	attackWeights = positionWeights{
+		domain.PlayerPositionCodeDEF: 0.2,
+		domain.PlayerPositionCodeMID: 0.5,
+		domain.PlayerPositionCodeATK: 1,
+	}
+	defenseWeights = positionWeights{
+		domain.PlayerPositionCodeGLK: 1.5,
+		domain.PlayerPositionCodeDEF: 1,
+		domain.PlayerPositionCodeMID: 0.5,
+	}
+	scorerWeights = positionWeights{
+		domain.PlayerPositionCodeDEF: 1,
+		domain.PlayerPositionCodeMID: 3,
+		domain.PlayerPositionCodeATK: 6,
+	}
+)
+
+func (s *side) strength(weights positionWeights) float64 {
+	var total float64
+	for _, p := range s.onPitch {
+		total += weights[p.PositionCode] * rating(p)
+	}
+	return total
+}
+
+func (s *side) pickWeighted(rnd *rand.Rand, weights positionWeights) domain.Player {
+	var total float64
+	for _, p := range s.onPitch {
+		total += weights[p.PositionCode]
+	}
+	if total == 0 {
+		return s.onPitch[rnd.Intn(len(s.onPitch))]
+	}
+
+	roll := rnd.Float64() * total
+	for _, p := range s.onPitch {
+		roll -= weights[p.PositionCode]
+		if roll < 0 {
+			return p
+		}
+	}
+	return s.onPitch[len(s.onPitch)-1]
+}
+
+// rating estimates player's strength from the overall rating
+func rating(p domain.Player) float64 {
+	return float64(p.Rating())
+}
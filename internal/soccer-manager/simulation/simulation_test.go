@@ -0,0 +1,117 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/rating"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/simulation"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTeam(id int64, size int) simulation.Team {
+	positions := []domain.PlayerPositionCode{
+		domain.PlayerPositionCodeGLK,
+		domain.PlayerPositionCodeDEF,
+		domain.PlayerPositionCodeDEF,
+		domain.PlayerPositionCodeMID,
+		domain.PlayerPositionCodeMID,
+		domain.PlayerPositionCodeATK,
+	}
+
+	players := make([]domain.Player, size)
+	rnd := rand.New(rand.NewSource(id))
+	for i := range players {
+		position := positions[i%len(positions)]
+		players[i] = domain.Player{
+			ID:           id*100 + int64(i),
+			TeamID:       id,
+			Age:          int32(18 + i%20),
+			PositionCode: position,
+			Attributes:   rating.Generate(rnd, string(position)),
+		}
+	}
+
+	return simulation.Team{ID: id, Players: players}
+}
+
+func Test_Simulate(t *testing.T) {
+	home := newTeam(1, 20)
+	away := newTeam(2, 20)
+
+	t.Run("same seed same result", func(t *testing.T) {
+		first, err := simulation.Simulate(42, home, away)
+		assert.NoError(t, err)
+
+		second, err := simulation.Simulate(42, home, away)
+		assert.NoError(t, err)
+
+		assert.Equal(t, first, second)
+	})
+
+	t.Run("roster order does not matter", func(t *testing.T) {
+		reversed := simulation.Team{ID: home.ID, Players: make([]domain.Player, len(home.Players))}
+		for i, p := range home.Players {
+			reversed.Players[len(home.Players)-1-i] = p
+		}
+
+		first, err := simulation.Simulate(7, home, away)
+		assert.NoError(t, err)
+
+		second, err := simulation.Simulate(7, reversed, away)
+		assert.NoError(t, err)
+
+		assert.Equal(t, first, second)
+	})
+
+	t.Run("timeline matches score", func(t *testing.T) {
+		for seed := range int64(50) {
+			res, err := simulation.Simulate(seed, home, away)
+			assert.NoError(t, err)
+
+			var homeGoals, awayGoals int32
+			subs := map[int64]int{}
+			lastMinute := int32(0)
+			for _, e := range res.Events {
+				assert.GreaterOrEqual(t, e.Minute, lastMinute)
+				lastMinute = e.Minute
+
+				switch e.Type {
+				case domain.MatchEventTypeGOAL:
+					if e.TeamID == home.ID {
+						homeGoals++
+					} else {
+						awayGoals++
+					}
+				case domain.MatchEventTypeSUBSTITUTION:
+					subs[e.TeamID]++
+					assert.NotZero(t, e.RelatedPlayerID)
+				}
+			}
+
+			assert.Equal(t, res.HomeScore, homeGoals)
+			assert.Equal(t, res.AwayScore, awayGoals)
+			for _, amount := range subs {
+				assert.LessOrEqual(t, amount, simulation.MaxSubstitutions)
+			}
+		}
+	})
+
+	t.Run("no bench no substitutions", func(t *testing.T) {
+		res, err := simulation.Simulate(3, newTeam(1, simulation.LineupSize), newTeam(2, simulation.LineupSize))
+		assert.NoError(t, err)
+
+		for _, e := range res.Events {
+			assert.NotEqual(t, domain.MatchEventTypeSUBSTITUTION, e.Type)
+		}
+	})
+
+	t.Run("not enough players", func(t *testing.T) {
+		_, err := simulation.Simulate(1, newTeam(1, simulation.LineupSize-1), away)
+		assert.ErrorIs(t, err, simulation.ErrNotEnoughPlayers)
+
+		_, err = simulation.Simulate(1, home, newTeam(2, 0))
+		assert.ErrorIs(t, err, simulation.ErrNotEnoughPlayers)
+	})
+}
@@ -0,0 +1,211 @@
+package stream
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+var ErrInvalidTopic = errors.New("invalid topic")
+
+// TopicMarket carries public market events: listings, price changes and sales
+const TopicMarket = "market"
+
+// TeamTopic carries events concerning the team
+func TeamTopic(teamID int64) string {
+	return "team:" + strconv.FormatInt(teamID, 10)
+}
+
+// PlayerTopic carries events concerning the player
+func PlayerTopic(playerID int64) string {
+	return "player:" + strconv.FormatInt(playerID, 10)
+}
+
+// UserTopic carries private events of the user, only the user may subscribe to it
+func UserTopic(userID int64) string {
+	return "user:" + strconv.FormatInt(userID, 10)
+}
+
+// ParseTopic validates a topic requested by a client, only public topics are accepted
+func ParseTopic(topic string) (string, error) {
+	if topic == TopicMarket {
+		return topic, nil
+	}
+
+	kind, id, ok := strings.Cut(topic, ":")
+	if !ok {
+		return "", ErrInvalidTopic
+	}
+
+	parsed, err := strconv.ParseInt(id, 10, 64)
+	if err != nil || parsed <= 0 {
+		return "", ErrInvalidTopic
+	}
+
+	switch kind {
+	case "team":
+		return TeamTopic(parsed), nil
+	case "player":
+		return PlayerTopic(parsed), nil
+	default:
+		return "", ErrInvalidTopic
+	}
+}
+
+// Message is delivered to every subscription following any of its topics,
+// Data must be marshallable to JSON
+type Message struct {
+	Topics []string
+	Event  string
+	Data   any
+}
+
+// Broker fans messages out to subscriptions without ever blocking the publisher,
+// a subscription that can't keep up loses messages instead
+type Broker struct {
+	mu     sync.RWMutex
+	subs   map[*Subscription]struct{}
+	buffer int
+	closed bool
+}
+
+// NewBroker creates a broker, buffer is the number of messages a subscription may lag behind
+func NewBroker(buffer int) *Broker {
+	return &Broker{
+		subs:   make(map[*Subscription]struct{}),
+		buffer: buffer,
+	}
+}
+
+// Subscribe starts following the topics, the subscription must be closed with Unsubscribe.
+// Subscriptions to a closed broker come closed
+func (b *Broker) Subscribe(topics ...string) *Subscription {
+	s := &Subscription{
+		c:      make(chan Message, b.buffer),
+		topics: make(map[string]struct{}, len(topics)),
+	}
+	s.Follow(topics...)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		close(s.c)
+		return s
+	}
+	b.subs[s] = struct{}{}
+
+	return s
+}
+
+// Unsubscribe stops the delivery and closes the subscription channel, it's safe to call twice
+func (b *Broker) Unsubscribe(s *Subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.subs[s]; !ok {
+		return
+	}
+	delete(b.subs, s)
+	close(s.c)
+}
+
+// Close unsubscribes everyone, so open streams end instead of holding the shutdown
+func (b *Broker) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.closed = true
+	for s := range b.subs {
+		delete(b.subs, s)
+		close(s.c)
+	}
+}
+
+// Publish delivers the message to following subscriptions, full subscriptions drop it
+func (b *Broker) Publish(msg Message) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for s := range b.subs {
+		if !s.follows(msg.Topics) {
+			continue
+		}
+
+		select {
+		case s.c <- msg:
+		default:
+			s.dropped.Add(1)
+		}
+	}
+}
+
+// Subscription is a buffered feed of messages on the followed topics
+type Subscription struct {
+	c chan Message
+
+	mu     sync.RWMutex
+	topics map[string]struct{}
+
+	dropped atomic.Uint64
+}
+
+// C is closed once unsubscribed
+func (s *Subscription) C() <-chan Message {
+	return s.c
+}
+
+// Follow adds topics to the subscription
+func (s *Subscription) Follow(topics ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, t := range topics {
+		s.topics[t] = struct{}{}
+	}
+}
+
+// Unfollow removes topics from the subscription
+func (s *Subscription) Unfollow(topics ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, t := range topics {
+		delete(s.topics, t)
+	}
+}
+
+// Topics returns the followed topics in order
+func (s *Subscription) Topics() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	topics := make([]string, 0, len(s.topics))
+	for t := range s.topics {
+		topics = append(topics, t)
+	}
+	slices.Sort(topics)
+
+	return topics
+}
+
+// Dropped returns the number of messages lost because the subscription was full
+func (s *Subscription) Dropped() uint64 {
+	return s.dropped.Load()
+}
+
+func (s *Subscription) follows(topics []string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, t := range topics {
+		if _, ok := s.topics[t]; ok {
+			return true
+		}
+	}
+
+	return false
+}
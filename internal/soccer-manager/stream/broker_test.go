@@ -0,0 +1,139 @@
+package stream_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/stream"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Broker(t *testing.T) {
+	t.Run("delivers to followed topics only", func(t *testing.T) {
+		b := stream.NewBroker(4)
+		market := b.Subscribe(stream.TopicMarket)
+		team := b.Subscribe(stream.TeamTopic(1))
+		defer b.Unsubscribe(market)
+		defer b.Unsubscribe(team)
+
+		b.Publish(stream.Message{Topics: []string{stream.TopicMarket, stream.TeamTopic(2)}, Event: "listed"})
+
+		assert.Equal(t, "listed", (<-market.C()).Event)
+		assert.Empty(t, team.C())
+	})
+
+	t.Run("message matching many topics is delivered once", func(t *testing.T) {
+		b := stream.NewBroker(4)
+		s := b.Subscribe(stream.TopicMarket, stream.PlayerTopic(7))
+		defer b.Unsubscribe(s)
+
+		b.Publish(stream.Message{Topics: []string{stream.TopicMarket, stream.PlayerTopic(7)}})
+
+		assert.Len(t, s.C(), 1)
+	})
+
+	t.Run("follow and unfollow", func(t *testing.T) {
+		b := stream.NewBroker(4)
+		s := b.Subscribe()
+		defer b.Unsubscribe(s)
+
+		s.Follow(stream.PlayerTopic(1))
+		b.Publish(stream.Message{Topics: []string{stream.PlayerTopic(1)}})
+		s.Unfollow(stream.PlayerTopic(1))
+		b.Publish(stream.Message{Topics: []string{stream.PlayerTopic(1)}})
+
+		assert.Len(t, s.C(), 1)
+	})
+
+	t.Run("slow subscriber drops instead of blocking", func(t *testing.T) {
+		b := stream.NewBroker(1)
+		slow := b.Subscribe(stream.TopicMarket)
+		fast := b.Subscribe(stream.TopicMarket)
+		defer b.Unsubscribe(slow)
+		defer b.Unsubscribe(fast)
+
+		done := make(chan struct{})
+		go func() {
+			for range 3 {
+				b.Publish(stream.Message{Topics: []string{stream.TopicMarket}})
+				<-fast.C()
+			}
+			close(done)
+		}()
+
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("publisher was blocked by a slow subscriber")
+		}
+		assert.Len(t, slow.C(), 1)
+		assert.Equal(t, uint64(2), slow.Dropped())
+		assert.Zero(t, fast.Dropped())
+	})
+
+	t.Run("unsubscribe closes the channel once", func(t *testing.T) {
+		b := stream.NewBroker(1)
+		s := b.Subscribe(stream.TopicMarket)
+
+		b.Unsubscribe(s)
+		b.Unsubscribe(s)
+		b.Publish(stream.Message{Topics: []string{stream.TopicMarket}})
+
+		_, open := <-s.C()
+		assert.False(t, open)
+	})
+
+	t.Run("close ends every subscription", func(t *testing.T) {
+		b := stream.NewBroker(1)
+		before := b.Subscribe(stream.TopicMarket)
+
+		b.Close()
+		after := b.Subscribe(stream.TopicMarket)
+		b.Unsubscribe(before)
+
+		_, open := <-before.C()
+		assert.False(t, open)
+		_, open = <-after.C()
+		assert.False(t, open)
+	})
+
+	t.Run("concurrent publish and unsubscribe", func(t *testing.T) {
+		b := stream.NewBroker(1)
+
+		var wg sync.WaitGroup
+		for range 8 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				s := b.Subscribe(stream.TopicMarket)
+				b.Publish(stream.Message{Topics: []string{stream.TopicMarket}})
+				b.Unsubscribe(s)
+			}()
+		}
+		wg.Wait()
+	})
+}
+
+func Test_ParseTopic(t *testing.T) {
+	for _, topic := range []string{stream.TopicMarket, "team:1", "player:42"} {
+		parsed, err := stream.ParseTopic(topic)
+		assert.NoError(t, err)
+		assert.Equal(t, topic, parsed)
+	}
+
+	for _, topic := range []string{"", "markets", "team", "team:", "team:0", "team:-1", "player:x", "user:1", "league:1"} {
+		_, err := stream.ParseTopic(topic)
+		assert.ErrorIs(t, err, stream.ErrInvalidTopic, topic)
+	}
+}
+
+func Test_Subscription_Topics(t *testing.T) {
+	b := stream.NewBroker(1)
+	s := b.Subscribe(stream.TeamTopic(2), stream.TopicMarket)
+	defer b.Unsubscribe(s)
+
+	s.Follow(stream.TopicMarket, stream.PlayerTopic(3))
+
+	assert.Equal(t, []string{stream.TopicMarket, stream.PlayerTopic(3), stream.TeamTopic(2)}, s.Topics())
+}
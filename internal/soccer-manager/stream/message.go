@@ -0,0 +1,211 @@
+package stream
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
+)
+
+// Event names as seen by stream clients
+const (
+	EventTransferListed       = "transfer.listed"
+	EventTransferPriceChanged = "transfer.price_changed"
+	EventTransferExpired      = "transfer.expired"
+	EventTransferSold         = "transfer.sold"
+	EventAuctionSettled       = "auction.settled"
+	EventMatchPlayed          = "match.played"
+	EventTeamUpdated          = "team.updated"
+	EventNotification         = "notification"
+	EventLagged               = "stream.lagged"
+)
+
+type (
+	TransferPayload struct {
+		ID            int64      `json:"id"`
+		PlayerID      int64      `json:"player_id"`
+		SellerTeamID  int64      `json:"seller_team_id"`
+		Price         int64      `json:"price"`
+		ListedAt      time.Time  `json:"listed_at"`
+		AuctionEndsAt *time.Time `json:"auction_ends_at,omitempty"`
+		ReservePrice  int64      `json:"reserve_price,omitempty"`
+		ExpiresAt     *time.Time `json:"expires_at,omitempty"`
+	} // @name StreamTransferPayload
+
+	SalePayload struct {
+		ID           int64     `json:"id"`
+		PlayerID     int64     `json:"player_id"`
+		SellerTeamID int64     `json:"seller_team_id"`
+		BuyerTeamID  int64     `json:"buyer_team_id"`
+		SoldPrice    int64     `json:"sold_price"`
+		SoldAt       time.Time `json:"sold_at"`
+	} // @name StreamSalePayload
+
+	AuctionPayload struct {
+		TransferID   int64                 `json:"transfer_id"`
+		PlayerID     int64                 `json:"player_id"`
+		SellerTeamID int64                 `json:"seller_team_id"`
+		BuyerTeamID  int64                 `json:"buyer_team_id,omitempty"`
+		SoldPrice    int64                 `json:"sold_price,omitempty"`
+		Outcome      domain.AuctionOutcome `json:"outcome"`
+		SettledAt    time.Time             `json:"settled_at"`
+	} // @name StreamAuctionPayload
+
+	MatchPayload struct {
+		ID         int64     `json:"id"`
+		HomeTeamID int64     `json:"home_team_id"`
+		AwayTeamID int64     `json:"away_team_id"`
+		HomeScore  int32     `json:"home_score"`
+		AwayScore  int32     `json:"away_score"`
+		PlayedAt   time.Time `json:"played_at"`
+	} // @name StreamMatchPayload
+
+	TeamPayload struct {
+		ID          int64              `json:"id"`
+		Name        string             `json:"name"`
+		CountryCode domain.CountryCode `json:"country_code"`
+	} // @name StreamTeamPayload
+
+	NotificationPayload struct {
+		ID        int64                   `json:"id"`
+		Kind      domain.NotificationKind `json:"kind"`
+		Payload   json.RawMessage         `json:"payload"`
+		CreatedAt time.Time               `json:"created_at"`
+	} // @name StreamNotificationPayload
+
+	LaggedPayload struct {
+		Dropped uint64 `json:"dropped"`
+	} // @name StreamLaggedPayload
+)
+
+// TransferListed goes to the market, the seller team and the player
+func TransferListed(t domain.Transfer) Message {
+	return transferMessage(EventTransferListed, t)
+}
+
+// TransferPriceChanged goes to the market, the seller team and the player
+func TransferPriceChanged(t domain.Transfer) Message {
+	return transferMessage(EventTransferPriceChanged, t)
+}
+
+// TransferExpired goes to the market, the seller team and the player
+func TransferExpired(t domain.Transfer) Message {
+	return transferMessage(EventTransferExpired, t)
+}
+
+// TransferSold goes to the market, both teams and the player
+func TransferSold(r domain.TransferRecord) Message {
+	return Message{
+		Topics: []string{
+			TopicMarket,
+			TeamTopic(r.SellerTeamID),
+			TeamTopic(r.BuyerTeamID),
+			PlayerTopic(r.PlayerID),
+		},
+		Event: EventTransferSold,
+		Data: SalePayload{
+			ID:           r.ID,
+			PlayerID:     r.PlayerID,
+			SellerTeamID: r.SellerTeamID,
+			BuyerTeamID:  r.BuyerTeamID,
+			SoldPrice:    r.SoldPrice,
+			SoldAt:       r.SoldAt,
+		},
+	}
+}
+
+// AuctionSettled goes to the market, the seller team, the winner if any and the player
+func AuctionSettled(r domain.AuctionResult) Message {
+	topics := []string{TopicMarket, TeamTopic(r.SellerTeamID), PlayerTopic(r.PlayerID)}
+	if r.BuyerTeamID != 0 {
+		topics = append(topics, TeamTopic(r.BuyerTeamID))
+	}
+
+	return Message{
+		Topics: topics,
+		Event:  EventAuctionSettled,
+		Data: AuctionPayload{
+			TransferID:   r.TransferID,
+			PlayerID:     r.PlayerID,
+			SellerTeamID: r.SellerTeamID,
+			BuyerTeamID:  r.BuyerTeamID,
+			SoldPrice:    r.SoldPrice,
+			Outcome:      r.Outcome,
+			SettledAt:    r.SettledAt,
+		},
+	}
+}
+
+// MatchPlayed goes to both teams, the timeline is left out
+func MatchPlayed(m domain.Match) Message {
+	return Message{
+		Topics: []string{TeamTopic(m.HomeTeamID), TeamTopic(m.AwayTeamID)},
+		Event:  EventMatchPlayed,
+		Data: MatchPayload{
+			ID:         m.ID,
+			HomeTeamID: m.HomeTeamID,
+			AwayTeamID: m.AwayTeamID,
+			HomeScore:  m.HomeScore,
+			AwayScore:  m.AwayScore,
+			PlayedAt:   m.PlayedAt,
+		},
+	}
+}
+
+// TeamUpdated goes to the team
+func TeamUpdated(t domain.Team) Message {
+	return Message{
+		Topics: []string{TeamTopic(t.ID)},
+		Event:  EventTeamUpdated,
+		Data: TeamPayload{
+			ID:          t.ID,
+			Name:        t.Name,
+			CountryCode: t.CountryCode,
+		},
+	}
+}
+
+// Notification goes to the recipient only
+func Notification(n domain.Notification) Message {
+	return Message{
+		Topics: []string{UserTopic(n.UserID)},
+		Event:  EventNotification,
+		Data: NotificationPayload{
+			ID:        n.ID,
+			Kind:      n.Kind,
+			Payload:   n.Payload,
+			CreatedAt: n.CreatedAt,
+		},
+	}
+}
+
+// Lagged tells the client how many messages it missed since the last notice
+func Lagged(dropped uint64) Message {
+	return Message{
+		Event: EventLagged,
+		Data:  LaggedPayload{Dropped: dropped},
+	}
+}
+
+func transferMessage(event string, t domain.Transfer) Message {
+	payload := TransferPayload{
+		ID:           t.ID,
+		PlayerID:     t.PlayerID,
+		SellerTeamID: t.SellerTeamID,
+		Price:        t.Price,
+		ListedAt:     t.ListedAt,
+		ReservePrice: t.ReservePrice,
+	}
+	if !t.AuctionEndsAt.IsZero() {
+		payload.AuctionEndsAt = &t.AuctionEndsAt
+	}
+	if !t.ExpiresAt.IsZero() {
+		payload.ExpiresAt = &t.ExpiresAt
+	}
+
+	return Message{
+		Topics: []string{TopicMarket, TeamTopic(t.SellerTeamID), PlayerTopic(t.PlayerID)},
+		Event:  event,
+		Data:   payload,
+	}
+}
@@ -0,0 +1,15 @@
+package tax
+
+// Policy is the transfer tax taken from seller's proceeds:
+// Percent of the price plus a Flat fee, zero values disable either part
+type Policy struct {
+	Percent float64
+	Flat    int64
+}
+
+// Of calculates the tax for a sale price, it never goes below zero or above the price
+func (p Policy) Of(price int64) int64 {
+	tax := int64(float64(price)*p.Percent/100) + p.Flat
+
+	return max(0, min(tax, price))
+}
@@ -0,0 +1,31 @@
+package tax_test
+
+import (
+	"testing"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/tax"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Of(t *testing.T) {
+	cases := []struct {
+		name   string
+		policy tax.Policy
+		price  int64
+		want   int64
+	}{
+		{"no tax", tax.Policy{}, 1000000, 0},
+		{"percentage", tax.Policy{Percent: 5}, 1000000, 50000},
+		{"flat", tax.Policy{Flat: 10000}, 1000000, 10000},
+		{"percentage and flat", tax.Policy{Percent: 2.5, Flat: 10000}, 1000000, 35000},
+		{"rounds down", tax.Policy{Percent: 5}, 99, 4},
+		{"capped at price", tax.Policy{Percent: 50, Flat: 10000}, 10000, 10000},
+		{"never negative", tax.Policy{Flat: -10000}, 1000, 0},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.want, c.policy.Of(c.price))
+		})
+	}
+}
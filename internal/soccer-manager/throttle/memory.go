@@ -0,0 +1,78 @@
+package throttle
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hexley21/soccer-manager/pkg/cache"
+	"github.com/hexley21/soccer-manager/pkg/cache/mem"
+	"github.com/hexley21/soccer-manager/pkg/cache/ttl"
+)
+
+// memoryTracker keeps failures in the process, every instance counts on its own.
+// The mutex makes every read and update of a key a single step
+type memoryTracker struct {
+	mu     sync.Mutex
+	cache  cache.Cache[string, ttl.ExpirableItem[Record]]
+	window time.Duration
+}
+
+func NewMemoryTracker(window time.Duration) *memoryTracker {
+	return &memoryTracker{
+		cache:  ttl.New(mem.NewInMemoryCache[string, ttl.ExpirableItem[Record]]()),
+		window: window,
+	}
+}
+
+func (t *memoryTracker) Attempt(ctx context.Context, key string, policy Policy) (Record, bool, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now()
+	item, _ := t.cache.Get(key)
+	if policy.RetryAfter(item.Value, now) > 0 {
+		return item.Value, false, nil
+	}
+
+	record := Record{Failures: item.Value.Failures + 1, LastFailedAt: now}
+	t.cache.Put(key, ttl.NewItem(record, t.window))
+
+	return record, true, nil
+}
+
+func (t *memoryTracker) Forgive(ctx context.Context, key string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	item, ok := t.cache.Get(key)
+	if !ok || item.Value.Failures == 0 {
+		return nil
+	}
+
+	// the key is still forgotten a window after its last failure
+	record := Record{Failures: item.Value.Failures - 1, LastFailedAt: item.Value.LastFailedAt}
+	t.cache.Put(key, ttl.NewItem(record, time.Until(record.LastFailedAt.Add(t.window))))
+
+	return nil
+}
+
+func (t *memoryTracker) Reset(ctx context.Context, key string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.cache.Delete(key)
+	return nil
+}
+
+func (t *memoryTracker) Prune(ctx context.Context) (int64, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	before := t.cache.Len()
+	for range t.cache.Scan() {
+		// scanning drops expired records
+	}
+
+	return int64(before - t.cache.Len()), nil
+}
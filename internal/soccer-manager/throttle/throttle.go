@@ -0,0 +1,146 @@
+package throttle
+
+import (
+	"context"
+	"time"
+)
+
+// Trackers to pick from in the config
+const (
+	TrackerMEMORY   = "memory"
+	TrackerPOSTGRES = "postgres"
+)
+
+// Record is the failed logins of a key since its failures were last forgotten
+type Record struct {
+	Failures     int32
+	LastFailedAt time.Time
+}
+
+// Tracker counts failed logins per key, failures are forgotten a window after the last one.
+// Every login is counted as failed up front, so parallel logins can't slip past the policy
+type Tracker interface {
+	// Attempt counts a failure if the policy lets the key try now, checking and counting is atomic.
+	// The record is the counted one, or the current one when the key has to wait
+	Attempt(ctx context.Context, key string, policy Policy) (Record, bool, error)
+	// Forgive takes back one counted failure of a login that didn't fail
+	Forgive(ctx context.Context, key string) error
+	Reset(ctx context.Context, key string) error
+	// Prune drops forgotten keys and returns how many there were
+	Prune(ctx context.Context) (int64, error)
+}
+
+// Policy lets FreeAttempts failures through, every one after that doubles the wait from BaseDelay.
+// MaxDelay caps it, a key at the cap is locked out until its wait passes
+type Policy struct {
+	FreeAttempts int32
+	BaseDelay    time.Duration
+	MaxDelay     time.Duration
+}
+
+// Delay is how long the key waits after its last failure
+func (p Policy) Delay(failures int32) time.Duration {
+	if failures < p.FreeAttempts {
+		return 0
+	}
+
+	delay := p.BaseDelay
+	for i := p.FreeAttempts; i < failures && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, p.MaxDelay)
+}
+
+// RetryAfter is how long the key still has to wait at now, zero when it may try
+func (p Policy) RetryAfter(r Record, now time.Time) time.Duration {
+	return max(0, r.LastFailedAt.Add(p.Delay(r.Failures)).Sub(now))
+}
+
+// Guard throttles logins by the attempted username and by the client IP,
+// the IP policy should be looser as clients behind a NAT share it
+type Guard struct {
+	tracker  Tracker
+	username Policy
+	ip       Policy
+}
+
+func NewGuard(tracker Tracker, username Policy, ip Policy) *Guard {
+	return &Guard{
+		tracker:  tracker,
+		username: username,
+		ip:       ip,
+	}
+}
+
+func usernameKey(username string) string {
+	return "user:" + username
+}
+
+func ipKey(ip string) string {
+	return "ip:" + ip
+}
+
+// Attempt counts the login as failed before it's made and returns how long it has to wait,
+// zero when it may go ahead. Throttled logins aren't counted.
+// A login that goes ahead must end with Succeed or Cancel unless it fails
+func (g *Guard) Attempt(ctx context.Context, username string, ip string) (time.Duration, error) {
+	if ip != "" {
+		record, ok, err := g.tracker.Attempt(ctx, ipKey(ip), g.ip)
+		if err != nil {
+			return 0, err
+		}
+		if !ok {
+			return waitOf(g.ip, record), nil
+		}
+	}
+
+	record, ok, err := g.tracker.Attempt(ctx, usernameKey(username), g.username)
+	if err != nil || !ok {
+		if ip != "" {
+			if err := g.tracker.Forgive(ctx, ipKey(ip)); err != nil {
+				return 0, err
+			}
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		return waitOf(g.username, record), nil
+	}
+
+	return 0, nil
+}
+
+// waitOf is the wait of a throttled key, never zero as the tracker may have decided a moment ago
+func waitOf(p Policy, r Record) time.Duration {
+	return max(p.RetryAfter(r, time.Now()), time.Nanosecond)
+}
+
+// Succeed forgets the failures of the username. The IP keeps its failures,
+// otherwise logging into one own account would clear them while guessing others
+func (g *Guard) Succeed(ctx context.Context, username string, ip string) error {
+	if ip != "" {
+		if err := g.tracker.Forgive(ctx, ipKey(ip)); err != nil {
+			return err
+		}
+	}
+
+	return g.tracker.Reset(ctx, usernameKey(username))
+}
+
+// Cancel takes back a login that neither failed nor succeeded, such as on an internal error
+func (g *Guard) Cancel(ctx context.Context, username string, ip string) error {
+	if ip != "" {
+		if err := g.tracker.Forgive(ctx, ipKey(ip)); err != nil {
+			return err
+		}
+	}
+
+	return g.tracker.Forgive(ctx, usernameKey(username))
+}
+
+// Prune drops forgotten failures of the tracker
+func (g *Guard) Prune(ctx context.Context) (int64, error) {
+	return g.tracker.Prune(ctx)
+}
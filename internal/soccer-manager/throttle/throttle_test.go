@@ -0,0 +1,151 @@
+package throttle_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/throttle"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Policy_Delay(t *testing.T) {
+	policy := throttle.Policy{FreeAttempts: 3, BaseDelay: time.Second, MaxDelay: 10 * time.Second}
+
+	for failures, expected := range map[int32]time.Duration{
+		0:  0,
+		2:  0,
+		3:  time.Second,
+		4:  2 * time.Second,
+		5:  4 * time.Second,
+		6:  8 * time.Second,
+		7:  10 * time.Second,
+		60: 10 * time.Second,
+	} {
+		assert.Equal(t, expected, policy.Delay(failures), "failures: %d", failures)
+	}
+}
+
+func Test_Policy_RetryAfter(t *testing.T) {
+	policy := throttle.Policy{FreeAttempts: 1, BaseDelay: time.Minute, MaxDelay: time.Hour}
+	now := time.Now()
+
+	assert.Zero(t, policy.RetryAfter(throttle.Record{}, now))
+	assert.Equal(t, 40*time.Second, policy.RetryAfter(throttle.Record{Failures: 1, LastFailedAt: now.Add(-20 * time.Second)}, now))
+	assert.Zero(t, policy.RetryAfter(throttle.Record{Failures: 1, LastFailedAt: now.Add(-2 * time.Minute)}, now))
+}
+
+func attempt(t *testing.T, guard *throttle.Guard, username string, ip string) time.Duration {
+	t.Helper()
+
+	wait, err := guard.Attempt(context.Background(), username, ip)
+	require.NoError(t, err)
+	return wait
+}
+
+func Test_Guard(t *testing.T) {
+	ctx := context.Background()
+	username := throttle.Policy{FreeAttempts: 2, BaseDelay: time.Minute, MaxDelay: time.Hour}
+	ip := throttle.Policy{FreeAttempts: 4, BaseDelay: time.Minute, MaxDelay: time.Hour}
+
+	t.Run("throttles username after free attempts", func(t *testing.T) {
+		guard := throttle.NewGuard(throttle.NewMemoryTracker(time.Hour), username, ip)
+
+		assert.Zero(t, attempt(t, guard, "alice", "10.0.0.1"))
+		assert.Zero(t, attempt(t, guard, "alice", "10.0.0.1"))
+
+		wait := attempt(t, guard, "alice", "10.0.0.2")
+		assert.Positive(t, wait)
+		assert.LessOrEqual(t, wait, time.Minute)
+
+		assert.Zero(t, attempt(t, guard, "bob", "10.0.0.2"))
+	})
+
+	t.Run("throttled attempts aren't counted", func(t *testing.T) {
+		guard := throttle.NewGuard(throttle.NewMemoryTracker(time.Hour), username, ip)
+
+		for range 5 {
+			attempt(t, guard, "alice", "10.0.0.1")
+		}
+
+		// the IP counted alice's two attempts only
+		assert.Zero(t, attempt(t, guard, "bob", "10.0.0.1"))
+		assert.Zero(t, attempt(t, guard, "carol", "10.0.0.1"))
+		assert.Positive(t, attempt(t, guard, "dave", "10.0.0.1"))
+	})
+
+	t.Run("throttles ip across usernames", func(t *testing.T) {
+		guard := throttle.NewGuard(throttle.NewMemoryTracker(time.Hour), username, ip)
+
+		for _, name := range []string{"a", "b", "c", "d"} {
+			assert.Zero(t, attempt(t, guard, name, "10.0.0.1"))
+		}
+
+		assert.Positive(t, attempt(t, guard, "e", "10.0.0.1"))
+		assert.Zero(t, attempt(t, guard, "e", "10.0.0.2"))
+	})
+
+	t.Run("success forgets username only", func(t *testing.T) {
+		guard := throttle.NewGuard(throttle.NewMemoryTracker(time.Hour), username, throttle.Policy{FreeAttempts: 2, BaseDelay: time.Minute, MaxDelay: time.Hour})
+
+		assert.Zero(t, attempt(t, guard, "alice", "10.0.0.1"))
+		assert.Zero(t, attempt(t, guard, "alice", "10.0.0.1"))
+		require.NoError(t, guard.Succeed(ctx, "alice", "10.0.0.1"))
+
+		assert.Zero(t, attempt(t, guard, "alice", ""))
+		assert.Zero(t, attempt(t, guard, "alice", ""))
+
+		// the IP kept the first failure
+		assert.Zero(t, attempt(t, guard, "bob", "10.0.0.1"))
+		assert.Positive(t, attempt(t, guard, "carol", "10.0.0.1"))
+	})
+
+	t.Run("cancel takes back the attempt", func(t *testing.T) {
+		guard := throttle.NewGuard(throttle.NewMemoryTracker(time.Hour), username, ip)
+
+		assert.Zero(t, attempt(t, guard, "alice", "10.0.0.1"))
+		require.NoError(t, guard.Cancel(ctx, "alice", "10.0.0.1"))
+
+		assert.Zero(t, attempt(t, guard, "alice", "10.0.0.1"))
+		assert.Zero(t, attempt(t, guard, "alice", "10.0.0.1"))
+		assert.Positive(t, attempt(t, guard, "alice", "10.0.0.1"))
+	})
+
+	t.Run("parallel attempts don't slip past the policy", func(t *testing.T) {
+		guard := throttle.NewGuard(throttle.NewMemoryTracker(time.Hour), username, ip)
+
+		var wg sync.WaitGroup
+		var allowed atomic.Int32
+		for range 50 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if wait, err := guard.Attempt(ctx, "alice", ""); err == nil && wait == 0 {
+					allowed.Add(1)
+				}
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, username.FreeAttempts, allowed.Load())
+	})
+
+	t.Run("failures are forgotten after window", func(t *testing.T) {
+		guard := throttle.NewGuard(throttle.NewMemoryTracker(20*time.Millisecond), username, ip)
+
+		assert.Zero(t, attempt(t, guard, "alice", "10.0.0.1"))
+		assert.Zero(t, attempt(t, guard, "alice", "10.0.0.1"))
+		assert.Positive(t, attempt(t, guard, "alice", "10.0.0.1"))
+		time.Sleep(30 * time.Millisecond)
+
+		assert.Zero(t, attempt(t, guard, "alice", "10.0.0.1"), "a forgotten key starts over")
+
+		time.Sleep(30 * time.Millisecond)
+		pruned, err := guard.Prune(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), pruned)
+	})
+}
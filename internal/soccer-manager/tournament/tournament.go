@@ -0,0 +1,163 @@
+package tournament
+
+import (
+	"slices"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
+)
+
+const (
+	PointsWin  = 3
+	PointsDraw = 1
+
+	// FormLength is the amount of latest results kept in the standing's form
+	FormLength = 5
+)
+
+// bye marks the empty slot when the amount of teams is odd
+const bye int64 = 0
+
+type Pairing struct {
+	Round      int32
+	HomeTeamID int64
+	AwayTeamID int64
+}
+
+// DoubleRoundRobin generates a schedule where every team plays every other team twice,
+// once at home and once away. It uses the circle method, the second half of the season
+// mirrors the first one with home and away swapped.
+//
+// With an odd amount of teams one team rests every round
+func DoubleRoundRobin(teamIDs []int64) []Pairing {
+	if len(teamIDs) < 2 {
+		return nil
+	}
+
+	slots := slices.Clone(teamIDs)
+	if len(slots)%2 == 1 {
+		slots = append(slots, bye)
+	}
+
+	n := len(slots)
+	rounds := n - 1
+	firstHalf := make([]Pairing, 0, rounds*n/2)
+
+	for round := range rounds {
+		for i := range n / 2 {
+			home, away := slots[i], slots[n-1-i]
+			if home == bye || away == bye {
+				continue
+			}
+
+			// the fixed slot would always play at home otherwise
+			if i == 0 && round%2 == 1 {
+				home, away = away, home
+			}
+
+			firstHalf = append(firstHalf, Pairing{
+				Round:      int32(round + 1),
+				HomeTeamID: home,
+				AwayTeamID: away,
+			})
+		}
+
+		// keep the first slot fixed and rotate the rest clockwise
+		last := slots[n-1]
+		copy(slots[2:], slots[1:n-1])
+		slots[1] = last
+	}
+
+	res := make([]Pairing, 0, len(firstHalf)*2)
+	res = append(res, firstHalf...)
+	for _, p := range firstHalf {
+		res = append(res, Pairing{
+			Round:      p.Round + int32(rounds),
+			HomeTeamID: p.AwayTeamID,
+			AwayTeamID: p.HomeTeamID,
+		})
+	}
+
+	return res
+}
+
+// Standings builds the league table from played fixtures.
+// Every enrolled team gets a row even without games,
+// the table is sorted by points, goal difference, goals scored and finally by team id.
+//
+// Form is calculated in the order of fixtures, so they should be passed in chronological order
+func Standings(teamIDs []int64, fixtures []domain.Fixture) []domain.Standing {
+	rows := make(map[int64]*standing, len(teamIDs))
+	for _, id := range teamIDs {
+		rows[id] = &standing{TeamID: id, Form: []domain.FormResult{}}
+	}
+
+	for _, f := range fixtures {
+		if !f.Played() {
+			continue
+		}
+
+		home, ok := rows[f.HomeTeamID]
+		if !ok {
+			continue
+		}
+		away, ok := rows[f.AwayTeamID]
+		if !ok {
+			continue
+		}
+
+		home.record(f.HomeScore, f.AwayScore)
+		away.record(f.AwayScore, f.HomeScore)
+	}
+
+	res := make([]domain.Standing, 0, len(rows))
+	for _, id := range teamIDs {
+		res = append(res, domain.Standing(*rows[id]))
+	}
+
+	slices.SortStableFunc(res, func(a, b domain.Standing) int {
+		switch {
+		case a.Points != b.Points:
+			return int(b.Points - a.Points)
+		case a.GoalDifference != b.GoalDifference:
+			return int(b.GoalDifference - a.GoalDifference)
+		case a.GoalsFor != b.GoalsFor:
+			return int(b.GoalsFor - a.GoalsFor)
+		case a.TeamID < b.TeamID:
+			return -1
+		case a.TeamID > b.TeamID:
+			return 1
+		}
+		return 0
+	})
+
+	return res
+}
+
+type standing domain.Standing
+
+func (s *standing) record(scored int32, conceded int32) {
+	s.Played++
+	s.GoalsFor += scored
+	s.GoalsAgainst += conceded
+	s.GoalDifference = s.GoalsFor - s.GoalsAgainst
+
+	var result domain.FormResult
+	switch {
+	case scored > conceded:
+		s.Won++
+		s.Points += PointsWin
+		result = domain.FormResultWIN
+	case scored == conceded:
+		s.Drawn++
+		s.Points += PointsDraw
+		result = domain.FormResultDRAW
+	default:
+		s.Lost++
+		result = domain.FormResultLOSS
+	}
+
+	s.Form = append(s.Form, result)
+	if len(s.Form) > FormLength {
+		s.Form = s.Form[len(s.Form)-FormLength:]
+	}
+}
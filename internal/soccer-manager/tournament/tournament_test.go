@@ -0,0 +1,111 @@
+package tournament_test
+
+import (
+	"testing"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/domain"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/tournament"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_DoubleRoundRobin(t *testing.T) {
+	for _, size := range []int{2, 3, 4, 5, 8, 11} {
+		teams := make([]int64, size)
+		for i := range teams {
+			teams[i] = int64(i + 1)
+		}
+
+		pairings := tournament.DoubleRoundRobin(teams)
+
+		// every ordered pair meets exactly once
+		assert.Len(t, pairings, size*(size-1))
+		seen := map[[2]int64]int{}
+		for _, p := range pairings {
+			assert.NotEqual(t, p.HomeTeamID, p.AwayTeamID)
+			seen[[2]int64{p.HomeTeamID, p.AwayTeamID}]++
+		}
+		for _, home := range teams {
+			for _, away := range teams {
+				if home != away {
+					assert.Equal(t, 1, seen[[2]int64{home, away}], "%d vs %d", home, away)
+				}
+			}
+		}
+
+		// nobody plays twice in a round
+		rounds := map[int32]map[int64]bool{}
+		var maxRound int32
+		for _, p := range pairings {
+			if rounds[p.Round] == nil {
+				rounds[p.Round] = map[int64]bool{}
+			}
+			assert.False(t, rounds[p.Round][p.HomeTeamID])
+			assert.False(t, rounds[p.Round][p.AwayTeamID])
+			rounds[p.Round][p.HomeTeamID] = true
+			rounds[p.Round][p.AwayTeamID] = true
+			maxRound = max(maxRound, p.Round)
+		}
+
+		expectedRounds := int32(size - 1)
+		if size%2 == 1 {
+			expectedRounds = int32(size)
+		}
+		assert.Equal(t, expectedRounds*2, maxRound)
+	}
+
+	t.Run("not enough teams", func(t *testing.T) {
+		assert.Empty(t, tournament.DoubleRoundRobin(nil))
+		assert.Empty(t, tournament.DoubleRoundRobin([]int64{1}))
+	})
+}
+
+func Test_Standings(t *testing.T) {
+	fixture := func(home, away int64, homeScore, awayScore int32) domain.Fixture {
+		return domain.Fixture{
+			HomeTeamID: home,
+			AwayTeamID: away,
+			MatchID:    home*10 + away,
+			HomeScore:  homeScore,
+			AwayScore:  awayScore,
+		}
+	}
+
+	t.Run("OK", func(t *testing.T) {
+		table := tournament.Standings([]int64{1, 2, 3, 4}, []domain.Fixture{
+			fixture(1, 2, 2, 0),
+			fixture(3, 4, 1, 1),
+			fixture(2, 3, 3, 1),
+			fixture(4, 1, 0, 0),
+			{HomeTeamID: 1, AwayTeamID: 3}, // not played yet
+		})
+
+		assert.Equal(t, []domain.Standing{
+			{TeamID: 1, Played: 2, Won: 1, Drawn: 1, GoalsFor: 2, GoalDifference: 2, Points: 4, Form: []domain.FormResult{"W", "D"}},
+			{TeamID: 2, Played: 2, Won: 1, Lost: 1, GoalsFor: 3, GoalsAgainst: 3, Points: 3, Form: []domain.FormResult{"L", "W"}},
+			{TeamID: 4, Played: 2, Drawn: 2, GoalsFor: 1, GoalsAgainst: 1, Points: 2, Form: []domain.FormResult{"D", "D"}},
+			{TeamID: 3, Played: 2, Drawn: 1, Lost: 1, GoalsFor: 2, GoalsAgainst: 4, GoalDifference: -2, Points: 1, Form: []domain.FormResult{"D", "L"}},
+		}, table)
+	})
+
+	t.Run("form keeps latest results", func(t *testing.T) {
+		var fixtures []domain.Fixture
+		for i := range tournament.FormLength + 2 {
+			fixtures = append(fixtures, fixture(1, 2, int32((i+1)%2), 0))
+		}
+
+		table := tournament.Standings([]int64{1, 2}, fixtures)
+
+		assert.Equal(t, int64(1), table[0].TeamID)
+		assert.Len(t, table[0].Form, tournament.FormLength)
+		assert.Equal(t, domain.FormResultWIN, table[0].Form[tournament.FormLength-1])
+	})
+
+	t.Run("teams without games", func(t *testing.T) {
+		table := tournament.Standings([]int64{2, 1}, nil)
+
+		assert.Equal(t, []domain.Standing{
+			{TeamID: 1, Form: []domain.FormResult{}},
+			{TeamID: 2, Form: []domain.FormResult{}},
+		}, table)
+	})
+}
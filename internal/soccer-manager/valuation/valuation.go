@@ -0,0 +1,143 @@
+package valuation
+
+import (
+	"errors"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/rating"
+)
+
+const (
+	StrategyRANDOM = "random"
+	StrategyRATING = "rating"
+	StrategyDEMAND = "demand"
+)
+
+var ErrUnknownStrategy = errors.New("unknown valuation strategy")
+
+// Sale describes a completed sale the player's new price is derived from
+type Sale struct {
+	// Price is player's price before the sale
+	Price        int64
+	SoldPrice    int64
+	PositionCode string
+	Overall      int32
+	Age          int32
+
+	// PositionSales and MarketSales count recent sales of player's position and of all positions,
+	// they are only filled for DemandAware valuators
+	PositionSales int64
+	MarketSales   int64
+}
+
+// PriceValuator decides player's price after a sale
+type PriceValuator interface {
+	Value(sale Sale) int64
+}
+
+// DemandAware valuators need recent market activity, sales within DemandWindow are counted
+type DemandAware interface {
+	DemandWindow() time.Duration
+}
+
+// New creates a valuator by its strategy name, basePrice is the price of an average rated player
+func New(strategy string, basePrice int64, demandWindow time.Duration) (PriceValuator, error) {
+	switch strategy {
+	case StrategyRANDOM, "":
+		return NewRandom(rand.New(rand.NewSource(time.Now().UnixNano()))), nil
+	case StrategyRATING:
+		return NewRating(basePrice), nil
+	case StrategyDEMAND:
+		return NewDemand(demandWindow), nil
+	}
+
+	return nil, ErrUnknownStrategy
+}
+
+// Random raises the price by a random 10-100%, higher rated players may rise more
+type Random struct {
+	mu  sync.Mutex
+	rnd *rand.Rand
+}
+
+// NewRandom creates a random valuator, pass a seeded rnd for reproducible prices
+func NewRandom(rnd *rand.Rand) *Random {
+	return &Random{rnd: rnd}
+}
+
+func (v *Random) Value(sale Sale) int64 {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	return int64(float64(sale.Price) * rating.PriceRise(v.rnd, sale.Overall))
+}
+
+const (
+	// peak ages are valued in full, every year outside of them costs ageDecline of the value
+	peakAgeFrom = 24
+	peakAgeTo   = 29
+	ageDecline  = 0.05
+	minAgeRatio = 0.3
+)
+
+// Rating values the player by overall rating and age, averaged with what the buyer paid
+type Rating struct {
+	basePrice int64
+}
+
+func NewRating(basePrice int64) *Rating {
+	return &Rating{basePrice: basePrice}
+}
+
+func (v *Rating) Value(sale Sale) int64 {
+	value := float64(rating.Price(v.basePrice, sale.Overall)) * ageRatio(sale.Age)
+
+	return (int64(value) + sale.SoldPrice) / 2
+}
+
+func ageRatio(age int32) float64 {
+	var years int32
+	switch {
+	case age < peakAgeFrom:
+		years = peakAgeFrom - age
+	case age > peakAgeTo:
+		years = age - peakAgeTo
+	}
+
+	return max(minAgeRatio, 1-float64(years)*ageDecline)
+}
+
+const (
+	// positions sharing the market, an even demand gives every position 1/positions of the sales
+	positions = 4
+
+	minDemandRatio = 0.8
+	maxDemandRatio = 1.5
+)
+
+// Demand values the player at the sold price scaled by the demand for player's position,
+// a position sold as often as the average keeps its sold price, a cold one falls up to 20% and a hot one rises up to 50%
+type Demand struct {
+	window time.Duration
+}
+
+func NewDemand(window time.Duration) *Demand {
+	return &Demand{window: window}
+}
+
+func (v *Demand) DemandWindow() time.Duration {
+	return v.window
+}
+
+func (v *Demand) Value(sale Sale) int64 {
+	demand := 1.0
+	if sale.MarketSales > 0 {
+		demand = float64(sale.PositionSales*positions) / float64(sale.MarketSales)
+	}
+
+	ratio := min(maxDemandRatio, max(minDemandRatio, 0.8+0.2*demand))
+
+	return int64(float64(sale.SoldPrice) * ratio)
+}
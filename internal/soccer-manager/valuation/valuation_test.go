@@ -0,0 +1,97 @@
+package valuation_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/rating"
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/valuation"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_New(t *testing.T) {
+	for _, strategy := range []string{"", valuation.StrategyRANDOM, valuation.StrategyRATING, valuation.StrategyDEMAND} {
+		v, err := valuation.New(strategy, 1000, time.Hour)
+		assert.NoError(t, err, strategy)
+		assert.NotNil(t, v, strategy)
+	}
+
+	_, err := valuation.New("unknown", 1000, time.Hour)
+	assert.ErrorIs(t, err, valuation.ErrUnknownStrategy)
+
+	v, _ := valuation.New(valuation.StrategyDEMAND, 1000, time.Hour)
+	aware, ok := v.(valuation.DemandAware)
+	assert.True(t, ok)
+	assert.Equal(t, time.Hour, aware.DemandWindow())
+}
+
+func Test_Random(t *testing.T) {
+	sale := valuation.Sale{Price: 1000, SoldPrice: 1500, Overall: 75}
+
+	t.Run("seeded", func(t *testing.T) {
+		a := valuation.NewRandom(rand.New(rand.NewSource(1)))
+		b := valuation.NewRandom(rand.New(rand.NewSource(1)))
+
+		for range 10 {
+			assert.Equal(t, a.Value(sale), b.Value(sale))
+		}
+	})
+
+	t.Run("rises 10-100%", func(t *testing.T) {
+		v := valuation.NewRandom(rand.New(rand.NewSource(1)))
+
+		for range 100 {
+			price := v.Value(sale)
+			assert.GreaterOrEqual(t, price, int64(1100))
+			assert.LessOrEqual(t, price, int64(2000))
+		}
+	})
+}
+
+func Test_Rating(t *testing.T) {
+	v := valuation.NewRating(1000)
+
+	t.Run("peak age", func(t *testing.T) {
+		sale := valuation.Sale{SoldPrice: 1000, Overall: rating.AverageOverall, Age: 27}
+
+		assert.Equal(t, int64(1000), v.Value(sale))
+	})
+
+	t.Run("young and old are valued less", func(t *testing.T) {
+		peak := v.Value(valuation.Sale{Overall: 80, Age: 26})
+
+		assert.Less(t, v.Value(valuation.Sale{Overall: 80, Age: 19}), peak)
+		assert.Less(t, v.Value(valuation.Sale{Overall: 80, Age: 34}), peak)
+	})
+
+	t.Run("age floor", func(t *testing.T) {
+		assert.Equal(t, int64(150), v.Value(valuation.Sale{Overall: rating.AverageOverall, Age: 60}))
+	})
+}
+
+func Test_Demand(t *testing.T) {
+	v := valuation.NewDemand(time.Hour)
+
+	cases := []struct {
+		name          string
+		positionSales int64
+		marketSales   int64
+		want          int64
+	}{
+		{"no market", 0, 0, 1000},
+		{"average demand", 5, 20, 1000},
+		{"cold position", 0, 20, 800},
+		{"hot position", 20, 20, 1500},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.want, v.Value(valuation.Sale{
+				SoldPrice:     1000,
+				PositionSales: c.positionSales,
+				MarketSales:   c.marketSales,
+			}))
+		})
+	}
+}
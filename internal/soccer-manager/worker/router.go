@@ -0,0 +1,79 @@
+package worker
+
+import (
+	"context"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/delivery"
+)
+
+func RegisterWorkers(c *delivery.Components) ([]*Worker, error) {
+	settleAuctions, err := New(
+		"auction settlement",
+		c.Cfg.Auctions.SettleInterval,
+		c.Cfg.Auctions.SettleTimeout,
+		func(ctx context.Context) error {
+			settled, err := c.Services.AuctionService.SettleExpiredAuctions(ctx)
+			if len(settled) > 0 {
+				c.Logger.Debugf("settled %d auctions", len(settled))
+			}
+			return err
+		},
+		c.Logger,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	expireTransfers, err := New(
+		"transfer expiry",
+		c.Cfg.Transfers.SweepInterval,
+		c.Cfg.Transfers.SweepTimeout,
+		func(ctx context.Context) error {
+			expired, err := c.Services.TransferService.ExpireTransfers(ctx)
+			if len(expired) > 0 {
+				c.Logger.Debugf("expired %d transfers", len(expired))
+			}
+			return err
+		},
+		c.Logger,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	dispatchOutbox, err := New(
+		"outbox dispatch",
+		c.Cfg.Events.Outbox.Interval,
+		c.Cfg.Events.Outbox.Timeout,
+		func(ctx context.Context) error {
+			completed, err := c.Outbox.Dispatch(ctx)
+			if completed > 0 {
+				c.Logger.Debugf("dispatched %d outbox events", completed)
+			}
+			return err
+		},
+		c.Logger,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	pruneLoginFailures, err := New(
+		"login failure prune",
+		c.Cfg.Login.PruneInterval,
+		c.Cfg.Login.PruneTimeout,
+		func(ctx context.Context) error {
+			pruned, err := c.LoginGuard.Prune(ctx)
+			if pruned > 0 {
+				c.Logger.Debugf("pruned %d login failures", pruned)
+			}
+			return err
+		},
+		c.Logger,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*Worker{settleAuctions, expireTransfers, dispatchOutbox, pruneLoginFailures}, nil
+}
@@ -0,0 +1,99 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Job is a single run of a periodic task, errors are logged and the next run goes on
+type Job func(ctx context.Context) error
+
+// Worker runs a job on a fixed interval until stopped
+type Worker struct {
+	name     string
+	interval time.Duration
+	timeout  time.Duration
+	job      Job
+	logger   echo.Logger
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// New creates a worker, timeout bounds a single run, zero means the interval.
+// The interval must be positive, a missing one in the config is caught here rather than by a panic at Start
+func New(name string, interval time.Duration, timeout time.Duration, job Job, logger echo.Logger) (*Worker, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("%s worker: interval must be positive, got %s", name, interval)
+	}
+	if timeout <= 0 {
+		timeout = interval
+	}
+
+	return &Worker{
+		name:     name,
+		interval: interval,
+		timeout:  timeout,
+		job:      job,
+		logger:   logger,
+	}, nil
+}
+
+// Start launches the worker in the background
+func (w *Worker) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	w.cancel = cancel
+	w.done = make(chan struct{})
+
+	go w.loop(ctx)
+}
+
+// Stop cancels the running job and waits for the worker to exit or ctx to be done
+func (w *Worker) Stop(ctx context.Context) error {
+	if w.cancel == nil {
+		return nil
+	}
+	w.cancel()
+
+	select {
+	case <-w.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (w *Worker) loop(ctx context.Context) {
+	defer close(w.done)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.run(ctx)
+		}
+	}
+}
+
+func (w *Worker) run(ctx context.Context) {
+	defer func() {
+		if r := recover(); r != nil {
+			w.logger.Errorf("panic recovered in %s worker: %v", w.name, r)
+		}
+	}()
+
+	runCtx, cancel := context.WithTimeout(ctx, w.timeout)
+	defer cancel()
+
+	// errors caused by stopping the worker are expected
+	if err := w.job(runCtx); err != nil && ctx.Err() == nil {
+		w.logger.Errorf("%s worker: %v", w.name, err)
+	}
+}
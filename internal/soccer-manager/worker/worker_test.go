@@ -0,0 +1,64 @@
+package worker_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hexley21/soccer-manager/internal/soccer-manager/worker"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Worker(t *testing.T) {
+	t.Run("runs until stopped", func(t *testing.T) {
+		var runs atomic.Int32
+		w, err := worker.New("test", 5*time.Millisecond, 0, func(ctx context.Context) error {
+			runs.Add(1)
+			return errors.New("keeps going")
+		}, echo.New().Logger)
+		require.NoError(t, err)
+
+		w.Start()
+		assert.Eventually(t, func() bool { return runs.Load() >= 3 }, time.Second, time.Millisecond)
+		assert.NoError(t, w.Stop(context.Background()))
+
+		stopped := runs.Load()
+		time.Sleep(20 * time.Millisecond)
+		assert.Equal(t, stopped, runs.Load())
+	})
+
+	t.Run("stop cancels running job", func(t *testing.T) {
+		started := make(chan struct{}, 1)
+		w, err := worker.New("test", time.Millisecond, time.Hour, func(ctx context.Context) error {
+			select {
+			case started <- struct{}{}:
+			default:
+			}
+			<-ctx.Done()
+			return ctx.Err()
+		}, echo.New().Logger)
+		require.NoError(t, err)
+
+		w.Start()
+		<-started
+		assert.NoError(t, w.Stop(context.Background()))
+	})
+
+	t.Run("stop without start", func(t *testing.T) {
+		w, err := worker.New("test", time.Second, 0, func(ctx context.Context) error { return nil }, echo.New().Logger)
+		require.NoError(t, err)
+
+		assert.NoError(t, w.Stop(context.Background()))
+	})
+
+	t.Run("rejects non-positive interval", func(t *testing.T) {
+		for _, interval := range []time.Duration{0, -time.Second} {
+			_, err := worker.New("test", interval, 0, func(ctx context.Context) error { return nil }, echo.New().Logger)
+			assert.Error(t, err)
+		}
+	})
+}
@@ -60,10 +60,18 @@ func (c *ttlCache[K, V]) All() iter.Seq[ExpirableItem[V]] {
 
 func (c *ttlCache[K, V]) Scan() iter.Seq2[K, ExpirableItem[V]] {
 	return func(yield func(K, ExpirableItem[V]) bool) {
+		// the inner cache may hold a lock while scanning, expired keys are deleted afterwards
+		var expired []K
+		defer func() {
+			for _, k := range expired {
+				c.inner.Delete(k)
+			}
+		}()
+
 		now := time.Now()
 		for k, item := range c.inner.Scan() {
 			if now.After(item.expiration) {
-				c.inner.Delete(k)
+				expired = append(expired, k)
 				continue
 			}
 			if !yield(k, item) {
@@ -0,0 +1,63 @@
+package ttl_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hexley21/soccer-manager/pkg/cache"
+	"github.com/hexley21/soccer-manager/pkg/cache/mem"
+	"github.com/hexley21/soccer-manager/pkg/cache/ttl"
+	"github.com/stretchr/testify/assert"
+)
+
+func newCache() cache.Cache[string, ttl.ExpirableItem[int]] {
+	c := ttl.New(mem.NewInMemoryCache[string, ttl.ExpirableItem[int]]())
+	c.Put("expired", ttl.NewItem(0, -time.Second))
+	c.Put("a", ttl.NewItem(1, time.Hour))
+	c.Put("b", ttl.NewItem(2, time.Hour))
+	return c
+}
+
+// scan collects up to limit items, failing if the scan blocks on the inner cache
+func scan(t *testing.T, c cache.Cache[string, ttl.ExpirableItem[int]], limit int) map[string]int {
+	t.Helper()
+
+	done := make(chan map[string]int)
+	go func() {
+		scanned := make(map[string]int)
+		for k, item := range c.Scan() {
+			scanned[k] = item.Value
+			if len(scanned) == limit {
+				break
+			}
+		}
+		done <- scanned
+	}()
+
+	select {
+	case scanned := <-done:
+		return scanned
+	case <-time.After(time.Second):
+		t.Fatal("scan blocked")
+		return nil
+	}
+}
+
+func Test_Scan(t *testing.T) {
+	t.Run("skips and deletes expired", func(t *testing.T) {
+		c := newCache()
+
+		assert.Equal(t, map[string]int{"a": 1, "b": 2}, scan(t, c, -1))
+		assert.Equal(t, 2, c.Len())
+	})
+
+	t.Run("stops early", func(t *testing.T) {
+		c := newCache()
+
+		assert.Len(t, scan(t, c, 1), 1)
+		for _, k := range []string{"a", "b"} {
+			_, ok := c.Get(k)
+			assert.True(t, ok)
+		}
+	})
+}
@@ -23,6 +23,15 @@ type (
 		Argon2     Argon2     `yaml:"argon2"`
 		Logging    Logging    `yaml:"logging"`
 		Events     Events     `yaml:"events"`
+		Match      Match      `yaml:"match"`
+		Auctions   Auctions   `yaml:"auctions"`
+		Transfers  Transfers  `yaml:"transfers"`
+		Market     Market     `yaml:"market"`
+		Stream     Stream     `yaml:"stream"`
+		Login      Login      `yaml:"login"`
+
+		// BootstrapAdmin is the username promoted to admin on startup while no admin exists
+		BootstrapAdmin string
 	}
 
 	Server struct {
@@ -31,12 +40,15 @@ type (
 		MaxFileSize     int64         `yaml:"max_file_size"`
 	}
 
+	// HTTP takes the client address from X-Real-IP only on requests of TrustedProxies (CIDRs),
+	// empty trusts loopback and private networks
 	HTTP struct {
-		Port         int           `yaml:"port"`
-		CorsOrigins  string        `yaml:"cors_origins"`
-		IdleTimeout  time.Duration `yaml:"idle_timeout"`
-		ReadTimeout  time.Duration `yaml:"read_timeout"`
-		WriteTimeout time.Duration `yaml:"write_timeout"`
+		Port           int           `yaml:"port"`
+		CorsOrigins    string        `yaml:"cors_origins"`
+		IdleTimeout    time.Duration `yaml:"idle_timeout"`
+		ReadTimeout    time.Duration `yaml:"read_timeout"`
+		WriteTimeout   time.Duration `yaml:"write_timeout"`
+		TrustedProxies []string      `yaml:"trusted_proxies"`
 	}
 
 	Globe struct {
@@ -44,18 +56,112 @@ type (
 	}
 
 	Events struct {
-		UserSignUp UserSignUp `yaml:"user_signup"`
+		UserSignUp    UserSignUp    `yaml:"user_signup"`
+		Watchlist     Watchlist     `yaml:"watchlist"`
+		Notifications Notifications `yaml:"notifications"`
+		Outbox        Outbox        `yaml:"outbox"`
 	}
+
+	// UserSignUp configures team provisioning of new users, it's retried by the outbox and given up
+	// after MaxAttempts, keep it below Outbox.MaxAttempts. Pending provisioning counts as stuck after StuckAfter
 	UserSignUp struct {
 		TeamBudgetFloat    float64 `yaml:"team_budget"`
 		TeamBudgetParsed   int64
 		PlayerBudgetFloat  float64 `yaml:"player_budget"`
 		PlayerBudgetParsed int64
-		GoroutineCount     int           `yaml:"goroutine_count"`
 		PlayerMinAge       int           `yaml:"player_min_age"`
 		PlayerMaxAge       int           `yaml:"player_max_age"`
 		TeamMembers        TeamMembers   `yaml:"members"`
 		Timeout            time.Duration `yaml:"timeout"`
+		MaxAttempts        int32         `yaml:"max_attempts"`
+		StuckAfter         time.Duration `yaml:"stuck_after"`
+	}
+
+	Watchlist struct {
+		Timeout time.Duration `yaml:"timeout"`
+	}
+
+	Notifications struct {
+		Timeout time.Duration `yaml:"timeout"`
+	}
+
+	// Outbox configures delivery of outbox events, a batch of BatchSize events is claimed every Interval.
+	// Lease must outlast Timeout or a slow batch is claimed twice, failed events are retried after
+	// RetryBase doubling up to RetryMax and dead-lettered after MaxAttempts
+	Outbox struct {
+		Interval    time.Duration `yaml:"interval"`
+		Timeout     time.Duration `yaml:"timeout"`
+		Lease       time.Duration `yaml:"lease"`
+		BatchSize   int32         `yaml:"batch_size"`
+		MaxAttempts int32         `yaml:"max_attempts"`
+		RetryBase   time.Duration `yaml:"retry_base"`
+		RetryMax    time.Duration `yaml:"retry_max"`
+	}
+
+	Match struct {
+		RosterLimit int32 `yaml:"roster_limit"`
+	}
+
+	Auctions struct {
+		SettleInterval time.Duration `yaml:"settle_interval"`
+		SettleTimeout  time.Duration `yaml:"settle_timeout"`
+		BatchSize      int32         `yaml:"batch_size"`
+	}
+
+	// Transfers configures fixed price listings, zero ListingTTL keeps listings until sold or delisted.
+	// Every sale is taxed by TaxPercent of the price plus TaxFlat, withheld from the seller
+	Transfers struct {
+		ListingTTL    time.Duration `yaml:"listing_ttl"`
+		SweepInterval time.Duration `yaml:"sweep_interval"`
+		SweepTimeout  time.Duration `yaml:"sweep_timeout"`
+		BatchSize     int32         `yaml:"batch_size"`
+		TaxPercent    float64       `yaml:"tax_percent"`
+		TaxFlat       int64         `yaml:"tax_flat"`
+		Valuation     Valuation     `yaml:"valuation"`
+	}
+
+	// Valuation picks how players are repriced after a sale: random, rating or demand.
+	// The demand strategy weighs sales within DemandWindow
+	Valuation struct {
+		Strategy     string        `yaml:"strategy"`
+		DemandWindow time.Duration `yaml:"demand_window"`
+	}
+
+	// Market configures analytics over transfer records, stats are cached for StatsTTL
+	Market struct {
+		StatsTTL      time.Duration `yaml:"stats_ttl"`
+		StatsTopLimit int32         `yaml:"stats_top_limit"`
+		StatsMaxDays  int32         `yaml:"stats_max_days"`
+	}
+
+	// Stream configures live events, a client lagging Buffer messages behind starts losing them.
+	// Timeout bounds lookups made while relaying an event, a socket client that doesn't
+	// read for WriteTimeout is disconnected
+	Stream struct {
+		Buffer       int           `yaml:"buffer"`
+		Heartbeat    time.Duration `yaml:"heartbeat"`
+		Timeout      time.Duration `yaml:"timeout"`
+		WriteTimeout time.Duration `yaml:"write_timeout"`
+		MaxTopics    int           `yaml:"max_topics"`
+	}
+
+	// Login configures throttling of failed logins, kept in memory or in postgres by Tracker.
+	// Failures of a key are forgotten Window after the last one and pruned every PruneInterval
+	Login struct {
+		Tracker       string        `yaml:"tracker"`
+		Window        time.Duration `yaml:"window"`
+		PruneInterval time.Duration `yaml:"prune_interval"`
+		PruneTimeout  time.Duration `yaml:"prune_timeout"`
+		Username      LoginPolicy   `yaml:"username"`
+		IP            LoginPolicy   `yaml:"ip"`
+	}
+
+	// LoginPolicy lets FreeAttempts failures through, every one after that doubles the wait
+	// from BaseDelay up to MaxDelay
+	LoginPolicy struct {
+		FreeAttempts int32         `yaml:"free_attempts"`
+		BaseDelay    time.Duration `yaml:"base_delay"`
+		MaxDelay     time.Duration `yaml:"max_delay"`
 	}
 
 	TeamMembers struct {
@@ -98,9 +204,19 @@ type (
 		Refresh TokenParams `yaml:"refresh"`
 	}
 
+	// TokenParams signs with the key of SigningKey out of Keys, every key verifies.
+	// Without Keys tokens are signed with HS256 and Secret, meant for local development
 	TokenParams struct {
-		Secret string
-		TTL    time.Duration `yaml:"ttl"`
+		Secret     string
+		TTL        time.Duration `yaml:"ttl"`
+		SigningKey string        `yaml:"signing_key"`
+		Keys       []KeyFile     `yaml:"keys"`
+	}
+
+	// KeyFile is a PEM encoded RSA or Ed25519 key, a public key only verifies
+	KeyFile struct {
+		ID   string `yaml:"id"`
+		Path string `yaml:"path"`
 	}
 
 	Pagination struct {
@@ -180,8 +296,10 @@ func (cfg *Config) parseEnv(envPath string) error {
 		return err
 	}
 
+	cfg.BootstrapAdmin = os.Getenv("BOOTSTRAP_ADMIN")
+
 	cfg.JWT.Access.Secret = os.Getenv("JWT_ACCESS_SECRET")
-	cfg.JWT.Access.Secret = os.Getenv("JWT_REFRESH_SECRET")
+	cfg.JWT.Refresh.Secret = os.Getenv("JWT_REFRESH_SECRET")
 
 	cfg.Postgres.User = os.Getenv("POSTGRES_USER")
 	cfg.Postgres.Password = os.Getenv("POSTGRES_PASSWORD")
@@ -0,0 +1,25 @@
+CREATE TABLE matches (
+  id            BIGINT PRIMARY KEY NOT NULL,
+  home_team_id  BIGINT NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+  away_team_id  BIGINT NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+  seed          BIGINT NOT NULL,
+  home_score    INT NOT NULL CHECK (home_score >= 0),
+  away_score    INT NOT NULL CHECK (away_score >= 0),
+  played_at     TIMESTAMPTZ NOT NULL DEFAULT now(),
+  CHECK (home_team_id <> away_team_id)
+);
+
+CREATE INDEX matches_home_team_id_idx ON matches (home_team_id);
+CREATE INDEX matches_away_team_id_idx ON matches (away_team_id);
+
+CREATE TABLE match_events (
+  id                BIGINT PRIMARY KEY NOT NULL,
+  match_id          BIGINT NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+  minute            INT NOT NULL CHECK (minute >= 0 AND minute <= 120),
+  event_type        VARCHAR NOT NULL CHECK (event_type IN ('GOAL', 'YELLOW_CARD', 'RED_CARD', 'SUBSTITUTION')),
+  team_id           BIGINT NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+  player_id         BIGINT REFERENCES players(id) ON DELETE SET NULL,
+  related_player_id BIGINT REFERENCES players(id) ON DELETE SET NULL
+);
+
+CREATE INDEX match_events_match_id_idx ON match_events (match_id);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS fixtures;
+DROP TABLE IF EXISTS league_teams;
+DROP TABLE IF EXISTS leagues;
@@ -0,0 +1,24 @@
+CREATE TABLE leagues (
+  id          BIGINT PRIMARY KEY NOT NULL,
+  name        VARCHAR(63) NOT NULL,
+  status      VARCHAR NOT NULL DEFAULT 'ENROLLING' CHECK(status IN ('ENROLLING', 'STARTED')),
+  created_at  TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE league_teams (
+  league_id  BIGINT NOT NULL REFERENCES leagues(id) ON DELETE CASCADE,
+  team_id    BIGINT NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+  PRIMARY KEY (league_id, team_id)
+);
+
+CREATE TABLE fixtures (
+  id            BIGINT PRIMARY KEY NOT NULL,
+  league_id     BIGINT NOT NULL REFERENCES leagues(id) ON DELETE CASCADE,
+  round         INT NOT NULL CHECK (round >= 1),
+  home_team_id  BIGINT NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+  away_team_id  BIGINT NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+  match_id      BIGINT UNIQUE REFERENCES matches(id) ON DELETE SET NULL,
+  UNIQUE(league_id, home_team_id, away_team_id)
+);
+
+CREATE INDEX fixtures_league_id_idx ON fixtures (league_id);
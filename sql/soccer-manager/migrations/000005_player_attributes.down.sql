@@ -0,0 +1,7 @@
+ALTER TABLE players
+  DROP COLUMN pace,
+  DROP COLUMN shooting,
+  DROP COLUMN passing,
+  DROP COLUMN defending,
+  DROP COLUMN goalkeeping,
+  DROP COLUMN stamina;
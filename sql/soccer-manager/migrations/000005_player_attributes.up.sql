@@ -0,0 +1,7 @@
+ALTER TABLE players
+  ADD COLUMN pace        INT NOT NULL DEFAULT 50 CHECK (pace >= 1 AND pace <= 99),
+  ADD COLUMN shooting    INT NOT NULL DEFAULT 50 CHECK (shooting >= 1 AND shooting <= 99),
+  ADD COLUMN passing     INT NOT NULL DEFAULT 50 CHECK (passing >= 1 AND passing <= 99),
+  ADD COLUMN defending   INT NOT NULL DEFAULT 50 CHECK (defending >= 1 AND defending <= 99),
+  ADD COLUMN goalkeeping INT NOT NULL DEFAULT 50 CHECK (goalkeeping >= 1 AND goalkeeping <= 99),
+  ADD COLUMN stamina     INT NOT NULL DEFAULT 50 CHECK (stamina >= 1 AND stamina <= 99);
@@ -0,0 +1,18 @@
+CREATE TABLE bids (
+  id              BIGINT PRIMARY KEY NOT NULL,
+  transfer_id     BIGINT REFERENCES transfers(id) ON DELETE SET NULL,
+  player_id       BIGINT NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+  seller_team_id  BIGINT NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+  bidder_team_id  BIGINT NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+  amount          BIGINT NOT NULL CHECK (amount > 0),
+  counter_amount  BIGINT CHECK (counter_amount > 0),
+  status          VARCHAR NOT NULL DEFAULT 'PENDING' CHECK(status IN ('PENDING', 'COUNTERED', 'ACCEPTED', 'REJECTED', 'WITHDRAWN', 'CANCELLED')),
+  created_at      TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at      TIMESTAMPTZ NOT NULL DEFAULT now(),
+  CHECK (seller_team_id <> bidder_team_id)
+);
+
+-- a team can only have a single open bid per transfer
+CREATE UNIQUE INDEX bids_open_transfer_bidder_idx ON bids (transfer_id, bidder_team_id) WHERE status IN ('PENDING', 'COUNTERED');
+CREATE INDEX bids_transfer_id_idx ON bids (transfer_id);
+CREATE INDEX bids_bidder_team_id_idx ON bids (bidder_team_id);
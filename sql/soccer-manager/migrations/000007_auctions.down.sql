@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS auction_results;
+
+ALTER TABLE transfers
+  DROP COLUMN auction_ends_at,
+  DROP COLUMN reserve_price;
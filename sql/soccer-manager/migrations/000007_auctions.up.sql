@@ -0,0 +1,22 @@
+ALTER TABLE transfers
+  ADD COLUMN auction_ends_at TIMESTAMPTZ,
+  ADD COLUMN reserve_price   BIGINT CHECK (reserve_price >= 0),
+  ADD CHECK ((auction_ends_at IS NULL) = (reserve_price IS NULL));
+
+CREATE INDEX transfers_auction_ends_at_idx ON transfers (auction_ends_at) WHERE auction_ends_at IS NOT NULL;
+
+CREATE TABLE auction_results (
+  id              BIGINT PRIMARY KEY NOT NULL,
+  transfer_id     BIGINT NOT NULL,
+  player_id       BIGINT NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+  seller_team_id  BIGINT NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+  winning_bid_id  BIGINT REFERENCES bids(id) ON DELETE SET NULL,
+  buyer_team_id   BIGINT REFERENCES teams(id) ON DELETE SET NULL,
+  sold_price      BIGINT CHECK (sold_price >= 0),
+  reserve_price   BIGINT NOT NULL CHECK (reserve_price >= 0),
+  outcome         VARCHAR NOT NULL CHECK(outcome IN ('SOLD', 'UNSOLD')),
+  ended_at        TIMESTAMPTZ NOT NULL,
+  settled_at      TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX auction_results_player_id_idx ON auction_results (player_id);
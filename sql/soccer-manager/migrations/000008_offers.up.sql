@@ -0,0 +1,17 @@
+CREATE TABLE offers (
+  id              BIGINT PRIMARY KEY NOT NULL,
+  player_id       BIGINT NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+  seller_team_id  BIGINT NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+  buyer_team_id   BIGINT NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+  amount          BIGINT NOT NULL CHECK (amount > 0),
+  status          VARCHAR NOT NULL DEFAULT 'PENDING' CHECK(status IN ('PENDING', 'ACCEPTED', 'DECLINED', 'WITHDRAWN', 'CANCELLED')),
+  created_at      TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at      TIMESTAMPTZ NOT NULL DEFAULT now(),
+  CHECK (seller_team_id <> buyer_team_id)
+);
+
+-- a team can only have a single pending offer per player
+CREATE UNIQUE INDEX offers_pending_player_buyer_idx ON offers (player_id, buyer_team_id) WHERE status = 'PENDING';
+CREATE INDEX offers_player_id_idx ON offers (player_id);
+CREATE INDEX offers_seller_team_id_idx ON offers (seller_team_id);
+CREATE INDEX offers_buyer_team_id_idx ON offers (buyer_team_id);
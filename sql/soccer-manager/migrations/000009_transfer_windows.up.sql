@@ -0,0 +1,12 @@
+CREATE TABLE transfer_windows (
+  id          BIGINT PRIMARY KEY NOT NULL,
+  name        VARCHAR NOT NULL,
+  opens_at    TIMESTAMPTZ NOT NULL,
+  closes_at   TIMESTAMPTZ NOT NULL,
+  created_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+  CHECK (closes_at > opens_at),
+  -- windows can't overlap, the market is either open or closed
+  EXCLUDE USING gist (tstzrange(opens_at, closes_at) WITH &&)
+);
+
+CREATE INDEX transfer_windows_opens_at_idx ON transfer_windows (opens_at);
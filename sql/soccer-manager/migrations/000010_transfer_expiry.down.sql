@@ -0,0 +1,2 @@
+ALTER TABLE transfers
+  DROP COLUMN expires_at;
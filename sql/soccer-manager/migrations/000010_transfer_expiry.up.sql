@@ -0,0 +1,7 @@
+-- fixed price listings may lapse, auctions end by auction_ends_at instead
+ALTER TABLE transfers
+  ADD COLUMN expires_at TIMESTAMPTZ,
+  ADD CONSTRAINT transfers_expires_after_listed CHECK (expires_at > listed_at),
+  ADD CONSTRAINT transfers_expiry_not_auction CHECK (expires_at IS NULL OR auction_ends_at IS NULL);
+
+CREATE INDEX transfers_expires_at_idx ON transfers (expires_at) WHERE expires_at IS NOT NULL;
@@ -0,0 +1,2 @@
+ALTER TABLE transfer_records
+  DROP COLUMN tax;
@@ -0,0 +1,3 @@
+-- tax taken from seller's proceeds, the seller is credited sold_price - tax
+ALTER TABLE transfer_records
+  ADD COLUMN tax BIGINT NOT NULL DEFAULT 0 CHECK (tax >= 0 AND tax <= sold_price);
@@ -0,0 +1,13 @@
+CREATE TABLE player_price_history (
+  id          BIGINT PRIMARY KEY NOT NULL,
+  player_id   BIGINT NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+  price       BIGINT NOT NULL CHECK (price >= 0),
+  reason      VARCHAR NOT NULL CHECK (reason IN ('GENERATED', 'SALE')),
+  recorded_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX player_price_history_player_id_recorded_at_idx ON player_price_history (player_id, recorded_at);
+
+-- current prices are the first point of existing players, player ids are unique snowflakes so they are safe to reuse
+INSERT INTO player_price_history (id, player_id, price, reason)
+SELECT id, id, price, 'GENERATED' FROM players;
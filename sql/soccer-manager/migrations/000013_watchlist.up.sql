@@ -0,0 +1,9 @@
+CREATE TABLE watchlist (
+  user_id     BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  player_id   BIGINT NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+  created_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+  PRIMARY KEY (user_id, player_id)
+);
+
+-- watchers are looked up by player whenever it's listed or repriced
+CREATE INDEX watchlist_player_id_idx ON watchlist (player_id);
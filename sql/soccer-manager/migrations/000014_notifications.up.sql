@@ -0,0 +1,12 @@
+CREATE TABLE notifications (
+  id          BIGINT PRIMARY KEY NOT NULL,
+  user_id     BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  kind        VARCHAR NOT NULL,
+  payload     JSONB NOT NULL DEFAULT '{}',
+  read_at     TIMESTAMPTZ,
+  created_at  TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX notifications_user_id_id_idx ON notifications (user_id, id);
+-- unread counts are read on every inbox request
+CREATE INDEX notifications_unread_idx ON notifications (user_id) WHERE read_at IS NULL;
@@ -0,0 +1,15 @@
+CREATE TABLE outbox_events (
+  id            BIGINT PRIMARY KEY NOT NULL,
+  topic         VARCHAR NOT NULL,
+  payload       JSONB NOT NULL DEFAULT '{}',
+  attempts      INTEGER NOT NULL DEFAULT 0,
+  -- names of the handlers that already got the event, they are skipped on retry
+  delivered_to  TEXT[] NOT NULL DEFAULT '{}',
+  last_error    TEXT,
+  available_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+  dead_at       TIMESTAMPTZ,
+  created_at    TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- the dispatcher polls for due events only, dead ones wait for an admin
+CREATE INDEX outbox_events_due_idx ON outbox_events (available_at) WHERE dead_at IS NULL;
@@ -0,0 +1,17 @@
+CREATE TABLE user_provisioning (
+  user_id     BIGINT PRIMARY KEY NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  -- pending until the team and its players exist, failed once it ran out of attempts
+  status      VARCHAR NOT NULL DEFAULT 'pending',
+  team_id     BIGINT REFERENCES teams(id) ON DELETE SET NULL,
+  attempts    INTEGER NOT NULL DEFAULT 0,
+  last_error  TEXT,
+  created_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at  TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX user_provisioning_status_idx ON user_provisioning (status) WHERE status <> 'completed';
+
+-- users that signed up before provisioning was tracked, the ones left without a team wait for an admin
+INSERT INTO user_provisioning (user_id, status, team_id)
+SELECT u.id, CASE WHEN t.id IS NULL THEN 'failed' ELSE 'completed' END, t.id
+FROM users u LEFT JOIN teams t ON t.user_id = u.id;
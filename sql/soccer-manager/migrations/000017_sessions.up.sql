@@ -0,0 +1,13 @@
+-- a session is a family of refresh tokens, only token_id is valid and every refresh replaces it
+CREATE TABLE sessions (
+  id              BIGINT PRIMARY KEY NOT NULL,
+  user_id         BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  token_id        BIGINT NOT NULL,
+  created_at      TIMESTAMPTZ NOT NULL DEFAULT now(),
+  last_used_at    TIMESTAMPTZ NOT NULL DEFAULT now(),
+  expires_at      TIMESTAMPTZ NOT NULL,
+  revoked_at      TIMESTAMPTZ,
+  revoked_reason  VARCHAR
+);
+
+CREATE INDEX sessions_user_id_idx ON sessions (user_id);
@@ -0,0 +1,4 @@
+-- the client of the last login or refresh, shown to users reviewing their sessions
+ALTER TABLE sessions
+  ADD COLUMN user_agent VARCHAR NOT NULL DEFAULT '',
+  ADD COLUMN ip VARCHAR NOT NULL DEFAULT '';
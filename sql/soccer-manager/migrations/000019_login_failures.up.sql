@@ -0,0 +1,8 @@
+-- failed logins per username or IP key, a row is forgotten a window after its last failure
+CREATE TABLE login_failures (
+  key             VARCHAR PRIMARY KEY NOT NULL,
+  failures        INT NOT NULL,
+  last_failed_at  TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX login_failures_last_failed_at_idx ON login_failures (last_failed_at);
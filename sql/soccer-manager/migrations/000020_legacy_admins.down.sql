@@ -0,0 +1,3 @@
+UPDATE users SET role = 'ADMIN' FROM legacy_admins WHERE users.id = legacy_admins.user_id;
+
+DROP TABLE IF EXISTS legacy_admins;
@@ -0,0 +1,17 @@
+-- admins from before registration was USER only, anyone could sign up as one.
+-- They are demoted and kept here for review, BOOTSTRAP_ADMIN promotes the trusted admin again on startup
+CREATE TABLE legacy_admins (
+  user_id     BIGINT PRIMARY KEY NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  username    VARCHAR NOT NULL,
+  demoted_at  TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+INSERT INTO legacy_admins (user_id, username)
+SELECT id, username FROM users WHERE role = 'ADMIN';
+
+UPDATE users SET role = 'USER' FROM legacy_admins WHERE users.id = legacy_admins.user_id;
+
+-- access tokens of the demoted admins still carry the role, their sessions end like on any role change
+UPDATE sessions SET revoked_at = now(), revoked_reason = 'role_change'
+FROM legacy_admins
+WHERE sessions.user_id = legacy_admins.user_id AND sessions.revoked_at IS NULL;
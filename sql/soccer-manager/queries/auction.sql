@@ -0,0 +1,17 @@
+-- name: ListExpiredAuctionIDs :many
+SELECT id FROM transfers WHERE auction_ends_at <= now() ORDER BY auction_ends_at LIMIT $1;
+
+-- name: GetWinningBidByTransferID :one
+SELECT id, transfer_id, player_id, seller_team_id, bidder_team_id, amount, counter_amount, status, created_at, updated_at FROM bids
+WHERE transfer_id = $1 AND status = 'PENDING' AND amount >= $2 ORDER BY amount DESC, created_at, id LIMIT 1 FOR UPDATE;
+
+-- name: InsertAuctionResult :one
+INSERT INTO auction_results (id, transfer_id, player_id, seller_team_id, winning_bid_id, buyer_team_id, sold_price, reserve_price, outcome, ended_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+RETURNING id, transfer_id, player_id, seller_team_id, winning_bid_id, buyer_team_id, sold_price, reserve_price, outcome, ended_at, settled_at;
+
+-- name: GetAuctionResultByID :one
+SELECT id, transfer_id, player_id, seller_team_id, winning_bid_id, buyer_team_id, sold_price, reserve_price, outcome, ended_at, settled_at FROM auction_results WHERE id = $1;
+
+-- name: ListAuctionResults :many
+SELECT id, transfer_id, player_id, seller_team_id, winning_bid_id, buyer_team_id, sold_price, reserve_price, outcome, ended_at, settled_at FROM auction_results WHERE id > $1 ORDER BY id LIMIT $2;
@@ -0,0 +1,35 @@
+-- name: GetBidByIDAndUserID :one
+SELECT b.id, b.transfer_id, b.player_id, b.seller_team_id, b.bidder_team_id, b.amount, b.counter_amount, b.status, b.created_at, b.updated_at
+FROM bids b JOIN teams t ON t.id IN (b.seller_team_id, b.bidder_team_id) WHERE b.id = $1 AND t.user_id = $2;
+
+-- name: ListBidsByTransferID :many
+SELECT b.id, b.transfer_id, b.player_id, b.seller_team_id, b.bidder_team_id, b.amount, b.counter_amount, b.status, b.created_at, b.updated_at
+FROM bids b JOIN teams t ON t.id = b.seller_team_id WHERE b.transfer_id = $1 AND t.user_id = $2 AND b.id > $3 ORDER BY b.id LIMIT $4;
+
+-- name: ListBidsByUserID :many
+SELECT b.id, b.transfer_id, b.player_id, b.seller_team_id, b.bidder_team_id, b.amount, b.counter_amount, b.status, b.created_at, b.updated_at
+FROM bids b JOIN teams t ON t.id = b.bidder_team_id WHERE t.user_id = $1 AND b.id > $2 ORDER BY b.id LIMIT $3;
+
+-- name: GetBidByIDForUpdate :one
+SELECT id, transfer_id, player_id, seller_team_id, bidder_team_id, amount, counter_amount, status, created_at, updated_at FROM bids WHERE id = $1 FOR UPDATE;
+
+-- name: InsertBid :one
+INSERT INTO bids (id, transfer_id, player_id, seller_team_id, bidder_team_id, amount) VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, transfer_id, player_id, seller_team_id, bidder_team_id, amount, counter_amount, status, created_at, updated_at;
+
+-- name: GetHighestBidAmountByTransferID :one
+SELECT COALESCE(MAX(amount), 0)::BIGINT FROM bids WHERE transfer_id = $1 AND status = 'PENDING';
+
+-- name: UpdateBidStatus :exec
+UPDATE bids SET status = $2, updated_at = now() WHERE id = $1;
+
+-- name: CounterBid :exec
+UPDATE bids SET status = 'COUNTERED', counter_amount = $2, updated_at = now() WHERE id = $1;
+
+-- name: ReleaseBidsByTransferID :exec
+WITH released AS (
+  UPDATE bids SET status = 'CANCELLED', updated_at = now()
+  WHERE transfer_id = $1 AND id <> $2 AND status IN ('PENDING', 'COUNTERED')
+  RETURNING bidder_team_id, amount
+)
+UPDATE teams SET budget = teams.budget + released.amount FROM released WHERE teams.id = released.bidder_team_id;
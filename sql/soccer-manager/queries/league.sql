@@ -0,0 +1,40 @@
+-- name: InsertLeague :one
+INSERT INTO leagues (id, name) VALUES ($1, $2) RETURNING *;
+
+-- name: GetLeagueByID :one
+SELECT * FROM leagues WHERE id = $1;
+
+-- name: ListLeaguesCursor :many
+SELECT * FROM leagues WHERE id > $1 ORDER BY id LIMIT $2;
+
+-- name: ShareLeagueStatus :one
+SELECT status FROM leagues WHERE id = $1 FOR SHARE;
+
+-- name: LockLeagueStatus :one
+SELECT status FROM leagues WHERE id = $1 FOR UPDATE;
+
+-- name: StartLeague :exec
+UPDATE leagues SET status = 'STARTED' WHERE id = $1 AND status = 'ENROLLING';
+
+-- name: InsertLeagueTeam :exec
+INSERT INTO league_teams (league_id, team_id) VALUES ($1, $2);
+
+-- name: DeleteLeagueTeam :exec
+DELETE FROM league_teams WHERE league_id = $1 AND team_id = $2;
+
+-- name: ListLeagueTeams :many
+SELECT t.* FROM teams t JOIN league_teams lt ON lt.team_id = t.id WHERE lt.league_id = $1 ORDER BY t.id;
+
+-- name: InsertFixture :exec
+INSERT INTO fixtures (id, league_id, round, home_team_id, away_team_id) VALUES ($1, $2, $3, $4, $5);
+
+-- name: GetFixtureByID :one
+SELECT f.id, f.league_id, f.round, f.home_team_id, f.away_team_id, f.match_id, m.home_score, m.away_score, m.played_at
+FROM fixtures f LEFT JOIN matches m ON m.id = f.match_id WHERE f.league_id = $1 AND f.id = $2;
+
+-- name: ListFixturesByLeagueID :many
+SELECT f.id, f.league_id, f.round, f.home_team_id, f.away_team_id, f.match_id, m.home_score, m.away_score, m.played_at
+FROM fixtures f LEFT JOIN matches m ON m.id = f.match_id WHERE f.league_id = $1 ORDER BY f.round, f.id;
+
+-- name: SetFixtureMatch :exec
+UPDATE fixtures SET match_id = $2 WHERE id = $1 AND match_id IS NULL;
@@ -0,0 +1,27 @@
+-- name: GetLoginFailure :one
+SELECT failures, last_failed_at FROM login_failures
+WHERE key = $1 AND last_failed_at > now() - make_interval(secs => $2);
+
+-- name: AttemptLogin :one
+INSERT INTO login_failures (key, failures) VALUES ($1, 1)
+ON CONFLICT (key) DO UPDATE SET
+  failures = CASE
+    WHEN login_failures.last_failed_at > now() - make_interval(secs => $2) THEN login_failures.failures + 1
+    ELSE 1
+  END,
+  last_failed_at = now()
+WHERE login_failures.last_failed_at <= now() - make_interval(secs => $2)
+  OR login_failures.failures < $3::INT
+  OR login_failures.last_failed_at + make_interval(
+    secs => LEAST($4::FLOAT8 * power(2, LEAST(login_failures.failures - $3::INT, 62)), $5::FLOAT8)
+  ) <= now()
+RETURNING failures, last_failed_at;
+
+-- name: ForgiveLoginFailure :exec
+UPDATE login_failures SET failures = failures - 1 WHERE key = $1 AND failures > 0;
+
+-- name: DeleteLoginFailure :exec
+DELETE FROM login_failures WHERE key = $1;
+
+-- name: PruneLoginFailures :execrows
+DELETE FROM login_failures WHERE last_failed_at <= now() - make_interval(secs => $1);
@@ -0,0 +1,38 @@
+-- name: ListDailyVolume :many
+SELECT date_trunc('day', sold_at) AS day, count(*), COALESCE(sum(sold_price), 0)::BIGINT
+FROM transfer_records
+WHERE sold_at >= $1
+GROUP BY day
+ORDER BY day;
+
+-- name: ListAveragePriceByPosition :many
+SELECT p.position_code, count(*), avg(tr.sold_price)::BIGINT
+FROM transfer_records tr JOIN players p ON p.id = tr.player_id
+WHERE tr.sold_at >= $1
+GROUP BY p.position_code
+ORDER BY p.position_code;
+
+-- name: ListTopTransferRecords :many
+SELECT id, player_id, seller_team_id, buyer_team_id, sold_price, tax, listed_at, sold_at FROM transfer_records
+WHERE sold_at >= $1
+ORDER BY sold_price DESC, id
+LIMIT $2;
+
+-- name: ListTopSpenders :many
+SELECT buyer_team_id, count(*), sum(sold_price)::BIGINT AS total
+FROM transfer_records
+WHERE sold_at >= $1
+GROUP BY buyer_team_id
+ORDER BY total DESC, buyer_team_id
+LIMIT $2;
+
+-- name: ListTopSellers :many
+SELECT seller_team_id, count(*), sum(sold_price - tax)::BIGINT AS total
+FROM transfer_records
+WHERE sold_at >= $1
+GROUP BY seller_team_id
+ORDER BY total DESC, seller_team_id
+LIMIT $2;
+
+-- name: GetAverageTimeToSell :one
+SELECT COALESCE(avg(extract(EPOCH FROM sold_at - listed_at)), 0)::BIGINT FROM transfer_records WHERE sold_at >= $1;
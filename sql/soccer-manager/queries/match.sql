@@ -0,0 +1,17 @@
+-- name: InsertMatch :exec
+INSERT INTO matches (id, home_team_id, away_team_id, seed, home_score, away_score) VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: InsertMatchEvent :exec
+INSERT INTO match_events (id, match_id, minute, event_type, team_id, player_id, related_player_id) VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: GetMatchByID :one
+SELECT * FROM matches WHERE id = $1;
+
+-- name: ListMatchesCursor :many
+SELECT * FROM matches WHERE id > $1 ORDER BY id LIMIT $2;
+
+-- name: ListMatchesByTeamID :many
+SELECT * FROM matches WHERE (home_team_id = $1 OR away_team_id = $1) AND id > $2 ORDER BY id LIMIT $3;
+
+-- name: ListMatchEventsByMatchID :many
+SELECT * FROM match_events WHERE match_id = $1 ORDER BY minute, id;
@@ -0,0 +1,35 @@
+-- name: ListNotificationsByUserID :many
+SELECT id, user_id, kind, payload, read_at, created_at FROM notifications
+WHERE user_id = $1
+  AND ($2::BIGINT = 0 OR id < $2)
+  AND (NOT $3::BOOLEAN OR read_at IS NULL)
+ORDER BY id DESC
+LIMIT $4;
+
+-- name: CountUnreadNotificationsByUserID :one
+SELECT count(*) FROM notifications WHERE user_id = $1 AND read_at IS NULL;
+
+-- name: InsertNotification :one
+INSERT INTO notifications (id, user_id, kind, payload) VALUES ($1, $2, $3, $4)
+RETURNING id, user_id, kind, payload, read_at, created_at;
+
+-- name: InsertTeamNotification :one
+INSERT INTO notifications (id, user_id, kind, payload)
+SELECT $1, user_id, $3, $4 FROM teams WHERE id = $2
+RETURNING id, user_id, kind, payload, read_at, created_at;
+
+-- name: MarkNotificationRead :exec
+UPDATE notifications SET read_at = COALESCE(read_at, now()) WHERE id = $1 AND user_id = $2;
+
+-- name: MarkAllNotificationsRead :exec
+UPDATE notifications SET read_at = now() WHERE user_id = $1 AND read_at IS NULL;
+
+-- name: InsertSaleNotifications :exec
+INSERT INTO notifications (id, user_id, kind, payload)
+SELECT $1, user_id, 'PLAYER_SOLD', jsonb_build_object(
+  'player_id', $3::BIGINT, 'seller_team_id', $4::BIGINT, 'buyer_team_id', $5::BIGINT, 'price', $6::BIGINT, 'tax', $7::BIGINT
+) FROM teams WHERE id = $4
+UNION ALL
+SELECT $2, user_id, 'PLAYER_BOUGHT', jsonb_build_object(
+  'player_id', $3::BIGINT, 'seller_team_id', $4::BIGINT, 'buyer_team_id', $5::BIGINT, 'price', $6::BIGINT, 'tax', $7::BIGINT
+) FROM teams WHERE id = $5;
@@ -0,0 +1,33 @@
+-- name: GetOfferByIDAndUserID :one
+SELECT o.id, o.player_id, o.seller_team_id, o.buyer_team_id, o.amount, o.status, o.created_at, o.updated_at
+FROM offers o JOIN teams t ON t.id IN (o.seller_team_id, o.buyer_team_id) WHERE o.id = $1 AND t.user_id = $2;
+
+-- name: ListOffersByPlayerID :many
+SELECT o.id, o.player_id, o.seller_team_id, o.buyer_team_id, o.amount, o.status, o.created_at, o.updated_at
+FROM offers o JOIN teams t ON t.id = o.seller_team_id WHERE o.player_id = $1 AND t.user_id = $2 AND o.id > $3 ORDER BY o.id LIMIT $4;
+
+-- name: ListSentOffersByUserID :many
+SELECT o.id, o.player_id, o.seller_team_id, o.buyer_team_id, o.amount, o.status, o.created_at, o.updated_at
+FROM offers o JOIN teams t ON t.id = o.buyer_team_id WHERE t.user_id = $1 AND o.id > $2 ORDER BY o.id LIMIT $3;
+
+-- name: ListReceivedOffersByUserID :many
+SELECT o.id, o.player_id, o.seller_team_id, o.buyer_team_id, o.amount, o.status, o.created_at, o.updated_at
+FROM offers o JOIN teams t ON t.id = o.seller_team_id WHERE t.user_id = $1 AND o.id > $2 ORDER BY o.id LIMIT $3;
+
+-- name: GetOfferByIDForUpdate :one
+SELECT id, player_id, seller_team_id, buyer_team_id, amount, status, created_at, updated_at FROM offers WHERE id = $1 FOR UPDATE;
+
+-- name: InsertOffer :one
+INSERT INTO offers (id, player_id, seller_team_id, buyer_team_id, amount) VALUES ($1, $2, $3, $4, $5)
+RETURNING id, player_id, seller_team_id, buyer_team_id, amount, status, created_at, updated_at;
+
+-- name: UpdateOfferStatus :exec
+UPDATE offers SET status = $2, updated_at = now() WHERE id = $1;
+
+-- name: ReleaseOffersByPlayerID :exec
+WITH released AS (
+  UPDATE offers SET status = 'CANCELLED', updated_at = now()
+  WHERE player_id = $1 AND status = 'PENDING'
+  RETURNING buyer_team_id, amount
+)
+UPDATE teams SET budget = teams.budget + released.amount FROM released WHERE teams.id = released.buyer_team_id;
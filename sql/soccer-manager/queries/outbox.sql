@@ -0,0 +1,25 @@
+-- name: InsertOutboxEvent :exec
+INSERT INTO outbox_events (id, topic, payload) VALUES ($1, $2, $3);
+
+-- name: ClaimOutboxEvents :many
+UPDATE outbox_events SET attempts = attempts + 1, available_at = $2
+WHERE id IN (
+  SELECT id FROM outbox_events
+  WHERE dead_at IS NULL AND available_at <= now()
+  ORDER BY id LIMIT $1
+  FOR UPDATE SKIP LOCKED
+)
+RETURNING id, topic, payload, attempts, delivered_to, last_error, available_at, dead_at, created_at;
+
+-- name: DeleteOutboxEvent :exec
+DELETE FROM outbox_events WHERE id = $1;
+
+-- name: FailOutboxEvent :exec
+UPDATE outbox_events SET delivered_to = $2, last_error = $3, available_at = $4, dead_at = $5 WHERE id = $1;
+
+-- name: ListDeadOutboxEvents :many
+SELECT id, topic, payload, attempts, delivered_to, last_error, available_at, dead_at, created_at FROM outbox_events
+WHERE dead_at IS NOT NULL AND id > $1 ORDER BY id LIMIT $2;
+
+-- name: RequeueOutboxEvent :exec
+UPDATE outbox_events SET attempts = 0, available_at = now(), dead_at = NULL WHERE id = $1 AND dead_at IS NOT NULL;
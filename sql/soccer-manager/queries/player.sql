@@ -1,8 +1,8 @@
 -- name: ListPlayersByCursor :many
-SELECT id, team_id, country_code, first_name, last_name, age, position_code, price FROM players WHERE id > $1 ORDER BY id LIMIT $2;
+SELECT id, team_id, country_code, first_name, last_name, age, position_code, price, pace, shooting, passing, defending, goalkeeping, stamina FROM players WHERE id > $1 ORDER BY id LIMIT $2;
 
 -- name: GetPlayerByID :one
-SELECT id, team_id, country_code, first_name, last_name, age, position_code, price FROM players WHERE id = $1;
+SELECT id, team_id, country_code, first_name, last_name, age, position_code, price, pace, shooting, passing, defending, goalkeeping, stamina FROM players WHERE id = $1;
 
 -- name: UpdatePlayerNameAndCountry :exec
 UPDATE players SET first_name = $3, last_name = $4, country_code = $5 FROM teams WHERE players.team_id = teams.id AND players.id = $2 AND teams.user_id = $1;
@@ -11,10 +11,16 @@ UPDATE players SET first_name = $3, last_name = $4, country_code = $5 FROM teams
 UPDATE players SET price = $2, team_id = $3 WHERE id = $1;
 
 -- name: ListPlayersByUserID :many
-SELECT p.* FROM players p JOIN teams t ON p.team_id = t.id WHERE t.user_id = $1 AND p.id > $2 ORDER BY p.id LIMIT $3;
+SELECT p.id, p.team_id, p.country_code, p.first_name, p.last_name, p.age, p.position_code, p.price, p.pace, p.shooting, p.passing, p.defending, p.goalkeeping, p.stamina FROM players p JOIN teams t ON p.team_id = t.id WHERE t.user_id = $1 AND p.id > $2 ORDER BY p.id LIMIT $3;
 
 -- name: ListPlayersByTeamID :many
-SELECT p.* FROM players p WHERE p.team_id = $1 AND p.id > $2 ORDER BY p.id LIMIT $3;
+SELECT p.id, p.team_id, p.country_code, p.first_name, p.last_name, p.age, p.position_code, p.price, p.pace, p.shooting, p.passing, p.defending, p.goalkeeping, p.stamina FROM players p WHERE p.team_id = $1 AND p.id > $2 ORDER BY p.id LIMIT $3;
 
 -- name: InsertPlayer :exec
-INSERT INTO players (id, team_id, country_code, first_name, last_name, age, position_code, price) VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+INSERT INTO players (id, team_id, country_code, first_name, last_name, age, position_code, price, pace, shooting, passing, defending, goalkeeping, stamina) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14);
+
+-- name: LockTeamByID :one
+SELECT id FROM teams WHERE id = $1 FOR UPDATE;
+
+-- name: TeamHasPlayers :one
+SELECT EXISTS (SELECT 1 FROM players WHERE team_id = $1);
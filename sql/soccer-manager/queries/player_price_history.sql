@@ -0,0 +1,21 @@
+-- name: InsertPlayerPriceHistory :exec
+INSERT INTO player_price_history (id, player_id, price, reason) VALUES ($1, $2, $3, $4);
+
+-- name: ListPlayerPriceHistory :many
+SELECT recorded_at, price, price, price FROM player_price_history
+WHERE player_id = $1
+  AND ($2::TIMESTAMPTZ IS NULL OR recorded_at >= $2)
+  AND ($3::TIMESTAMPTZ IS NULL OR recorded_at < $3)
+ORDER BY recorded_at, id;
+
+-- name: ListPlayerPriceHistoryBuckets :many
+SELECT date_trunc($4, recorded_at) AS bucket,
+  (array_agg(price ORDER BY recorded_at DESC, id DESC))[1],
+  min(price),
+  max(price)
+FROM player_price_history
+WHERE player_id = $1
+  AND ($2::TIMESTAMPTZ IS NULL OR recorded_at >= $2)
+  AND ($3::TIMESTAMPTZ IS NULL OR recorded_at < $3)
+GROUP BY bucket
+ORDER BY bucket;
@@ -0,0 +1,31 @@
+-- name: InsertProvisioning :exec
+INSERT INTO user_provisioning (user_id) VALUES ($1);
+
+-- name: GetProvisioningByUserID :one
+SELECT user_id, status, team_id, attempts, last_error, created_at, updated_at FROM user_provisioning WHERE user_id = $1;
+
+-- name: StartProvisioning :one
+INSERT INTO user_provisioning (user_id, attempts) VALUES ($1, 1)
+ON CONFLICT (user_id) DO UPDATE SET
+  status = CASE WHEN user_provisioning.status = 'completed' THEN 'completed' ELSE 'pending' END,
+  attempts = user_provisioning.attempts + 1,
+  updated_at = now()
+RETURNING user_id, status, team_id, attempts, last_error, created_at, updated_at;
+
+-- name: CompleteProvisioning :exec
+UPDATE user_provisioning SET status = 'completed', team_id = $2, last_error = NULL, updated_at = now() WHERE user_id = $1;
+
+-- name: FailProvisioning :exec
+UPDATE user_provisioning SET status = $2, team_id = $3, last_error = $4, updated_at = now() WHERE user_id = $1;
+
+-- name: GetUsernameForUpdate :one
+SELECT username FROM users WHERE id = $1 FOR UPDATE;
+
+-- name: ResetProvisioning :exec
+INSERT INTO user_provisioning (user_id) VALUES ($1)
+ON CONFLICT (user_id) DO UPDATE SET status = 'pending', attempts = 0, last_error = NULL, updated_at = now();
+
+-- name: ListStuckProvisioning :many
+SELECT user_id, status, team_id, attempts, last_error, created_at, updated_at FROM user_provisioning
+WHERE (status = 'failed' OR (status = 'pending' AND updated_at < $1)) AND user_id > $2
+ORDER BY user_id LIMIT $3;
@@ -0,0 +1,28 @@
+-- name: CreateSession :one
+INSERT INTO sessions (id, user_id, token_id, expires_at, user_agent, ip) VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, user_id, token_id, user_agent, ip, created_at, last_used_at, expires_at, revoked_at, revoked_reason;
+
+-- name: GetSessionForUpdate :one
+SELECT id, user_id, token_id, user_agent, ip, created_at, last_used_at, expires_at, revoked_at, revoked_reason FROM sessions
+WHERE id = $1 FOR UPDATE;
+
+-- name: ListActiveSessionsByUserID :many
+SELECT id, user_id, token_id, user_agent, ip, created_at, last_used_at, expires_at, revoked_at, revoked_reason FROM sessions
+WHERE user_id = $1 AND revoked_at IS NULL AND expires_at > now()
+ORDER BY last_used_at DESC;
+
+-- name: IsSessionActive :one
+SELECT EXISTS (
+  SELECT 1 FROM sessions WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL AND expires_at > now()
+) AS active;
+
+-- name: RotateSession :one
+UPDATE sessions SET token_id = $2, expires_at = $3, user_agent = $4, ip = $5, last_used_at = now() WHERE id = $1
+RETURNING id, user_id, token_id, user_agent, ip, created_at, last_used_at, expires_at, revoked_at, revoked_reason;
+
+-- name: RevokeSession :execrows
+UPDATE sessions SET revoked_at = now(), revoked_reason = $3 WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL;
+
+-- name: RevokeUserSessions :execrows
+UPDATE sessions SET revoked_at = now(), revoked_reason = $3
+WHERE user_id = $1 AND id <> $2 AND revoked_at IS NULL AND expires_at > now();
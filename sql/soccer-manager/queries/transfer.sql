@@ -1,8 +1,24 @@
 -- name: ListTransfers :many
-SELECT * FROM transfers WHERE id > $1 ORDER BY id LIMIT $2;
+SELECT t.id, t.player_id, t.seller_team_id, t.price, t.listed_at, t.auction_ends_at, t.reserve_price, t.expires_at
+FROM transfers t JOIN players p ON p.id = t.player_id
+WHERE (t.expires_at IS NULL OR t.expires_at > now())
+  AND ($1::VARCHAR IS NULL OR p.position_code = $1)
+  AND ($2::BIGINT IS NULL OR t.price >= $2)
+  AND ($3::BIGINT IS NULL OR t.price <= $3)
+  AND ($4::INT IS NULL OR p.age >= $4)
+  AND ($5::INT IS NULL OR p.age <= $5)
+  AND ($6::VARCHAR IS NULL OR p.country_code = $6)
+  AND ($7::BIGINT IS NULL OR t.seller_team_id = $7)
+  AND ($8::TIMESTAMPTZ IS NULL OR t.listed_at >= $8)
+  AND ($9::TIMESTAMPTZ IS NULL OR t.listed_at <= $9)
+  AND ($10::BIGINT = 0 OR (
+    (CASE WHEN $11::BOOLEAN THEN t.price ELSE t.id END) * $12::BIGINT, t.id * $12::BIGINT
+  ) > ($13::BIGINT * $12::BIGINT, $10::BIGINT * $12::BIGINT))
+ORDER BY (CASE WHEN $11::BOOLEAN THEN t.price ELSE t.id END) * $12::BIGINT, t.id * $12::BIGINT
+LIMIT $14;
 
 -- name: ListTransfersByTeamId :many
-SELECT * FROM transfers WHERE seller_team_id = $1 AND id > $2 ORDER BY id LIMIT $3;
+SELECT * FROM transfers WHERE seller_team_id = $1 AND (expires_at IS NULL OR expires_at > now()) AND id > $2 ORDER BY id LIMIT $3;
 
 -- name: GetTransferByPlayerID :one
 SELECT * FROM transfers WHERE player_id = $1;
@@ -12,15 +28,33 @@ SELECT * FROM transfers WHERE id = $1;
 
 -- name: InsertTransferRecordByUser :one
 WITH team AS (SELECT id FROM teams WHERE user_id = $1 LIMIT 1)
-INSERT INTO transfers (id, player_id, seller_team_id, price)
-VALUES ($2, $3, (SELECT id FROM team), $4)
+INSERT INTO transfers (id, player_id, seller_team_id, price, auction_ends_at, reserve_price, expires_at)
+VALUES ($2, $3, (SELECT id FROM team), $4, $5, $6, $7)
 RETURNING id;
 
 -- name: DeleteTransferByID :exec
 DELETE FROM transfers WHERE id = $1;
 
--- name: DeleteTransferByIDAndUserID :exec
-DELETE FROM transfers USING teams WHERE transfers.id = $1 AND transfers.seller_team_id = teams.id AND teams.user_id = $2;
+-- name: LockTransferByIDAndUserID :one
+SELECT transfers.* FROM transfers JOIN teams ON transfers.seller_team_id = teams.id
+WHERE transfers.id = $1 AND teams.user_id = $2 FOR UPDATE OF transfers;
+
+-- name: HasOpenBidsByTransferID :one
+SELECT EXISTS (SELECT 1 FROM bids WHERE transfer_id = $1 AND status IN ('PENDING', 'COUNTERED'));
 
 -- name: UpdateTransferPriceByIDAndUserID :exec
-UPDATE transfers SET price = $2 FROM teams WHERE transfers.id = $1 AND transfers.seller_team_id = teams.id AND teams.user_id = $3;
+UPDATE transfers SET price = $2 FROM teams WHERE transfers.id = $1 AND transfers.seller_team_id = teams.id AND teams.user_id = $3 AND transfers.auction_ends_at IS NULL;
+
+-- name: RenewTransferByIDAndUserID :exec
+UPDATE transfers SET expires_at = $3 FROM teams WHERE transfers.id = $1 AND transfers.seller_team_id = teams.id AND teams.user_id = $2 AND transfers.expires_at > now();
+
+-- name: ListExpiredTransferIDs :many
+SELECT id FROM transfers WHERE expires_at <= now() ORDER BY expires_at LIMIT $1;
+
+-- name: LockExpiredTransferByID :one
+SELECT * FROM transfers WHERE id = $1 AND expires_at <= now() FOR UPDATE;
+
+-- name: CountRecentSalesByPosition :one
+SELECT count(*) FILTER (WHERE p.position_code = $1), count(*)
+FROM transfer_records tr JOIN players p ON p.id = tr.player_id
+WHERE tr.sold_at >= $2;
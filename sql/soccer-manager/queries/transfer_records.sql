@@ -2,7 +2,16 @@
 SELECT * FROM transfer_records WHERE id = $1;
 
 -- name: ListTransferRecords :many
-SELECT * FROM transfer_records WHERE id > $1 ORDER BY id LIMIT $2;
+SELECT * FROM transfer_records
+WHERE id > $1
+  AND ($3::BIGINT IS NULL OR player_id = $3)
+  AND ($4::BIGINT IS NULL OR seller_team_id = $4)
+  AND ($5::BIGINT IS NULL OR buyer_team_id = $5)
+  AND ($6::BIGINT IS NULL OR seller_team_id = $6 OR buyer_team_id = $6)
+  AND ($7::TIMESTAMPTZ IS NULL OR sold_at >= $7)
+  AND ($8::TIMESTAMPTZ IS NULL OR sold_at < $8)
+ORDER BY id LIMIT $2;
 
--- name: InsertTransferRecord :exec
-INSERT INTO transfer_records (id, player_id, seller_team_id, buyer_team_id, sold_price, listed_at) VALUES ($1, $2, $3, $4, $5, $6);
+-- name: InsertTransferRecord :one
+INSERT INTO transfer_records (id, player_id, seller_team_id, buyer_team_id, sold_price, tax, listed_at) VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
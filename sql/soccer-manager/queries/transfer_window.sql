@@ -0,0 +1,25 @@
+-- name: GetTransferWindowByID :one
+SELECT id, name, opens_at, closes_at, created_at FROM transfer_windows WHERE id = $1;
+
+-- name: ListTransferWindows :many
+SELECT id, name, opens_at, closes_at, created_at FROM transfer_windows WHERE id > $1 ORDER BY id LIMIT $2;
+
+-- name: GetCurrentTransferWindow :one
+SELECT id, name, opens_at, closes_at, created_at FROM transfer_windows WHERE opens_at <= now() AND closes_at > now();
+
+-- name: GetNextTransferWindow :one
+SELECT id, name, opens_at, closes_at, created_at FROM transfer_windows WHERE opens_at > now() ORDER BY opens_at LIMIT 1;
+
+-- name: HasTransferWindows :one
+SELECT EXISTS (SELECT 1 FROM transfer_windows);
+
+-- name: InsertTransferWindow :one
+INSERT INTO transfer_windows (id, name, opens_at, closes_at) VALUES ($1, $2, $3, $4)
+RETURNING id, name, opens_at, closes_at, created_at;
+
+-- name: UpdateTransferWindow :one
+UPDATE transfer_windows SET name = $2, opens_at = $3, closes_at = $4 WHERE id = $1
+RETURNING id, name, opens_at, closes_at, created_at;
+
+-- name: DeleteTransferWindowByID :exec
+DELETE FROM transfer_windows WHERE id = $1;
@@ -22,5 +22,17 @@ INSERT INTO users (id, username, role, hash) VALUES ($1, $2, $3, $4) RETURNING i
 -- name: UpdateUserHash :exec
 UPDATE users SET hash = $2 WHERE id = $1;
 
+-- name: LockUsersByRole :many
+SELECT id FROM users WHERE role = $1 FOR UPDATE;
+
+-- name: GetUserForUpdate :one
+SELECT id, username, role FROM users WHERE id = $1 FOR UPDATE;
+
+-- name: UpdateUserRole :exec
+UPDATE users SET role = $2 WHERE id = $1;
+
+-- name: PromoteFirstAdmin :one
+UPDATE users SET role = $2 WHERE username = $1 RETURNING id;
+
 -- name: DeleteUser :exec
 DELETE FROM users WHERE id = $1;
@@ -0,0 +1,19 @@
+-- name: ListWatchlistByUserID :many
+SELECT p.id, p.team_id, p.first_name, p.last_name, p.age, p.position_code, p.price, t.id, t.price, w.created_at
+FROM watchlist w
+JOIN players p ON p.id = w.player_id
+LEFT JOIN transfers t ON t.player_id = p.id AND (t.expires_at IS NULL OR t.expires_at > now())
+WHERE w.user_id = $1 AND w.player_id > $2
+ORDER BY w.player_id
+LIMIT $3;
+
+-- name: ListWatcherIDsByPlayerID :many
+SELECT w.user_id FROM watchlist w
+WHERE w.player_id = $1
+  AND NOT EXISTS (SELECT 1 FROM teams t WHERE t.id = $2 AND t.user_id = w.user_id);
+
+-- name: InsertWatchlist :exec
+INSERT INTO watchlist (user_id, player_id) VALUES ($1, $2);
+
+-- name: DeleteWatchlist :exec
+DELETE FROM watchlist WHERE user_id = $1 AND player_id = $2;